@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"newTiktoken/internal/api-router/ports"
+	"newTiktoken/internal/api-router/routes"
+	"newTiktoken/internal/common/client"
+	"newTiktoken/internal/common/config"
+	"newTiktoken/internal/common/keys"
+	"newTiktoken/internal/common/logs"
+	"newTiktoken/internal/common/metrics"
+	"newTiktoken/internal/common/server"
+	"newTiktoken/internal/common/tracing"
+)
+
+func main() {
+	ctx := context.Background()
+	configStore, err := config.Load(ctx, config.WithRequired("api_router.routes_file"))
+	if err != nil {
+		logrus.WithError(err).Fatal("Unable to load config")
+	}
+	cfg := configStore.Get()
+	logs.WatchLevel(configStore)
+
+	shutdownTracerProvider, err := tracing.InitTracerProvider(ctx, "api-router")
+	if err != nil {
+		logrus.WithError(err).Fatal("Unable to init tracer provider")
+	}
+	defer func() {
+		_ = shutdownTracerProvider(context.Background())
+	}()
+
+	go metrics.RunMetricsServerOnAddr(cfg.Metrics.Addr(), prometheus.DefaultGatherer)
+
+	table, err := routes.Load(cfg.APIRouter.RoutesFile)
+	if err != nil {
+		logrus.WithError(err).Fatal("Unable to load routes")
+	}
+	serviceKeys, err := keys.FromConfig(ctx, cfg, "api-router")
+	if err != nil {
+		logrus.WithError(err).Fatal("Unable to load keys")
+	}
+	defer serviceKeys.Close()
+	if serviceKeys.Set == nil {
+		logrus.Fatal("keys.etcd_prefix or auth.public_keys_dir is required")
+	}
+
+	conns := make(map[string]grpc.ClientConnInterface, len(table.Services))
+	for route, service := range table.Services {
+		conn, err := client.NewConn(service.Address)
+		if err != nil {
+			logrus.WithError(err).WithField("route", route).Fatal("Unable to create gRPC connection")
+		}
+		defer func() {
+			_ = conn.Close()
+		}()
+		conns[route] = conn
+	}
+	proxy, err := ports.NewGRPCProxy(table, conns)
+	if err != nil {
+		logrus.WithError(err).Fatal("Unable to create gRPC proxy")
+	}
+
+	server.RunHTTPServerOnAddr(cfg.APIRouter.Addr(), func(router chi.Router) http.Handler {
+		return ports.HandlerFromMux(router, table, serviceKeys.Set, proxy)
+	}, server.WithoutDefaultAuth(),
+		server.WithHTTPShutdownTimeout(cfg.APIRouter.ShutdownTimeout),
+	)
+}
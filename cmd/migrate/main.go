@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/config"
+	"newTiktoken/internal/common/logs"
+	"newTiktoken/internal/common/migrations"
+)
+
+const usage = `Usage: migrate [flags] <command>
+
+Commands:
+  up          执行所有未执行的迁移
+  down [n]    回滚最近执行的 n 个迁移，默认 1 个
+  status      列出每个迁移是否已执行
+  version     输出已执行的最大版本
+
+Flags:
+`
+
+// migrate 执行编译进二进制的表结构迁移，MySQL 连接使用与各服务相同的配置
+func main() {
+	lockTimeout := flag.Duration("lock-timeout", time.Minute, "等待其他实例释放迁移锁的最长时间")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	configStore, err := config.Load(ctx, config.WithRequired("mysql.dsn"))
+	if err != nil {
+		logrus.WithError(err).Fatal("Unable to load config")
+	}
+	cfg := configStore.Get()
+	logs.WatchLevel(configStore)
+
+	db, err := cfg.MySQL.Open()
+	if err != nil {
+		logrus.WithError(err).Fatal("Unable to open MySQL connection")
+	}
+	defer db.Close()
+
+	embedded, err := migrations.Embedded()
+	if err != nil {
+		logrus.WithError(err).Fatal("Unable to load migrations")
+	}
+	migrator := migrations.NewMigrator(db, embedded, *lockTimeout)
+
+	if err := run(ctx, migrator, flag.Arg(0), flag.Args()[1:]); err != nil {
+		logrus.WithError(err).Fatalf("migrate %s failed", flag.Arg(0))
+	}
+}
+
+func run(ctx context.Context, migrator *migrations.Migrator, command string, args []string) error {
+	switch command {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		for _, migration := range applied {
+			fmt.Printf("applied %d_%s\n", migration.Version, migration.Name)
+		}
+		if err == nil && len(applied) == 0 {
+			fmt.Println("no pending migrations")
+		}
+		return err
+	case "down":
+		steps := 1
+		if len(args) > 0 {
+			parsed, err := strconv.Atoi(args[0])
+			if err != nil || parsed <= 0 {
+				return fmt.Errorf("down expects a positive number of steps, got %q", args[0])
+			}
+			steps = parsed
+		}
+		reverted, err := migrator.Down(ctx, steps)
+		for _, migration := range reverted {
+			fmt.Printf("reverted %d_%s\n", migration.Version, migration.Name)
+		}
+		return err
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED AT")
+		for _, status := range statuses {
+			appliedAt := "pending"
+			if status.AppliedAt != nil {
+				appliedAt = status.AppliedAt.Format(time.RFC3339)
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\n", status.Version, status.Name, appliedAt)
+		}
+		return w.Flush()
+	case "version":
+		version, err := migrator.Version(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Println(version)
+		return nil
+	default:
+		flag.Usage()
+		return fmt.Errorf("unknown command %q", command)
+	}
+}
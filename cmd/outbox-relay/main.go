@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"os/signal"
+	"syscall"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/config"
+	"newTiktoken/internal/common/events"
+	"newTiktoken/internal/common/events/watermill"
+	"newTiktoken/internal/common/logs"
+)
+
+// outbox-relay 把各服务写入 outbox_events 表的事件转发到 Kafka
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	configStore, err := config.Load(ctx, config.WithRequired("mysql.dsn", "kafka.brokers"))
+	if err != nil {
+		logrus.WithError(err).Fatal("Unable to load config")
+	}
+	cfg := configStore.Get()
+	logs.WatchLevel(configStore)
+
+	db, err := cfg.MySQL.Open()
+	if err != nil {
+		logrus.WithError(err).Fatal("Unable to open MySQL connection")
+	}
+	defer db.Close()
+
+	logger := logrus.NewEntry(logrus.StandardLogger())
+	publisher, err := watermill.NewKafkaPublisher(cfg.Kafka.Brokers, logger)
+	if err != nil {
+		logger.WithError(err).Fatal("Unable to create publisher")
+	}
+	defer publisher.Close()
+
+	logger.Info("Starting: outbox relay")
+	if err := events.NewOutboxRelay(db, publisher, logger).Run(ctx); err != nil {
+		logger.WithError(err).Fatal("Outbox relay stopped")
+	}
+}
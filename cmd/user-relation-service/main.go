@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"newTiktoken/internal/common/client"
+	"newTiktoken/internal/common/config"
+	"newTiktoken/internal/common/events"
+	"newTiktoken/internal/common/events/watermill"
+	relationpb "newTiktoken/internal/common/genproto/user_relation"
+	"newTiktoken/internal/common/keys"
+	"newTiktoken/internal/common/logs"
+	"newTiktoken/internal/common/metrics"
+	"newTiktoken/internal/common/server"
+	"newTiktoken/internal/common/tracing"
+	"newTiktoken/internal/user-relation/app"
+	"newTiktoken/internal/user-relation/ports"
+	"newTiktoken/internal/user-relation/service"
+	"os"
+	"time"
+)
+
+func main() {
+	// 后台任务失败时取消 ctx，gRPC 服务优雅退出后执行清理逻辑
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	configStore, err := config.Load(ctx, config.WithRequired("mysql.dsn", "redis.addr", "kafka.brokers"))
+	if err != nil {
+		logrus.WithError(err).Fatal("Unable to load config")
+	}
+	cfg := configStore.Get()
+	logs.WatchLevel(configStore)
+
+	shutdownTracerProvider, err := tracing.InitTracerProvider(ctx, "user-relation-service")
+	if err != nil {
+		logrus.WithError(err).Fatal("Unable to init tracer provider")
+	}
+	defer func() {
+		_ = shutdownTracerProvider(context.Background())
+	}()
+
+	metricsClient := metrics.NewPrometheusMetrics("user_relation_service", prometheus.DefaultRegisterer)
+	go metrics.RunMetricsServerOnAddr(cfg.Metrics.Addr(), prometheus.DefaultGatherer)
+
+	serviceKeys, err := keys.FromConfig(ctx, cfg, "user-relation-service")
+	if err != nil {
+		logrus.WithError(err).Fatal("Unable to load keys")
+	}
+	defer serviceKeys.Close()
+
+	application, healthChecks, cleanup := service.NewApplication(ctx, cfg, metricsClient)
+	defer cleanup()
+
+	reconcileInterval, err := followCountReconcileInterval()
+	if err != nil {
+		logrus.WithError(err).Fatal("Invalid FOLLOW_COUNT_RECONCILE_INTERVAL")
+	}
+	go runFollowCountReconciler(ctx, application, reconcileInterval)
+
+	subscriber, err := watermill.NewKafkaSubscriber(
+		cfg.Kafka.Brokers,
+		"user-relation-service.hot-users",
+		logrus.WithField("component", "relation-events-consumer"),
+	)
+	if err != nil {
+		logrus.WithError(err).Fatal("Unable to create kafka subscriber")
+	}
+	defer subscriber.Close()
+	events.RunConsumers(ctx, cancel, subscriber, ports.NewEventHandlers(application))
+
+	server.RunGRPCServerOnAddr(cfg.GRPC.Addr(), func(srv *grpc.Server) {
+		svc := ports.NewGrpcServer(application)
+		relationpb.RegisterRelationServiceServer(srv, svc)
+	}, server.WithContext(ctx),
+		server.WithConfig(configStore),
+		server.WithKeySet(serviceKeys.Set, cfg.Auth.TokenIssuer),
+		server.WithMetricsClient(metricsClient),
+		server.WithHealthChecks(healthChecks...),
+	)
+}
+
+func followCountReconcileInterval() (time.Duration, error) {
+	value := os.Getenv("FOLLOW_COUNT_RECONCILE_INTERVAL")
+	if value == "" {
+		return time.Hour, nil
+	}
+	return time.ParseDuration(value)
+}
+
+func runFollowCountReconciler(ctx context.Context, application app.Application, interval time.Duration) {
+	etcdClient, err := client.NewEtcdClient()
+	if err != nil {
+		logrus.WithError(err).Warn("Running follow count reconciler without distributed lock")
+		etcdClient = nil
+	} else {
+		defer etcdClient.Close()
+	}
+
+	ports.NewFollowCountReconciler(application, etcdClient, interval).Run(ctx)
+}
@@ -2,18 +2,75 @@ package main
 
 import (
 	"context"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"newTiktoken/internal/common/config"
+	"newTiktoken/internal/common/events"
+	"newTiktoken/internal/common/events/watermill"
 	userpb "newTiktoken/internal/common/genproto/user"
+	"newTiktoken/internal/common/keys"
+	"newTiktoken/internal/common/logs"
+	"newTiktoken/internal/common/metrics"
 	"newTiktoken/internal/common/server"
+	"newTiktoken/internal/common/tracing"
 	"newTiktoken/internal/user/ports"
 	"newTiktoken/internal/user/service"
 )
 
 func main() {
-	ctx := context.Background()
-	application := service.NewApplication(ctx)
-	server.RunGRPCServer(func(srv *grpc.Server) {
+	// 后台任务失败时取消 ctx，gRPC 服务优雅退出后执行清理逻辑
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	configStore, err := config.Load(ctx, config.WithRequired("mysql.dsn", "kafka.brokers"))
+	if err != nil {
+		logrus.WithError(err).Fatal("Unable to load config")
+	}
+	cfg := configStore.Get()
+	logs.WatchLevel(configStore)
+
+	shutdownTracerProvider, err := tracing.InitTracerProvider(ctx, "user-service")
+	if err != nil {
+		logrus.WithError(err).Fatal("Unable to init tracer provider")
+	}
+	defer func() {
+		_ = shutdownTracerProvider(context.Background())
+	}()
+
+	metricsClient := metrics.NewPrometheusMetrics("user_service", prometheus.DefaultRegisterer)
+	go metrics.RunMetricsServerOnAddr(cfg.Metrics.Addr(), prometheus.DefaultGatherer)
+
+	serviceKeys, err := keys.FromConfig(ctx, cfg, "user-service")
+	if err != nil {
+		logrus.WithError(err).Fatal("Unable to load keys")
+	}
+	defer serviceKeys.Close()
+
+	application, healthChecks, cleanup := service.NewApplication(ctx, cfg, metricsClient, serviceKeys.Signer)
+	defer cleanup()
+
+	subscriber, err := watermill.NewKafkaSubscriber(
+		cfg.Kafka.Brokers,
+		"user-service.user-counts",
+		logrus.WithField("component", "events-consumer"),
+	)
+	if err != nil {
+		logrus.WithError(err).Fatal("Unable to create kafka subscriber")
+	}
+	defer subscriber.Close()
+	events.RunConsumers(ctx, cancel, subscriber, ports.NewEventHandlers(application))
+
+	server.RunGRPCServerOnAddr(cfg.GRPC.Addr(), func(srv *grpc.Server) {
 		svc := ports.NewGrpcServer(application)
 		userpb.RegisterUserServiceServer(srv, svc)
-	})
+	}, server.WithContext(ctx),
+		server.WithConfig(configStore),
+		server.WithKeySet(serviceKeys.Set, cfg.Auth.TokenIssuer),
+		server.WithMetricsClient(metricsClient),
+		server.WithHealthChecks(healthChecks...),
+		server.WithPublicMethods(
+			"/user_v1.UserService/Register",
+			"/user_v1.UserService/Login",
+		),
+	)
 }
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"newTiktoken/internal/common/client"
+	"newTiktoken/internal/common/config"
+	"newTiktoken/internal/common/events"
+	"newTiktoken/internal/common/events/watermill"
+	favoritepb "newTiktoken/internal/common/genproto/video_favorite"
+	"newTiktoken/internal/common/keys"
+	"newTiktoken/internal/common/logs"
+	"newTiktoken/internal/common/metrics"
+	"newTiktoken/internal/common/server"
+	"newTiktoken/internal/common/tracing"
+	"newTiktoken/internal/video-favorite/app"
+	"newTiktoken/internal/video-favorite/ports"
+	"newTiktoken/internal/video-favorite/service"
+	"os"
+	"time"
+)
+
+func main() {
+	// 后台任务失败时取消 ctx，gRPC 服务优雅退出后执行清理逻辑
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	configStore, err := config.Load(ctx, config.WithRequired("mysql.dsn", "kafka.brokers"))
+	if err != nil {
+		logrus.WithError(err).Fatal("Unable to load config")
+	}
+	cfg := configStore.Get()
+	logs.WatchLevel(configStore)
+
+	shutdownTracerProvider, err := tracing.InitTracerProvider(ctx, "video-favorite-service")
+	if err != nil {
+		logrus.WithError(err).Fatal("Unable to init tracer provider")
+	}
+	defer func() {
+		_ = shutdownTracerProvider(context.Background())
+	}()
+
+	metricsClient := metrics.NewPrometheusMetrics("video_favorite_service", prometheus.DefaultRegisterer)
+	go metrics.RunMetricsServerOnAddr(cfg.Metrics.Addr(), prometheus.DefaultGatherer)
+
+	serviceKeys, err := keys.FromConfig(ctx, cfg, "video-favorite-service")
+	if err != nil {
+		logrus.WithError(err).Fatal("Unable to load keys")
+	}
+	defer serviceKeys.Close()
+
+	application, healthChecks, cleanup := service.NewApplication(ctx, cfg, metricsClient)
+	defer cleanup()
+
+	reconcileInterval, err := favoriteCountReconcileInterval()
+	if err != nil {
+		logrus.WithError(err).Fatal("Invalid FAVORITE_COUNT_RECONCILE_INTERVAL")
+	}
+	go runFavoriteCountReconciler(ctx, application, reconcileInterval)
+
+	subscriber, err := watermill.NewKafkaSubscriber(
+		cfg.Kafka.Brokers,
+		"video-favorite-service.favorite-counts",
+		logrus.WithField("component", "favorite-events-consumer"),
+	)
+	if err != nil {
+		logrus.WithError(err).Fatal("Unable to create kafka subscriber")
+	}
+	defer subscriber.Close()
+	events.RunConsumers(ctx, cancel, subscriber, ports.NewEventHandlers(application))
+
+	server.RunGRPCServerOnAddr(cfg.GRPC.Addr(), func(srv *grpc.Server) {
+		svc := ports.NewGrpcServer(application)
+		favoritepb.RegisterFavoriteServiceServer(srv, svc)
+	}, server.WithContext(ctx),
+		server.WithConfig(configStore),
+		server.WithKeySet(serviceKeys.Set, cfg.Auth.TokenIssuer),
+		server.WithMetricsClient(metricsClient),
+		server.WithHealthChecks(healthChecks...),
+	)
+}
+
+func favoriteCountReconcileInterval() (time.Duration, error) {
+	value := os.Getenv("FAVORITE_COUNT_RECONCILE_INTERVAL")
+	if value == "" {
+		return time.Hour, nil
+	}
+	return time.ParseDuration(value)
+}
+
+func runFavoriteCountReconciler(ctx context.Context, application app.Application, interval time.Duration) {
+	etcdClient, err := client.NewEtcdClient()
+	if err != nil {
+		logrus.WithError(err).Warn("Running favorite count reconciler without distributed lock")
+		etcdClient = nil
+	} else {
+		defer etcdClient.Close()
+	}
+
+	ports.NewFavoriteCountReconciler(application, etcdClient, interval).Run(ctx)
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"newTiktoken/internal/common/config"
+	videopb "newTiktoken/internal/common/genproto/video"
+	"newTiktoken/internal/common/keys"
+	"newTiktoken/internal/common/logs"
+	"newTiktoken/internal/common/metrics"
+	"newTiktoken/internal/common/server"
+	"newTiktoken/internal/common/tracing"
+	"newTiktoken/internal/video/ports"
+	"newTiktoken/internal/video/service"
+)
+
+func main() {
+	ctx := context.Background()
+	configStore, err := config.Load(ctx, config.WithRequired("mysql.dsn"))
+	if err != nil {
+		logrus.WithError(err).Fatal("Unable to load config")
+	}
+	cfg := configStore.Get()
+	logs.WatchLevel(configStore)
+
+	shutdownTracerProvider, err := tracing.InitTracerProvider(ctx, "video-service")
+	if err != nil {
+		logrus.WithError(err).Fatal("Unable to init tracer provider")
+	}
+	defer func() {
+		_ = shutdownTracerProvider(context.Background())
+	}()
+
+	metricsClient := metrics.NewPrometheusMetrics("video_service", prometheus.DefaultRegisterer)
+	go metrics.RunMetricsServerOnAddr(cfg.Metrics.Addr(), prometheus.DefaultGatherer)
+
+	serviceKeys, err := keys.FromConfig(ctx, cfg, "video-service")
+	if err != nil {
+		logrus.WithError(err).Fatal("Unable to load keys")
+	}
+	defer serviceKeys.Close()
+
+	application, healthChecks, cleanup := service.NewApplication(ctx, cfg, metricsClient)
+	defer cleanup()
+
+	server.RunGRPCServerOnAddr(cfg.GRPC.Addr(), func(srv *grpc.Server) {
+		svc := ports.NewGrpcServer(application)
+		videopb.RegisterVideoServiceServer(srv, svc)
+	}, server.WithConfig(configStore),
+		server.WithKeySet(serviceKeys.Set, cfg.Auth.TokenIssuer),
+		server.WithMetricsClient(metricsClient),
+		server.WithHealthChecks(healthChecks...),
+	)
+}
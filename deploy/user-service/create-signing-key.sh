@@ -0,0 +1,18 @@
+#!/bin/bash
+
+# 脚本：生成 user-service 签发 access token 使用的 P-256 私钥，保存到 Secret jwt-signing-keys。
+# 公钥由 user-service 启动时发布到 etcd，其他服务和 api-router 从 etcd 获取。
+# 更换私钥时重新运行此脚本并重启 user-service，旧公钥在 KEYS_GRACE_PERIOD 内仍然有效。
+#
+# !!! 注意：请务必在项目的根目录下运行此脚本 !!!
+
+set -e
+
+KEY_FILE="$(mktemp)"
+trap 'rm -f "$KEY_FILE"' EXIT
+
+openssl ecparam -name prime256v1 -genkey -noout | openssl pkcs8 -topk8 -nocrypt -out "$KEY_FILE"
+kubectl create secret generic jwt-signing-keys \
+  --from-file=user-service.key="$KEY_FILE" \
+  --dry-run=client -o yaml | kubectl apply -f -
+echo "✅ 私钥已生成，重启 user-service 后生效。"
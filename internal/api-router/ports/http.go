@@ -0,0 +1,21 @@
+package ports
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"newTiktoken/internal/api-router/routes"
+	"newTiktoken/internal/common/keys"
+)
+
+// HandlerFromMux 注册 POST /{route}/{method}，先检查服务是否上线，再校验 token，最后转发给 gRPC 服务
+func HandlerFromMux(router chi.Router, table routes.Table, keySet keys.Set, proxy http.Handler) http.Handler {
+	router.Get("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	router.With(
+		ServiceAvailabilityMiddleware(table, keySet),
+		TokenAuthMiddleware(table, keySet),
+	).Post("/{"+routeParam+"}/{"+methodParam+"}", proxy.ServeHTTP)
+	return router
+}
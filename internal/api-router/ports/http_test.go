@@ -0,0 +1,245 @@
+package ports_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+	"newTiktoken/internal/api-router/ports"
+	"newTiktoken/internal/api-router/routes"
+	"newTiktoken/internal/common/auth"
+	commonerrors "newTiktoken/internal/common/errors"
+	userpb "newTiktoken/internal/common/genproto/user"
+	"newTiktoken/internal/common/keys"
+	"newTiktoken/internal/common/logs"
+	"newTiktoken/internal/common/server"
+)
+
+var table = routes.Table{
+	Services: map[string]routes.Service{
+		"user": {
+			Name:        "user-service",
+			Address:     "bufnet",
+			GRPCService: "user_v1.UserService",
+			DependsOn:   "user-service",
+		},
+		"video": {
+			Name:        "video-service",
+			Address:     "bufnet",
+			GRPCService: "video.VideoService",
+			DependsOn:   "user-service",
+		},
+	},
+	SkipRoutes: []string{"user/Login"},
+}
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	signingKey := newKey(t)
+	keyID, err := keys.KeyID(&signingKey.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// video-service 没有登记公钥，视为未上线
+	keySet := keys.StaticSet{"user-service": {keyID: &signingKey.PublicKey}}
+	handler := newTestHandler(t, keySet)
+
+	validToken := issueToken(t, auth.ES256TokenIssuer{PrivateKey: signingKey, KeyID: keyID, TTL: time.Hour})
+	otherKey := newKey(t)
+	forgedToken := issueToken(t, auth.ES256TokenIssuer{PrivateKey: otherKey, KeyID: keyID, TTL: time.Hour})
+
+	testCases := []struct {
+		Name           string
+		Path           string
+		Body           string
+		Token          string
+		ExpectedStatus int
+		ExpectedBody   string
+	}{
+		{
+			Name:           "skipped_route_without_token",
+			Path:           "/user/Login",
+			Body:           `{"username": "alice", "password": "password"}`,
+			ExpectedStatus: http.StatusOK,
+			ExpectedBody:   `"access_token":"token-for-alice"`,
+		},
+		{
+			Name:           "missing_token",
+			Path:           "/user/GetUserInformation",
+			Body:           `{"uuid": "user-a"}`,
+			ExpectedStatus: http.StatusUnauthorized,
+			ExpectedBody:   `"slug":"empty-bearer-token"`,
+		},
+		{
+			Name:           "token_signed_by_other_key",
+			Path:           "/user/GetUserInformation",
+			Body:           `{"uuid": "user-a"}`,
+			Token:          forgedToken,
+			ExpectedStatus: http.StatusUnauthorized,
+			ExpectedBody:   `"slug":"unable-to-verify-jwt"`,
+		},
+		{
+			Name:           "valid_token_is_forwarded",
+			Path:           "/user/GetUserInformation",
+			Body:           `{"uuid": "user-a"}`,
+			Token:          validToken,
+			ExpectedStatus: http.StatusOK,
+			ExpectedBody:   `"name":"Bearer ` + validToken + `"`,
+		},
+		{
+			Name:           "grpc_error_slug",
+			Path:           "/user/GetUserInformation",
+			Body:           `{"uuid": "missing"}`,
+			Token:          validToken,
+			ExpectedStatus: http.StatusNotFound,
+			ExpectedBody:   `"slug":"user-not-found"`,
+		},
+		{
+			Name:           "invalid_body",
+			Path:           "/user/GetUserInformation",
+			Body:           `{"unknown_field": 1}`,
+			Token:          validToken,
+			ExpectedStatus: http.StatusBadRequest,
+			ExpectedBody:   `"slug":"invalid-request-body"`,
+		},
+		{
+			Name:           "unknown_method",
+			Path:           "/user/DeleteUser",
+			Token:          validToken,
+			ExpectedStatus: http.StatusNotFound,
+			ExpectedBody:   `"slug":"unknown-method"`,
+		},
+		{
+			Name:           "unknown_route",
+			Path:           "/shop/Buy",
+			Token:          validToken,
+			ExpectedStatus: http.StatusNotFound,
+			ExpectedBody:   `"slug":"unknown-route"`,
+		},
+		{
+			Name:           "service_without_public_key",
+			Path:           "/video/Feed",
+			Token:          validToken,
+			ExpectedStatus: http.StatusServiceUnavailable,
+			ExpectedBody:   `"slug":"service-unavailable"`,
+		},
+	}
+	for _, c := range testCases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodPost, c.Path, strings.NewReader(c.Body))
+			if c.Token != "" {
+				req.Header.Set("Authorization", "Bearer "+c.Token)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != c.ExpectedStatus {
+				t.Errorf("expected status %d, got %d: %s", c.ExpectedStatus, rec.Code, rec.Body)
+			}
+			if body := compactJSON(t, rec.Body.Bytes()); !strings.Contains(body, c.ExpectedBody) {
+				t.Errorf("expected body to contain %s, got %s", c.ExpectedBody, body)
+			}
+		})
+	}
+}
+
+// testUserServer 在 GetUserInformation 的 name 中返回收到的 authorization
+type testUserServer struct {
+	userpb.UnimplementedUserServiceServer
+}
+
+func (testUserServer) Login(_ context.Context, req *userpb.LoginRequest) (*userpb.LoginResponse, error) {
+	return &userpb.LoginResponse{UserUuid: "user-" + req.GetUsername(), AccessToken: "token-for-" + req.GetUsername()}, nil
+}
+
+func (testUserServer) GetUserInformation(ctx context.Context, req *userpb.GetUserInformationRequest) (*userpb.User, error) {
+	if req.GetUuid() == "missing" {
+		return nil, commonerrors.NewNotFoundError("user not found", "user-not-found")
+	}
+	return &userpb.User{Uuid: req.GetUuid(), Name: strings.Join(metadata.ValueFromIncomingContext(ctx, "authorization"), ",")}, nil
+}
+
+func newTestHandler(t *testing.T, keySet keys.Set) http.Handler {
+	t.Helper()
+
+	listener := bufconn.Listen(1024 * 1024)
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(server.ErrorTranslationUnaryServerInterceptor()))
+	userpb.RegisterUserServiceServer(grpcServer, testUserServer{})
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	proxy, err := ports.NewGRPCProxy(table, map[string]grpc.ClientConnInterface{"user": conn, "video": conn})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// httperr 从 context 中获取日志，与 server.RunHTTPServer 一样先添加日志中间件
+	router := chi.NewRouter()
+	router.Use(logs.NewStructuredLogger(logrus.StandardLogger()))
+	return ports.HandlerFromMux(router, table, keySet, proxy)
+}
+
+func newKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return privateKey
+}
+
+func issueToken(t *testing.T, issuer auth.ES256TokenIssuer) string {
+	t.Helper()
+
+	token, err := issuer.IssueToken(auth.User{UUID: "user-a", Role: auth.RoleUser})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token.Token
+}
+
+// compactJSON 去掉 protojson 随机加入的空白，便于比较
+func compactJSON(t *testing.T, data []byte) string {
+	t.Helper()
+
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		t.Fatalf("invalid json %s: %v", data, err)
+	}
+	compact, err := json.Marshal(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(compact)
+}
@@ -0,0 +1,87 @@
+package ports
+
+import (
+	"crypto/ecdsa"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/pkg/errors"
+	"newTiktoken/internal/api-router/routes"
+	"newTiktoken/internal/common/auth"
+	"newTiktoken/internal/common/keys"
+	"newTiktoken/internal/common/server/httperr"
+)
+
+// 请求路径为 /api/{route}/{method}
+const (
+	routeParam  = "route"
+	methodParam = "method"
+)
+
+// TokenAuthMiddleware 使用路由所依赖服务的公钥校验 bearer token，通过后把用户放入 context
+// table.SkipRoutes 中的请求不校验，但携带的 authorization 仍会转发给下游服务
+func TokenAuthMiddleware(table routes.Table, keySet keys.Set) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route, method := chi.URLParam(r, routeParam), chi.URLParam(r, methodParam)
+			if table.Skip(route, method) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			service, ok := table.Services[route]
+			if !ok {
+				httperr.NotFound("unknown-route", errors.Errorf("unknown route %q", route), w, r)
+				return
+			}
+
+			bearerToken := tokenFromHeader(r)
+			if bearerToken == "" {
+				httperr.Unauthorised("empty-bearer-token", nil, w, r)
+				return
+			}
+			verifier := auth.ES256TokenVerifier{PublicKeys: func() map[string]*ecdsa.PublicKey {
+				return keySet.PublicKeys(service.DependsOn)
+			}}
+			user, err := verifier.VerifyToken(r.Context(), bearerToken)
+			if err != nil {
+				httperr.Unauthorised("unable-to-verify-jwt", err, w, r)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(auth.ContextWithUser(r.Context(), user)))
+		})
+	}
+}
+
+// ServiceAvailabilityMiddleware 拒绝转发到没有正在使用的公钥的服务，服务登记公钥后才认为已上线
+func ServiceAvailabilityMiddleware(table routes.Table, keySet keys.Set) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route := chi.URLParam(r, routeParam)
+			service, ok := table.Services[route]
+			if !ok {
+				httperr.NotFound("unknown-route", errors.Errorf("unknown route %q", route), w, r)
+				return
+			}
+			if !keySet.Online(service.Name) {
+				httperr.ServiceUnavailable(
+					"service-unavailable",
+					errors.Errorf("service %s is offline", service.Name),
+					w, r,
+				)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func tokenFromHeader(r *http.Request) string {
+	headerValue := r.Header.Get("Authorization")
+	if len(headerValue) > 7 && strings.EqualFold(headerValue[:7], "bearer ") {
+		return headerValue[7:]
+	}
+	return ""
+}
@@ -0,0 +1,152 @@
+package ports
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"newTiktoken/internal/api-router/routes"
+	"newTiktoken/internal/common/server"
+	"newTiktoken/internal/common/server/httperr"
+
+	// 注册各服务的 proto 描述，代理按 grpc_service 查找方法
+	_ "newTiktoken/internal/common/genproto/user"
+	_ "newTiktoken/internal/common/genproto/user_relation"
+	_ "newTiktoken/internal/common/genproto/video"
+	_ "newTiktoken/internal/common/genproto/video_comment"
+	_ "newTiktoken/internal/common/genproto/video_favorite"
+)
+
+// maxRequestBodySize 限制转发的 JSON 请求体大小
+const maxRequestBodySize = 1 << 20
+
+var (
+	unmarshalOptions = protojson.UnmarshalOptions{}
+	marshalOptions   = protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}
+)
+
+// GRPCProxy 把 POST /api/{route}/{method} 的 JSON 请求体转换为 gRPC 请求，调用路由对应服务的 unary 方法，
+// 并把响应转换为 JSON；字段名使用 proto 中的名字，请求也接受 lowerCamelCase
+type GRPCProxy struct {
+	services map[string]proxiedService
+}
+
+type proxiedService struct {
+	conn       grpc.ClientConnInterface
+	descriptor protoreflect.ServiceDescriptor
+}
+
+// NewGRPCProxy 中 conns 的 key 与 table.Services 相同
+func NewGRPCProxy(table routes.Table, conns map[string]grpc.ClientConnInterface) (GRPCProxy, error) {
+	services := make(map[string]proxiedService, len(table.Services))
+	for route, service := range table.Services {
+		conn, ok := conns[route]
+		if !ok {
+			return GRPCProxy{}, errors.Errorf("no connection for route %s", route)
+		}
+		descriptor, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(service.GRPCService))
+		if err != nil {
+			return GRPCProxy{}, errors.Wrapf(err, "unknown grpc service %s", service.GRPCService)
+		}
+		serviceDescriptor, ok := descriptor.(protoreflect.ServiceDescriptor)
+		if !ok {
+			return GRPCProxy{}, errors.Errorf("%s is not a grpc service", service.GRPCService)
+		}
+		services[route] = proxiedService{conn: conn, descriptor: serviceDescriptor}
+	}
+	return GRPCProxy{services: services}, nil
+}
+
+func (p GRPCProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	route, method := chi.URLParam(r, routeParam), chi.URLParam(r, methodParam)
+	service, ok := p.services[route]
+	if !ok {
+		httperr.NotFound("unknown-route", errors.Errorf("unknown route %q", route), w, r)
+		return
+	}
+	methodDescriptor := service.descriptor.Methods().ByName(protoreflect.Name(method))
+	if methodDescriptor == nil || methodDescriptor.IsStreamingClient() || methodDescriptor.IsStreamingServer() {
+		httperr.NotFound("unknown-method", errors.Errorf("unknown method %s/%s", route, method), w, r)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestBodySize))
+	if err != nil {
+		httperr.BadRequest("unable-to-read-body", err, w, r)
+		return
+	}
+	req := dynamicpb.NewMessage(methodDescriptor.Input())
+	if len(body) > 0 {
+		if err := unmarshalOptions.Unmarshal(body, req); err != nil {
+			httperr.BadRequest("invalid-request-body", err, w, r)
+			return
+		}
+	}
+
+	ctx := r.Context()
+	if authorization := r.Header.Get("Authorization"); authorization != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", authorization)
+	}
+	resp := dynamicpb.NewMessage(methodDescriptor.Output())
+	fullMethod := "/" + string(service.descriptor.FullName()) + "/" + method
+	if err := service.conn.Invoke(ctx, fullMethod, req, resp); err != nil {
+		respondWithGRPCError(err, w, r)
+		return
+	}
+
+	data, err := marshalOptions.Marshal(resp)
+	if err != nil {
+		httperr.InternalError("unable-to-marshal-response", err, w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data)
+}
+
+// respondWithGRPCError 与 server.ErrorTranslationUnaryServerInterceptor 相反，把 gRPC 状态码转换为 HTTP 状态码，
+// 下游返回的 ErrorInfo 中的 slug 原样返回给客户端
+func respondWithGRPCError(err error, w http.ResponseWriter, r *http.Request) {
+	st := status.Convert(err)
+	slug := grpcErrorSlug(st)
+
+	switch st.Code() {
+	case codes.InvalidArgument, codes.OutOfRange:
+		httperr.BadRequest(slug, err, w, r)
+	case codes.Unauthenticated, codes.PermissionDenied:
+		httperr.Unauthorised(slug, err, w, r)
+	case codes.NotFound:
+		httperr.NotFound(slug, err, w, r)
+	case codes.AlreadyExists, codes.Aborted:
+		httperr.Conflict(slug, err, w, r)
+	case codes.FailedPrecondition:
+		httperr.PreconditionFailed(slug, err, w, r)
+	case codes.ResourceExhausted:
+		httperr.TooManyRequests(slug, err, w, r)
+	case codes.Unavailable, codes.DeadlineExceeded:
+		httperr.ServiceUnavailable(slug, err, w, r)
+	default:
+		httperr.InternalError(slug, err, w, r)
+	}
+}
+
+func grpcErrorSlug(st *status.Status) string {
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok && info.GetDomain() == server.ErrorInfoDomain {
+			return info.GetReason()
+		}
+	}
+	if st.Code() == codes.Unavailable || st.Code() == codes.DeadlineExceeded {
+		return "service-unavailable"
+	}
+	return "internal-server-error"
+}
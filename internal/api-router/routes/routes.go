@@ -0,0 +1,94 @@
+package routes
+
+import (
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// Service 是 /api/<route>/<Method> 转发的 gRPC 服务
+type Service struct {
+	// Name 是服务在 keys 中登记公钥使用的名字，没有公钥时认为服务未上线
+	Name string `yaml:"name"`
+	// Address 是 gRPC 服务的地址
+	Address string `yaml:"address"`
+	// GRPCService 是 proto 中服务的全名，如 user_v1.UserService
+	GRPCService string `yaml:"grpc_service"`
+	// DependsOn 是签发请求 token 的服务，使用它的公钥校验 token
+	DependsOn string `yaml:"depends_on"`
+}
+
+// Table 是 api-router 的服务依赖映射，key 为请求路径中的 route
+//
+//	services:
+//	  user:
+//	    name: user-service
+//	    address: user-service:50051
+//	    grpc_service: user_v1.UserService
+//	    depends_on: user-service
+//	skip_routes:
+//	  - user/Login
+type Table struct {
+	Services map[string]Service `yaml:"services"`
+	// SkipRoutes 中的 <route>/<Method> 不校验 token，如注册和登录
+	SkipRoutes []string `yaml:"skip_routes"`
+}
+
+// Load 读取并校验 YAML 格式的 Table
+func Load(path string) (Table, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Table{}, errors.Wrapf(err, "unable to read routes %s", path)
+	}
+	return Parse(data)
+}
+
+func Parse(data []byte) (Table, error) {
+	var table Table
+	if err := yaml.Unmarshal(data, &table); err != nil {
+		return Table{}, errors.Wrap(err, "unable to parse routes")
+	}
+	if err := table.validate(); err != nil {
+		return Table{}, err
+	}
+	return table, nil
+}
+
+func (t Table) validate() error {
+	if len(t.Services) == 0 {
+		return errors.New("routes: no services")
+	}
+	var problems []string
+	for route, service := range t.Services {
+		if route == "" || strings.Contains(route, "/") {
+			problems = append(problems, "invalid route "+route)
+		}
+		if service.Name == "" || service.Address == "" || service.GRPCService == "" || service.DependsOn == "" {
+			problems = append(problems, "route "+route+": name, address, grpc_service and depends_on are required")
+		}
+	}
+	for _, skipRoute := range t.SkipRoutes {
+		route, method, ok := strings.Cut(skipRoute, "/")
+		if _, exists := t.Services[route]; !ok || !exists || method == "" {
+			problems = append(problems, "invalid skip route "+skipRoute)
+		}
+	}
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return errors.Errorf("routes: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// Skip 返回 route 的 method 是否不需要校验 token
+func (t Table) Skip(route, method string) bool {
+	for _, skipRoute := range t.SkipRoutes {
+		if skipRoute == route+"/"+method {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,75 @@
+package routes_test
+
+import (
+	"testing"
+
+	"newTiktoken/internal/api-router/routes"
+)
+
+const validRoutes = `
+services:
+  user:
+    name: user-service
+    address: user-service:50051
+    grpc_service: user_v1.UserService
+    depends_on: user-service
+skip_routes:
+  - user/Login
+`
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	table, err := routes.Parse([]byte(validRoutes))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := routes.Service{
+		Name:        "user-service",
+		Address:     "user-service:50051",
+		GRPCService: "user_v1.UserService",
+		DependsOn:   "user-service",
+	}
+	if got := table.Services["user"]; got != expected {
+		t.Errorf("expected %+v, got %+v", expected, got)
+	}
+	if !table.Skip("user", "Login") {
+		t.Error("expected user/Login to be skipped")
+	}
+	if table.Skip("user", "InformationOfUser") {
+		t.Error("expected user/InformationOfUser not to be skipped")
+	}
+}
+
+func TestParseRejectsInvalidTables(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name   string
+		Routes string
+	}{
+		{Name: "no_services", Routes: `skip_routes: []`},
+		{
+			Name: "missing_depends_on",
+			Routes: `
+services:
+  user:
+    name: user-service
+    address: user-service:50051
+    grpc_service: user_v1.UserService
+`,
+		},
+		{Name: "skip_unknown_route", Routes: validRoutes + "  - video/Feed\n"},
+		{Name: "skip_without_method", Routes: validRoutes + "  - user\n"},
+	}
+	for _, c := range testCases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := routes.Parse([]byte(c.Routes)); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
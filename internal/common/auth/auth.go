@@ -74,6 +74,10 @@ var (
 	NoUserInContextError = commonerrors.NewAuthorizationError("no user in context", "no-user-found")
 )
 
+func ContextWithUser(ctx context.Context, user User) context.Context {
+	return context.WithValue(ctx, userContextKey, user)
+}
+
 func UserFromCtx(ctx context.Context) (User, error) {
 	u, ok := ctx.Value(userContextKey).(User)
 	if ok {
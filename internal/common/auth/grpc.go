@@ -0,0 +1,133 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"firebase.google.com/go/v4/auth"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// TokenVerifier 校验 bearer token 并返回 token 对应的用户
+type TokenVerifier interface {
+	VerifyToken(ctx context.Context, token string) (User, error)
+}
+
+type FirebaseTokenVerifier struct {
+	AuthClient *auth.Client
+}
+
+func (f FirebaseTokenVerifier) VerifyToken(ctx context.Context, bearerToken string) (User, error) {
+	token, err := f.AuthClient.VerifyIDToken(ctx, bearerToken)
+	if err != nil {
+		return User{}, errors.Wrap(err, "unable to verify jwt")
+	}
+	return User{
+		UUID:        token.UID,
+		Email:       stringClaim(token.Claims, "email"),
+		Role:        stringClaim(token.Claims, "role"),
+		DisplayName: stringClaim(token.Claims, "name"),
+	}, nil
+}
+
+// MockTokenVerifier 与 HttpMockMiddleware 使用相同的密钥和 claims，只用于不依赖 Firebase 的本地环境
+type MockTokenVerifier struct{}
+
+func (MockTokenVerifier) VerifyToken(ctx context.Context, bearerToken string) (User, error) {
+	return JWTTokenVerifier{Secret: []byte(mockSecret)}.VerifyToken(ctx, bearerToken)
+}
+
+func stringClaim(claims map[string]interface{}, key string) string {
+	value, _ := claims[key].(string)
+	return value
+}
+
+// PublicMethods 是不需要认证的 gRPC 方法，包括健康检查和反射
+var PublicMethods = []string{
+	"/grpc.health.v1.Health/Check",
+	"/grpc.health.v1.Health/Watch",
+	"/grpc.reflection.v1.ServerReflection/ServerReflectionInfo",
+	"/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo",
+}
+
+// UnaryServerInterceptor 从 metadata 的 authorization 中校验 bearer token，并把 User 放入 context
+// publicMethods 中的方法（gRPC 完整方法名）跳过认证
+func UnaryServerInterceptor(verifier TokenVerifier, publicMethods ...string) grpc.UnaryServerInterceptor {
+	public := methodSet(publicMethods)
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if _, ok := public[info.FullMethod]; ok {
+			return handler(ctx, req)
+		}
+		ctx, err := authenticate(ctx, verifier)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func StreamServerInterceptor(verifier TokenVerifier, publicMethods ...string) grpc.StreamServerInterceptor {
+	public := methodSet(publicMethods)
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if _, ok := public[info.FullMethod]; ok {
+			return handler(srv, ss)
+		}
+		ctx, err := authenticate(ss.Context(), verifier)
+		if err != nil {
+			return err
+		}
+		return handler(srv, authenticatedServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+type authenticatedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s authenticatedServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func authenticate(ctx context.Context, verifier TokenVerifier) (context.Context, error) {
+	bearerToken := TokenFromMetadata(ctx)
+	if bearerToken == "" {
+		return nil, status.Error(codes.Unauthenticated, "empty bearer token")
+	}
+	user, err := verifier.VerifyToken(ctx, bearerToken)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unable to verify bearer token")
+	}
+	return ContextWithUser(ctx, user), nil
+}
+
+// GRPCUserFromCtx 返回认证拦截器放入 context 的用户，不存在时返回 Unauthenticated 状态，供 gRPC handler 直接返回
+func GRPCUserFromCtx(ctx context.Context) (User, error) {
+	user, err := UserFromCtx(ctx)
+	if err != nil {
+		return User{}, status.Error(codes.Unauthenticated, err.Error())
+	}
+	return user, nil
+}
+
+// TokenFromMetadata 返回 incoming metadata 中 authorization 的 bearer token
+func TokenFromMetadata(ctx context.Context) string {
+	for _, value := range metadata.ValueFromIncomingContext(ctx, "authorization") {
+		if len(value) > 7 && strings.ToLower(value[0:6]) == "bearer" {
+			return value[7:]
+		}
+	}
+	return ""
+}
+
+func methodSet(methods []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(methods))
+	for _, method := range methods {
+		set[method] = struct{}{}
+	}
+	return set
+}
@@ -0,0 +1,98 @@
+package auth_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"newTiktoken/internal/common/auth"
+)
+
+func newMockToken(t *testing.T, claims jwt.MapClaims, secret string) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func incomingContextWithToken(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func callUnary(ctx context.Context, method string, publicMethods ...string) (auth.User, error) {
+	interceptor := auth.UnaryServerInterceptor(auth.MockTokenVerifier{}, publicMethods...)
+	var user auth.User
+	_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, _ any) (any, error) {
+		u, err := auth.UserFromCtx(ctx)
+		user = u
+		return nil, err
+	})
+	return user, err
+}
+
+func TestUnaryServerInterceptorPutsUserInContext(t *testing.T) {
+	t.Parallel()
+	token := newMockToken(t, jwt.MapClaims{
+		"user_uuid": "user-a",
+		"email":     "a@example.com",
+		"role":      "user",
+		"name":      "A",
+	}, "mock_secret")
+
+	user, err := callUnary(incomingContextWithToken(token), "/user_v1.UserService/UpdateUser")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.UUID != "user-a" || user.Email != "a@example.com" || user.DisplayName != "A" {
+		t.Errorf("unexpected user %+v", user)
+	}
+}
+
+func TestUnaryServerInterceptorRejectsInvalidTokens(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		Name string
+		Ctx  context.Context
+	}{
+		{
+			Name: "missing_metadata",
+			Ctx:  context.Background(),
+		},
+		{
+			Name: "wrong_secret",
+			Ctx:  incomingContextWithToken(newMockToken(t, jwt.MapClaims{"user_uuid": "user-a"}, "other_secret")),
+		},
+		{
+			Name: "missing_user_uuid",
+			Ctx:  incomingContextWithToken(newMockToken(t, jwt.MapClaims{"email": "a@example.com"}, "mock_secret")),
+		},
+		{
+			Name: "not_bearer",
+			Ctx:  metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Basic abc")),
+		},
+	}
+	for _, c := range testCases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+			_, err := callUnary(c.Ctx, "/user_v1.UserService/UpdateUser")
+			if status.Code(err) != codes.Unauthenticated {
+				t.Errorf("expected Unauthenticated, got %v", err)
+			}
+		})
+	}
+}
+
+func TestUnaryServerInterceptorSkipsPublicMethods(t *testing.T) {
+	t.Parallel()
+	_, err := callUnary(context.Background(), "/grpc.health.v1.Health/Check", auth.PublicMethods...)
+	if err != auth.NoUserInContextError {
+		t.Errorf("expected handler to run without user, got %v", err)
+	}
+}
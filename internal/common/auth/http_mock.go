@@ -4,8 +4,8 @@ import (
 	"context"
 	"net/http"
 
-	"github.com/dgrijalva/jwt-go"
-	"github.com/dgrijalva/jwt-go/request"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/golang-jwt/jwt/v5/request"
 	"newTiktoken/internal/common/server/httperr"
 )
 
@@ -17,7 +17,7 @@ func HttpMockMiddleware(next http.Handler) http.Handler {
 			r,
 			request.AuthorizationHeaderExtractor,
 			func(token *jwt.Token) (i interface{}, e error) {
-				return []byte("mock_secret"), nil
+				return []byte(mockSecret), nil
 			},
 			request.WithClaims(&claims),
 		)
@@ -0,0 +1,132 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+)
+
+// mockSecret 是 HttpMockMiddleware 和 MockTokenVerifier 使用的密钥，只用于本地环境
+const mockSecret = "mock_secret"
+
+// access token 的 claims 与 HttpMockMiddleware 读取的一致
+const (
+	claimUserUUID = "user_uuid"
+	claimEmail    = "email"
+	claimRole     = "role"
+	claimName     = "name"
+)
+
+// JWTTokenVerifier 校验 JWTTokenIssuer 使用同一密钥签发的 HS256 access token
+type JWTTokenVerifier struct {
+	Secret []byte
+}
+
+func (v JWTTokenVerifier) VerifyToken(_ context.Context, bearerToken string) (User, error) {
+	return parseToken(bearerToken, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.Errorf("unexpected signing method %v", token.Header["alg"])
+		}
+		return v.Secret, nil
+	})
+}
+
+// ES256TokenVerifier 使用签发服务登记的公钥校验 ES256TokenIssuer 签发的 token
+// PublicKeys 在每次校验时调用，返回的 key 为 kid，因此公钥轮换后不需要重新创建 verifier
+type ES256TokenVerifier struct {
+	PublicKeys func() map[string]*ecdsa.PublicKey
+}
+
+func (v ES256TokenVerifier) VerifyToken(_ context.Context, bearerToken string) (User, error) {
+	return parseToken(bearerToken, func(token *jwt.Token) (interface{}, error) {
+		if token.Method != jwt.SigningMethodES256 {
+			return nil, errors.Errorf("unexpected signing method %v", token.Header["alg"])
+		}
+		keyID, _ := token.Header["kid"].(string)
+		publicKey, ok := v.PublicKeys()[keyID]
+		if !ok {
+			return nil, errors.Errorf("unknown key id %q", keyID)
+		}
+		return publicKey, nil
+	})
+}
+
+func parseToken(bearerToken string, keyFunc jwt.Keyfunc) (User, error) {
+	var claims jwt.MapClaims
+	token, err := jwt.ParseWithClaims(bearerToken, &claims, keyFunc)
+	if err != nil {
+		return User{}, errors.Wrap(err, "unable to parse jwt")
+	}
+	if !token.Valid {
+		return User{}, errors.New("invalid jwt")
+	}
+
+	userUUID := stringClaim(claims, claimUserUUID)
+	if userUUID == "" {
+		return User{}, errors.New("empty user_uuid claim")
+	}
+	return User{
+		UUID:        userUUID,
+		Email:       stringClaim(claims, claimEmail),
+		Role:        stringClaim(claims, claimRole),
+		DisplayName: stringClaim(claims, claimName),
+	}, nil
+}
+
+// AccessToken 是签发给客户端的 bearer token
+type AccessToken struct {
+	Token     string
+	ExpiresAt time.Time
+}
+
+// JWTTokenIssuer 使用 HS256 签发有效期为 TTL 的 access token
+type JWTTokenIssuer struct {
+	Secret []byte
+	TTL    time.Duration
+}
+
+func (i JWTTokenIssuer) IssueToken(user User) (AccessToken, error) {
+	if len(i.Secret) == 0 {
+		return AccessToken{}, errors.New("empty jwt secret")
+	}
+	return signToken(jwt.SigningMethodHS256, i.Secret, "", user, i.TTL)
+}
+
+// ES256TokenIssuer 使用服务的私钥签发 access token，kid 为公钥的 keys.KeyID
+// 其他服务和 api-router 使用该服务登记的公钥校验，无需共享密钥
+type ES256TokenIssuer struct {
+	PrivateKey *ecdsa.PrivateKey
+	KeyID      string
+	TTL        time.Duration
+}
+
+func (i ES256TokenIssuer) IssueToken(user User) (AccessToken, error) {
+	if i.PrivateKey == nil {
+		return AccessToken{}, errors.New("empty private key")
+	}
+	return signToken(jwt.SigningMethodES256, i.PrivateKey, i.KeyID, user, i.TTL)
+}
+
+func signToken(method jwt.SigningMethod, key interface{}, keyID string, user User, ttl time.Duration) (AccessToken, error) {
+	now := time.Now()
+	expiresAt := now.Add(ttl)
+	token := jwt.NewWithClaims(method, jwt.MapClaims{
+		claimUserUUID: user.UUID,
+		claimEmail:    user.Email,
+		claimRole:     user.Role,
+		claimName:     user.DisplayName,
+		"iat":         now.Unix(),
+		"exp":         expiresAt.Unix(),
+	})
+	if keyID != "" {
+		token.Header["kid"] = keyID
+	}
+	signed, err := token.SignedString(key)
+	if err != nil {
+		return AccessToken{}, errors.Wrap(err, "unable to sign jwt")
+	}
+	return AccessToken{Token: signed, ExpiresAt: expiresAt}, nil
+}
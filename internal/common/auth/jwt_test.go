@@ -0,0 +1,140 @@
+package auth_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"newTiktoken/internal/common/auth"
+)
+
+func TestJWTTokenIssuerIssuesTokenReadableByVerifier(t *testing.T) {
+	t.Parallel()
+
+	user := auth.User{UUID: "user-a", Email: "a@example.com", Role: auth.RoleUser, DisplayName: "A"}
+	issuer := auth.JWTTokenIssuer{Secret: []byte("secret"), TTL: time.Hour}
+
+	token, err := issuer.IssueToken(user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if until := time.Until(token.ExpiresAt); until <= 59*time.Minute || until > time.Hour {
+		t.Errorf("unexpected expiration %s", token.ExpiresAt)
+	}
+
+	got, err := auth.JWTTokenVerifier{Secret: []byte("secret")}.VerifyToken(context.Background(), token.Token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != user {
+		t.Errorf("expected %+v, got %+v", user, got)
+	}
+}
+
+func TestJWTTokenVerifierRejectsInvalidTokens(t *testing.T) {
+	t.Parallel()
+
+	user := auth.User{UUID: "user-a", Role: auth.RoleUser}
+	testCases := []struct {
+		Name   string
+		Issuer auth.JWTTokenIssuer
+	}{
+		{
+			Name:   "other_secret",
+			Issuer: auth.JWTTokenIssuer{Secret: []byte("other_secret"), TTL: time.Hour},
+		},
+		{
+			Name:   "expired",
+			Issuer: auth.JWTTokenIssuer{Secret: []byte("secret"), TTL: -time.Minute},
+		},
+	}
+
+	for _, c := range testCases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			token, err := c.Issuer.IssueToken(user)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := (auth.JWTTokenVerifier{Secret: []byte("secret")}).VerifyToken(context.Background(), token.Token); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestES256TokenVerifier(t *testing.T) {
+	t.Parallel()
+
+	user := auth.User{UUID: "user-a", Email: "a@example.com", Role: auth.RoleUser, DisplayName: "A"}
+	privateKey := newES256Key(t)
+	otherKey := newES256Key(t)
+	verifier := auth.ES256TokenVerifier{PublicKeys: func() map[string]*ecdsa.PublicKey {
+		return map[string]*ecdsa.PublicKey{"current": &privateKey.PublicKey}
+	}}
+
+	token, err := auth.ES256TokenIssuer{PrivateKey: privateKey, KeyID: "current", TTL: time.Hour}.IssueToken(user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := verifier.VerifyToken(context.Background(), token.Token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != user {
+		t.Errorf("expected %+v, got %+v", user, got)
+	}
+
+	testCases := []struct {
+		Name   string
+		Issuer interface {
+			IssueToken(auth.User) (auth.AccessToken, error)
+		}
+	}{
+		{
+			Name:   "unknown_key_id",
+			Issuer: auth.ES256TokenIssuer{PrivateKey: privateKey, KeyID: "previous", TTL: time.Hour},
+		},
+		{
+			Name:   "other_key",
+			Issuer: auth.ES256TokenIssuer{PrivateKey: otherKey, KeyID: "current", TTL: time.Hour},
+		},
+		{
+			Name:   "expired",
+			Issuer: auth.ES256TokenIssuer{PrivateKey: privateKey, KeyID: "current", TTL: -time.Minute},
+		},
+		{
+			Name:   "hs256",
+			Issuer: auth.JWTTokenIssuer{Secret: []byte("secret"), TTL: time.Hour},
+		},
+	}
+	for _, c := range testCases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			token, err := c.Issuer.IssueToken(user)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := verifier.VerifyToken(context.Background(), token.Token); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func newES256Key(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return privateKey
+}
@@ -0,0 +1,79 @@
+package auth
+
+import (
+	"fmt"
+	"strings"
+
+	commonerrors "newTiktoken/internal/common/errors"
+)
+
+const (
+	RoleAdmin = "admin"
+	// RoleUser 是注册用户的默认角色
+	RoleUser = "user"
+)
+
+// Policy 判断 user 是否可以执行 cmd，拒绝时返回 ErrorTypeAuthorization 的 SlugError
+// 每种命令在自己的包中声明 Policy，由 command handler 在执行前调用
+type Policy[C any] func(user User, cmd C) error
+
+func (p Policy[C]) Authorize(user User, cmd C) error {
+	if user.UUID == "" {
+		return NoUserInContextError
+	}
+	return p(user, cmd)
+}
+
+// Owner 只允许 owner(cmd) 返回的用户执行命令
+func Owner[C any](owner func(cmd C) string) Policy[C] {
+	return func(user User, cmd C) error {
+		if user.UUID == owner(cmd) {
+			return nil
+		}
+		return commonerrors.NewAuthorizationError(
+			fmt.Sprintf("user %s is not allowed to %s on behalf of user %s", user.UUID, commandName(cmd), owner(cmd)),
+			"not-owner",
+		)
+	}
+}
+
+// Role 只允许拥有 role 角色的用户执行命令
+func Role[C any](role string) Policy[C] {
+	return func(user User, cmd C) error {
+		if user.Role == role {
+			return nil
+		}
+		return commonerrors.NewAuthorizationError(
+			fmt.Sprintf("user %s needs role %s to %s", user.UUID, role, commandName(cmd)),
+			"missing-role",
+		)
+	}
+}
+
+// AnyOf 在任意一个 policy 允许时允许，全部拒绝时返回第一个 policy 的错误，没有 policy 时拒绝
+func AnyOf[C any](policies ...Policy[C]) Policy[C] {
+	return func(user User, cmd C) error {
+		if len(policies) == 0 {
+			return commonerrors.NewAuthorizationError(
+				fmt.Sprintf("no policy allows user %s to %s", user.UUID, commandName(cmd)),
+				"no-policy",
+			)
+		}
+		var firstErr error
+		for _, policy := range policies {
+			err := policy(user, cmd)
+			if err == nil {
+				return nil
+			}
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+		return firstErr
+	}
+}
+
+func commandName(cmd any) string {
+	name := fmt.Sprintf("%T", cmd)
+	return name[strings.LastIndex(name, ".")+1:]
+}
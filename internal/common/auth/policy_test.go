@@ -0,0 +1,87 @@
+package auth_test
+
+import (
+	"errors"
+	"testing"
+
+	"newTiktoken/internal/common/auth"
+	commonerrors "newTiktoken/internal/common/errors"
+)
+
+type renameProfile struct {
+	OwnerUUID string
+}
+
+var renameProfilePolicy = auth.AnyOf(
+	auth.Owner(func(cmd renameProfile) string { return cmd.OwnerUUID }),
+	auth.Role[renameProfile](auth.RoleAdmin),
+)
+
+func TestPolicy(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		Name         string
+		Policy       auth.Policy[renameProfile]
+		User         auth.User
+		ExpectedSlug string
+	}{
+		{
+			Name:   "owner_allowed",
+			Policy: renameProfilePolicy,
+			User:   auth.User{UUID: "owner", Role: "user"},
+		},
+		{
+			Name:   "admin_allowed",
+			Policy: renameProfilePolicy,
+			User:   auth.User{UUID: "admin", Role: auth.RoleAdmin},
+		},
+		{
+			Name:         "other_user_denied_with_first_error",
+			Policy:       renameProfilePolicy,
+			User:         auth.User{UUID: "other", Role: "user"},
+			ExpectedSlug: "not-owner",
+		},
+		{
+			Name:         "role_denied",
+			Policy:       auth.Role[renameProfile](auth.RoleAdmin),
+			User:         auth.User{UUID: "owner", Role: "user"},
+			ExpectedSlug: "missing-role",
+		},
+		{
+			Name:         "anonymous_denied",
+			Policy:       renameProfilePolicy,
+			User:         auth.User{},
+			ExpectedSlug: "no-user-found",
+		},
+		{
+			Name:         "empty_any_of_denied",
+			Policy:       auth.AnyOf[renameProfile](),
+			User:         auth.User{UUID: "owner", Role: auth.RoleAdmin},
+			ExpectedSlug: "no-policy",
+		},
+	}
+	for _, c := range testCases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+			err := c.Policy.Authorize(c.User, renameProfile{OwnerUUID: "owner"})
+			if c.ExpectedSlug == "" {
+				if err != nil {
+					t.Fatalf("expected to be allowed, got %v", err)
+				}
+				return
+			}
+
+			var slugError commonerrors.SlugError
+			if !errors.As(err, &slugError) {
+				t.Fatalf("expected SlugError, got %v", err)
+			}
+			if slugError.ErrorType() != commonerrors.ErrorTypeAuthorization {
+				t.Errorf("expected authorization error, got %s", slugError.ErrorType())
+			}
+			if slugError.Slug() != c.ExpectedSlug {
+				t.Errorf("expected slug %s, got %s", c.ExpectedSlug, slugError.Slug())
+			}
+		})
+	}
+}
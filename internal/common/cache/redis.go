@@ -0,0 +1,14 @@
+package cache
+
+import (
+	"github.com/redis/go-redis/v9"
+	"newTiktoken/internal/common/config"
+)
+
+func NewRedisClient(cfg config.RedisConfig) *redis.Client {
+	return redis.NewClient(&redis.Options{
+		Addr:     cfg.Addr,
+		Password: cfg.Password,
+		DB:       cfg.DB,
+	})
+}
@@ -0,0 +1,44 @@
+package cache
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+	"newTiktoken/internal/common/tracing"
+)
+
+// 用户信息缓存由 user 服务写入，user 和 user-relation 服务在修改用户信息或关注数的事务提交后删除
+const userInformationKeyPrefix = "user:information:"
+
+func UserInformationKey(userUUID string) string {
+	return userInformationKeyPrefix + userUUID
+}
+
+// UserInformationInvalidator 删除用户信息缓存，下一次读取时从数据库重新加载
+type UserInformationInvalidator struct {
+	client redis.UniversalClient
+}
+
+func NewUserInformationInvalidator(client redis.UniversalClient) UserInformationInvalidator {
+	if client == nil {
+		panic("nil redis client")
+	}
+	return UserInformationInvalidator{client: client}
+}
+
+func (i UserInformationInvalidator) InvalidateUserInformation(ctx context.Context, userUUIDs ...string) (err error) {
+	ctx, span := tracing.StartRedisSpan(ctx, "UserInformationInvalidator.InvalidateUserInformation")
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	if len(userUUIDs) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(userUUIDs))
+	for _, userUUID := range userUUIDs {
+		keys = append(keys, UserInformationKey(userUUID))
+	}
+	return errors.Wrap(i.client.Del(ctx, keys...).Err(), "failed to invalidate user information cache")
+}
@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+	"os"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	userpb "newTiktoken/internal/common/genproto/user"
+)
+
+func NewUserClient() (client userpb.UserServiceClient, close func() error, err error) {
+	grpcAddr := os.Getenv("USER_GRPC_ADDR")
+	if grpcAddr == "" {
+		return nil, func() error { return nil }, errors.New("empty env USER_GRPC_ADDR")
+	}
+
+	conn, err := grpc.NewClient(grpcAddr, grpcDialOpts()...)
+	if err != nil {
+		return nil, func() error { return nil }, err
+	}
+
+	return userpb.NewUserServiceClient(conn), conn.Close, nil
+}
+
+// NewConn 创建到 addr 的连接，与服务客户端使用相同的拦截器
+func NewConn(addr string) (*grpc.ClientConn, error) {
+	return grpc.NewClient(addr, grpcDialOpts()...)
+}
+
+func grpcDialOpts() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+		grpc.WithUnaryInterceptor(forwardAuthorizationUnaryClientInterceptor),
+	}
+}
+
+// forwardAuthorizationUnaryClientInterceptor 把调用方请求中的 authorization 转发给下游服务，
+// 使下游服务以同一个用户的身份处理请求
+func forwardAuthorizationUnaryClientInterceptor(
+	ctx context.Context,
+	method string,
+	req, reply any,
+	cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	if authorization := metadata.ValueFromIncomingContext(ctx, "authorization"); len(authorization) > 0 {
+		if outgoing, _ := metadata.FromOutgoingContext(ctx); len(outgoing.Get("authorization")) == 0 {
+			ctx = metadata.AppendToOutgoingContext(ctx, "authorization", authorization[0])
+		}
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
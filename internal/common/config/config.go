@@ -0,0 +1,265 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// Config 是各服务共用的配置，按 默认值 -> YAML 文件 -> 环境变量 -> etcd 的顺序覆盖
+// yaml 标签同时是 etcd 中的 key（如 <prefix>/log.level），env 标签是对应的环境变量
+// 带 reload:"true" 的字段在 etcd 中修改后会热更新，其余字段修改后需要重启服务
+type Config struct {
+	GRPC      GRPCConfig      `yaml:"grpc"`
+	Metrics   MetricsConfig   `yaml:"metrics"`
+	MySQL     MySQLConfig     `yaml:"mysql"`
+	Etcd      EtcdConfig      `yaml:"etcd"`
+	Kafka     KafkaConfig     `yaml:"kafka"`
+	Redis     RedisConfig     `yaml:"redis"`
+	Cache     CacheConfig     `yaml:"cache"`
+	Log       LogConfig       `yaml:"log"`
+	RateLimit RateLimitConfig `yaml:"rate_limit"`
+	Auth      AuthConfig      `yaml:"auth"`
+	Keys      KeysConfig      `yaml:"keys"`
+	// PasswordHash 只有 user-service 使用
+	PasswordHash PasswordHashConfig `yaml:"password_hash"`
+	// APIRouter 只有 api-router 使用
+	APIRouter APIRouterConfig `yaml:"api_router"`
+	// Features 是功能开关，只能通过 YAML 文件或 etcd（<prefix>/features.<name>）设置
+	Features map[string]bool `yaml:"features" reload:"true"`
+}
+
+// GRPCConfig 中 ShutdownDelay 是收到退出信号后以 NOT_SERVING 继续接收请求的时间，让探针先把实例摘除
+// ShutdownTimeout 是之后等待进行中请求完成的最长时间
+type GRPCConfig struct {
+	Port                int           `yaml:"port" env:"PORT"`
+	ShutdownDelay       time.Duration `yaml:"shutdown_delay" env:"GRPC_SHUTDOWN_DELAY"`
+	ShutdownTimeout     time.Duration `yaml:"shutdown_timeout" env:"GRPC_SHUTDOWN_TIMEOUT"`
+	HealthCheckInterval time.Duration `yaml:"health_check_interval" env:"HEALTH_CHECK_INTERVAL"`
+}
+
+func (c GRPCConfig) Addr() string {
+	return fmt.Sprintf(":%d", c.Port)
+}
+
+type MetricsConfig struct {
+	Port int `yaml:"port" env:"METRICS_PORT"`
+}
+
+func (c MetricsConfig) Addr() string {
+	return fmt.Sprintf(":%d", c.Port)
+}
+
+type MySQLConfig struct {
+	DSN             string        `yaml:"dsn" env:"MYSQL_DSN"`
+	MaxOpenConns    int           `yaml:"max_open_conns" env:"MYSQL_MAX_OPEN_CONNS"`
+	MaxIdleConns    int           `yaml:"max_idle_conns" env:"MYSQL_MAX_IDLE_CONNS"`
+	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime" env:"MYSQL_CONN_MAX_LIFETIME"`
+}
+
+type EtcdConfig struct {
+	Endpoints   []string      `yaml:"endpoints" env:"ETCD_ENDPOINTS"`
+	DialTimeout time.Duration `yaml:"dial_timeout" env:"ETCD_DIAL_TIMEOUT"`
+	// ConfigPrefix 不为空时从 etcd 的该前缀下加载配置并监听修改
+	ConfigPrefix string `yaml:"config_prefix" env:"CONFIG_ETCD_PREFIX"`
+}
+
+type KafkaConfig struct {
+	Brokers []string `yaml:"brokers" env:"KAFKA_BROKERS"`
+}
+
+type RedisConfig struct {
+	Addr     string `yaml:"addr" env:"REDIS_ADDR"`
+	Password string `yaml:"password" env:"REDIS_PASSWORD"`
+	DB       int    `yaml:"db" env:"REDIS_DB"`
+}
+
+// CacheConfig 控制用户信息的 Redis 缓存，缓存时间为 TTL 加上 [0, Jitter) 内的随机值，避免同时过期
+// NegativeTTL 是不存在的用户的缓存时间
+type CacheConfig struct {
+	Enabled     bool          `yaml:"enabled" env:"CACHE_ENABLED"`
+	TTL         time.Duration `yaml:"ttl" env:"CACHE_TTL"`
+	Jitter      time.Duration `yaml:"jitter" env:"CACHE_JITTER"`
+	NegativeTTL time.Duration `yaml:"negative_ttl" env:"CACHE_NEGATIVE_TTL"`
+}
+
+type LogConfig struct {
+	Level string `yaml:"level" env:"LOG_LEVEL" reload:"true"`
+}
+
+// LogrusLevel 返回 Level 对应的 logrus 级别，Level 已经过 Validate 校验
+func (c LogConfig) LogrusLevel() logrus.Level {
+	level, err := logrus.ParseLevel(c.Level)
+	if err != nil {
+		return logrus.InfoLevel
+	}
+	return level
+}
+
+// RateLimitConfig 限制单个实例每秒处理的 gRPC 请求数，RequestsPerSecond 为 0 时不限流
+type RateLimitConfig struct {
+	RequestsPerSecond float64 `yaml:"requests_per_second" env:"RATE_LIMIT_RPS" reload:"true"`
+	Burst             int     `yaml:"burst" env:"RATE_LIMIT_BURST" reload:"true"`
+}
+
+// AuthConfig 是自签 access token 的配置
+// 有公钥（keys.etcd_prefix 或 PublicKeysDir）时 gRPC 服务使用 TokenIssuer 的公钥校验 ES256 token，否则 JWTSecret 不为空时用它校验 HS256 token
+// SigningKeyFile 是服务的 P-256 私钥，签发 token 的服务（user-service）没有私钥时使用 JWTSecret 签发
+type AuthConfig struct {
+	JWTSecret      string        `yaml:"jwt_secret" env:"JWT_SECRET"`
+	AccessTokenTTL time.Duration `yaml:"access_token_ttl" env:"ACCESS_TOKEN_TTL"`
+	SigningKeyFile string        `yaml:"signing_key_file" env:"JWT_SIGNING_KEY_FILE"`
+	// PublicKeysDir 中的 <service>.pem 是各服务的公钥
+	PublicKeysDir string `yaml:"public_keys_dir" env:"JWT_PUBLIC_KEYS_DIR"`
+	TokenIssuer   string `yaml:"token_issuer" env:"JWT_TOKEN_ISSUER"`
+}
+
+// KeysConfig 中 EtcdPrefix 不为空时，服务把自己的公钥发布到 etcd 的该前缀下，并从中获取其他服务的公钥
+// 没有配置 auth.signing_key_file 的服务启动时生成私钥，每隔 RotationInterval 轮换，为 0 时不轮换
+// 轮换和下线前使用的公钥在 GracePeriod 内仍然有效，GracePeriod 不能小于 access token 的有效期
+type KeysConfig struct {
+	EtcdPrefix       string        `yaml:"etcd_prefix" env:"KEYS_ETCD_PREFIX"`
+	LeaseTTL         time.Duration `yaml:"lease_ttl" env:"KEYS_LEASE_TTL"`
+	RotationInterval time.Duration `yaml:"rotation_interval" env:"KEYS_ROTATION_INTERVAL"`
+	GracePeriod      time.Duration `yaml:"grace_period" env:"KEYS_GRACE_PERIOD"`
+}
+
+// APIRouterConfig 中 RoutesFile 是路由和服务依赖的 YAML 文件，格式见 routes.Table
+// Port 是 HTTP 端口，ShutdownTimeout 是收到退出信号后等待进行中请求完成的最长时间
+type APIRouterConfig struct {
+	RoutesFile      string        `yaml:"routes_file" env:"API_ROUTER_ROUTES_FILE"`
+	Port            int           `yaml:"port" env:"API_ROUTER_PORT"`
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout" env:"API_ROUTER_SHUTDOWN_TIMEOUT"`
+}
+
+func (c APIRouterConfig) Addr() string {
+	return fmt.Sprintf(":%d", c.Port)
+}
+
+// PasswordHashConfig 是 Argon2id 的参数和哈希工作池的大小
+// 修改参数后已保存的哈希仍然可以校验，并在用户下一次登录成功时按新参数重新计算
+// 所有 worker 都在计算且等待的请求超过 QueueSize 时直接拒绝，避免请求堆积到超时
+type PasswordHashConfig struct {
+	MemoryKiB   int `yaml:"memory_kib" env:"PASSWORD_HASH_MEMORY_KIB"`
+	Iterations  int `yaml:"iterations" env:"PASSWORD_HASH_ITERATIONS"`
+	Parallelism int `yaml:"parallelism" env:"PASSWORD_HASH_PARALLELISM"`
+	Workers     int `yaml:"workers" env:"PASSWORD_HASH_WORKERS"`
+	QueueSize   int `yaml:"queue_size" env:"PASSWORD_HASH_QUEUE_SIZE"`
+}
+
+func Default() Config {
+	return Config{
+		GRPC: GRPCConfig{
+			Port:                8080,
+			ShutdownDelay:       5 * time.Second,
+			ShutdownTimeout:     20 * time.Second,
+			HealthCheckInterval: 5 * time.Second,
+		},
+		Metrics: MetricsConfig{Port: 9090},
+		MySQL: MySQLConfig{
+			MaxOpenConns:    20,
+			MaxIdleConns:    10,
+			ConnMaxLifetime: 30 * time.Minute,
+		},
+		Etcd: EtcdConfig{DialTimeout: 5 * time.Second},
+		Cache: CacheConfig{
+			TTL:         10 * time.Minute,
+			Jitter:      time.Minute,
+			NegativeTTL: 30 * time.Second,
+		},
+		Log: LogConfig{Level: "info"},
+		RateLimit: RateLimitConfig{
+			Burst: 100,
+		},
+		Auth: AuthConfig{
+			AccessTokenTTL: time.Hour,
+			TokenIssuer:    "user-service",
+		},
+		Keys: KeysConfig{
+			LeaseTTL:         10 * time.Second,
+			RotationInterval: 24 * time.Hour,
+			GracePeriod:      2 * time.Hour,
+		},
+		PasswordHash: PasswordHashConfig{
+			MemoryKiB:   64 * 1024,
+			Iterations:  3,
+			Parallelism: 2,
+			Workers:     4,
+			QueueSize:   32,
+		},
+		APIRouter: APIRouterConfig{
+			Port:            8080,
+			ShutdownTimeout: 20 * time.Second,
+		},
+		Features: map[string]bool{},
+	}
+}
+
+// Validate 一次返回所有不合法的配置项，便于启动失败时一次改完
+func (c Config) Validate() error {
+	var problems []string
+	check := func(ok bool, format string, args ...any) {
+		if !ok {
+			problems = append(problems, fmt.Sprintf(format, args...))
+		}
+	}
+
+	check(validPort(c.GRPC.Port), "grpc.port must be between 1 and 65535, got %d", c.GRPC.Port)
+	check(c.GRPC.ShutdownDelay >= 0, "grpc.shutdown_delay can't be negative, got %s", c.GRPC.ShutdownDelay)
+	check(c.GRPC.ShutdownTimeout > 0, "grpc.shutdown_timeout must be positive, got %s", c.GRPC.ShutdownTimeout)
+	check(c.GRPC.HealthCheckInterval > 0, "grpc.health_check_interval must be positive, got %s", c.GRPC.HealthCheckInterval)
+	check(validPort(c.Metrics.Port), "metrics.port must be between 1 and 65535, got %d", c.Metrics.Port)
+	check(c.MySQL.MaxOpenConns >= 0, "mysql.max_open_conns can't be negative, got %d", c.MySQL.MaxOpenConns)
+	check(c.MySQL.MaxIdleConns >= 0, "mysql.max_idle_conns can't be negative, got %d", c.MySQL.MaxIdleConns)
+	check(c.MySQL.MaxOpenConns == 0 || c.MySQL.MaxIdleConns <= c.MySQL.MaxOpenConns,
+		"mysql.max_idle_conns (%d) can't exceed mysql.max_open_conns (%d)", c.MySQL.MaxIdleConns, c.MySQL.MaxOpenConns)
+	check(c.MySQL.ConnMaxLifetime >= 0, "mysql.conn_max_lifetime can't be negative, got %s", c.MySQL.ConnMaxLifetime)
+	check(c.Etcd.ConfigPrefix == "" || len(c.Etcd.Endpoints) > 0, "etcd.endpoints is required when etcd.config_prefix is set")
+	check(!c.Cache.Enabled || c.Redis.Addr != "", "redis.addr is required when cache.enabled is true")
+	check(!c.Cache.Enabled || c.Cache.TTL > 0, "cache.ttl must be positive, got %s", c.Cache.TTL)
+	check(c.Cache.Jitter >= 0, "cache.jitter can't be negative, got %s", c.Cache.Jitter)
+	check(!c.Cache.Enabled || c.Cache.NegativeTTL > 0, "cache.negative_ttl must be positive, got %s", c.Cache.NegativeTTL)
+	_, err := logrus.ParseLevel(c.Log.Level)
+	check(err == nil, "log.level %q is not a valid level", c.Log.Level)
+	check(c.RateLimit.RequestsPerSecond >= 0, "rate_limit.requests_per_second can't be negative, got %v", c.RateLimit.RequestsPerSecond)
+	check(c.RateLimit.RequestsPerSecond == 0 || c.RateLimit.Burst > 0,
+		"rate_limit.burst must be positive when rate limiting is enabled, got %d", c.RateLimit.Burst)
+	check(c.Auth.AccessTokenTTL > 0, "auth.access_token_ttl must be positive, got %s", c.Auth.AccessTokenTTL)
+	check((c.Auth.PublicKeysDir == "" && c.Keys.EtcdPrefix == "") || c.Auth.TokenIssuer != "",
+		"auth.token_issuer is required when auth.public_keys_dir or keys.etcd_prefix is set")
+	check(c.Keys.EtcdPrefix == "" || len(c.Etcd.Endpoints) > 0, "etcd.endpoints is required when keys.etcd_prefix is set")
+	check(c.Keys.LeaseTTL >= time.Second, "keys.lease_ttl must be at least 1s, got %s", c.Keys.LeaseTTL)
+	check(c.Keys.RotationInterval >= 0, "keys.rotation_interval can't be negative, got %s", c.Keys.RotationInterval)
+	check(c.Keys.GracePeriod >= c.Auth.AccessTokenTTL,
+		"keys.grace_period (%s) can't be less than auth.access_token_ttl (%s)", c.Keys.GracePeriod, c.Auth.AccessTokenTTL)
+	check(c.PasswordHash.Parallelism > 0 && c.PasswordHash.Parallelism <= 255,
+		"password_hash.parallelism must be between 1 and 255, got %d", c.PasswordHash.Parallelism)
+	check(c.PasswordHash.MemoryKiB >= 8*c.PasswordHash.Parallelism,
+		"password_hash.memory_kib must be at least 8 * password_hash.parallelism, got %d", c.PasswordHash.MemoryKiB)
+	check(c.PasswordHash.Iterations > 0, "password_hash.iterations must be positive, got %d", c.PasswordHash.Iterations)
+	check(c.PasswordHash.Workers > 0, "password_hash.workers must be positive, got %d", c.PasswordHash.Workers)
+	check(c.PasswordHash.QueueSize >= 0, "password_hash.queue_size can't be negative, got %d", c.PasswordHash.QueueSize)
+
+	if len(problems) > 0 {
+		return errors.Errorf("invalid config:\n  %s", strings.Join(problems, "\n  "))
+	}
+	return nil
+}
+
+func validPort(port int) bool {
+	return port > 0 && port <= 65535
+}
+
+func (c Config) clone() Config {
+	clone := c
+	clone.Etcd.Endpoints = append([]string(nil), c.Etcd.Endpoints...)
+	clone.Kafka.Brokers = append([]string(nil), c.Kafka.Brokers...)
+	clone.Features = make(map[string]bool, len(c.Features))
+	for name, enabled := range c.Features {
+		clone.Features[name] = enabled
+	}
+	return clone
+}
@@ -0,0 +1,171 @@
+package config
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadDefaults(t *testing.T) {
+	store, err := Load(context.Background(), WithFile(""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := store.Get()
+	if cfg.GRPC.Port != 8080 || cfg.Metrics.Port != 9090 || cfg.Log.Level != "info" {
+		t.Errorf("unexpected defaults: %+v", cfg)
+	}
+}
+
+func TestLoadOverridesFileWithEnv(t *testing.T) {
+	path := writeFile(t, `
+grpc:
+  port: 50051
+  shutdown_timeout: 30s
+mysql:
+  dsn: file-dsn
+  max_open_conns: 50
+etcd:
+  endpoints: [etcd-1:2379, etcd-2:2379]
+features:
+  new-feed: true
+`)
+	t.Setenv("MYSQL_DSN", "env-dsn")
+	t.Setenv("LOG_LEVEL", "debug")
+
+	store, err := Load(context.Background(), WithFile(path), WithRequired("mysql.dsn"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := store.Get()
+
+	if cfg.GRPC.Port != 50051 {
+		t.Errorf("expected port from file, got %d", cfg.GRPC.Port)
+	}
+	if cfg.GRPC.ShutdownTimeout != 30*time.Second {
+		t.Errorf("expected shutdown timeout from file, got %s", cfg.GRPC.ShutdownTimeout)
+	}
+	if cfg.GRPC.HealthCheckInterval != 5*time.Second {
+		t.Errorf("expected default health check interval, got %s", cfg.GRPC.HealthCheckInterval)
+	}
+	if cfg.MySQL.DSN != "env-dsn" {
+		t.Errorf("expected env to override file, got %s", cfg.MySQL.DSN)
+	}
+	if cfg.MySQL.MaxOpenConns != 50 {
+		t.Errorf("expected max open conns from file, got %d", cfg.MySQL.MaxOpenConns)
+	}
+	if cfg.Log.Level != "debug" {
+		t.Errorf("expected log level from env, got %s", cfg.Log.Level)
+	}
+	if len(cfg.Etcd.Endpoints) != 2 {
+		t.Errorf("expected 2 etcd endpoints, got %v", cfg.Etcd.Endpoints)
+	}
+	if !cfg.Features["new-feed"] {
+		t.Errorf("expected feature from file, got %v", cfg.Features)
+	}
+}
+
+func TestLoadSplitsListEnv(t *testing.T) {
+	t.Setenv("KAFKA_BROKERS", "kafka-1:9092, kafka-2:9092,")
+
+	store, err := Load(context.Background(), WithFile(""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	brokers := store.Get().Kafka.Brokers
+	if len(brokers) != 2 || brokers[0] != "kafka-1:9092" || brokers[1] != "kafka-2:9092" {
+		t.Errorf("unexpected brokers %v", brokers)
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		File          string
+		Env           map[string]string
+		Required      []string
+		ExpectedError []string
+	}{
+		{
+			Name:          "missing_required",
+			Required:      []string{"mysql.dsn"},
+			ExpectedError: []string{"mysql.dsn (env MYSQL_DSN)"},
+		},
+		{
+			Name:          "invalid_env",
+			Env:           map[string]string{"PORT": "http"},
+			ExpectedError: []string{"invalid env PORT", "grpc.port must be an integer"},
+		},
+		{
+			Name:          "unknown_file_key",
+			File:          "grpc:\n  prot: 50051\n",
+			ExpectedError: []string{"field prot not found"},
+		},
+		{
+			Name: "all_invalid_values_reported",
+			Env: map[string]string{
+				"PORT":           "70000",
+				"LOG_LEVEL":      "verbose",
+				"RATE_LIMIT_RPS": "-1",
+			},
+			ExpectedError: []string{"grpc.port", "log.level", "rate_limit.requests_per_second"},
+		},
+		{
+			Name:          "etcd_prefix_without_endpoints",
+			Env:           map[string]string{"CONFIG_ETCD_PREFIX": "/config/user-service"},
+			ExpectedError: []string{"etcd.endpoints is required"},
+		},
+		{
+			Name: "keys_grace_period_shorter_than_token_ttl",
+			Env: map[string]string{
+				"ACCESS_TOKEN_TTL":  "2h",
+				"KEYS_GRACE_PERIOD": "1h",
+			},
+			ExpectedError: []string{"keys.grace_period (1h0m0s) can't be less than auth.access_token_ttl (2h0m0s)"},
+		},
+		{
+			Name:          "keys_etcd_prefix_without_endpoints",
+			Env:           map[string]string{"KEYS_ETCD_PREFIX": "/keys"},
+			ExpectedError: []string{"etcd.endpoints is required when keys.etcd_prefix is set"},
+		},
+		{
+			Name:          "public_keys_without_token_issuer",
+			File:          "auth:\n  public_keys_dir: /etc/jwt/public-keys\n  token_issuer: \"\"\n",
+			ExpectedError: []string{"auth.token_issuer is required"},
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.Name, func(t *testing.T) {
+			for key, value := range c.Env {
+				t.Setenv(key, value)
+			}
+			path := ""
+			if c.File != "" {
+				path = writeFile(t, c.File)
+			}
+
+			_, err := Load(context.Background(), WithFile(path), WithRequired(c.Required...))
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			for _, expected := range c.ExpectedError {
+				if !strings.Contains(err.Error(), expected) {
+					t.Errorf("expected %q in error, got: %v", expected, err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,206 @@
+package config
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+const testPrefix = "/config/user-service/"
+
+type fakeEtcd struct {
+	mu       sync.Mutex
+	kvs      []*mvccpb.KeyValue
+	revision int64
+	events   chan clientv3.WatchResponse
+}
+
+func newFakeEtcd(t *testing.T, kvs map[string]string) *fakeEtcd {
+	f := &fakeEtcd{revision: 7, events: make(chan clientv3.WatchResponse)}
+	f.setKvs(kvs)
+	t.Cleanup(func() { close(f.events) })
+	return f
+}
+
+func (f *fakeEtcd) setKvs(kvs map[string]string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.kvs = nil
+	for key, value := range kvs {
+		f.kvs = append(f.kvs, &mvccpb.KeyValue{Key: []byte(testPrefix + key), Value: []byte(value)})
+	}
+}
+
+func (f *fakeEtcd) Get(context.Context, string, ...clientv3.OpOption) (*clientv3.GetResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return &clientv3.GetResponse{
+		Header: &etcdserverpb.ResponseHeader{Revision: f.revision},
+		Kvs:    f.kvs,
+	}, nil
+}
+
+func (f *fakeEtcd) Watch(context.Context, string, ...clientv3.OpOption) clientv3.WatchChan {
+	return f.events
+}
+
+func (f *fakeEtcd) put(key, value string) {
+	f.events <- clientv3.WatchResponse{Events: []*clientv3.Event{{
+		Type: mvccpb.PUT,
+		Kv:   &mvccpb.KeyValue{Key: []byte(testPrefix + key), Value: []byte(value)},
+	}}}
+}
+
+// compact 模拟 watch 的 revision 已被压缩，watch 中断期间的修改只能通过重新加载获得
+func (f *fakeEtcd) compact(kvs map[string]string) {
+	f.setKvs(kvs)
+	f.events <- clientv3.WatchResponse{CompactRevision: f.revision}
+}
+
+func (f *fakeEtcd) delete(key string) {
+	f.events <- clientv3.WatchResponse{Events: []*clientv3.Event{{
+		Type: mvccpb.DELETE,
+		Kv:   &mvccpb.KeyValue{Key: []byte(testPrefix + key)},
+	}}}
+}
+
+func loadWithEtcd(t *testing.T, etcd *fakeEtcd) (*Store, chan Config) {
+	t.Helper()
+	t.Setenv("CONFIG_ETCD_PREFIX", testPrefix)
+	t.Setenv("ETCD_ENDPOINTS", "etcd:2379")
+	t.Setenv("LOG_LEVEL", "warn")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	store, err := Load(ctx, WithFile(""), WithEtcdSource(etcd))
+	if err != nil {
+		t.Fatal(err)
+	}
+	changes := make(chan Config, 10)
+	store.Subscribe(func(cfg Config) {
+		changes <- cfg
+	})
+	return store, changes
+}
+
+func waitForChange(t *testing.T, changes chan Config) Config {
+	t.Helper()
+	select {
+	case cfg := <-changes:
+		return cfg
+	case <-time.After(time.Second):
+		t.Fatal("config wasn't reloaded")
+		return Config{}
+	}
+}
+
+func TestLoadFromEtcd(t *testing.T) {
+	etcd := newFakeEtcd(t, map[string]string{
+		"grpc.port":         "50051",
+		"log.level":         "error",
+		"features.new-feed": "true",
+		"removed.key":       "ignored",
+	})
+	store, _ := loadWithEtcd(t, etcd)
+
+	cfg := store.Get()
+	if cfg.GRPC.Port != 50051 {
+		t.Errorf("expected port from etcd, got %d", cfg.GRPC.Port)
+	}
+	if cfg.Log.Level != "error" {
+		t.Errorf("expected etcd to override env, got %s", cfg.Log.Level)
+	}
+	if !cfg.Features["new-feed"] {
+		t.Errorf("expected feature from etcd, got %v", cfg.Features)
+	}
+}
+
+func TestLoadFromEtcdRejectsInvalidValue(t *testing.T) {
+	etcd := newFakeEtcd(t, map[string]string{"rate_limit.burst": "many"})
+	t.Setenv("CONFIG_ETCD_PREFIX", testPrefix)
+	t.Setenv("ETCD_ENDPOINTS", "etcd:2379")
+
+	if _, err := Load(context.Background(), WithFile(""), WithEtcdSource(etcd)); err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestHotReloadFromEtcd(t *testing.T) {
+	etcd := newFakeEtcd(t, nil)
+	store, changes := loadWithEtcd(t, etcd)
+
+	etcd.put("log.level", "debug")
+	if cfg := waitForChange(t, changes); cfg.Log.Level != "debug" {
+		t.Errorf("expected debug, got %s", cfg.Log.Level)
+	}
+
+	etcd.put("rate_limit.requests_per_second", "250")
+	if cfg := waitForChange(t, changes); cfg.RateLimit.RequestsPerSecond != 250 {
+		t.Errorf("expected 250 rps, got %v", cfg.RateLimit.RequestsPerSecond)
+	}
+
+	// 修改需要重启的配置或不合法的值不会生效
+	etcd.put("grpc.port", "50052")
+	etcd.put("log.level", "verbose")
+	etcd.put("features.new-feed", "true")
+	cfg := waitForChange(t, changes)
+	if !cfg.Features["new-feed"] {
+		t.Errorf("expected feature to be enabled, got %v", cfg.Features)
+	}
+	if cfg.GRPC.Port != 8080 {
+		t.Errorf("grpc.port isn't reloadable, got %d", cfg.GRPC.Port)
+	}
+	if cfg.Log.Level != "debug" {
+		t.Errorf("invalid log level should be ignored, got %s", cfg.Log.Level)
+	}
+
+	// 删除 key 后恢复为文件和环境变量中的值
+	etcd.delete("log.level")
+	if cfg := waitForChange(t, changes); cfg.Log.Level != "warn" {
+		t.Errorf("expected log level from env after delete, got %s", cfg.Log.Level)
+	}
+	etcd.delete("features.new-feed")
+	if cfg := waitForChange(t, changes); cfg.Features["new-feed"] {
+		t.Errorf("expected feature to be removed, got %v", cfg.Features)
+	}
+
+	if store.Get().Log.Level != "warn" {
+		t.Errorf("store wasn't updated, got %s", store.Get().Log.Level)
+	}
+}
+
+func TestHotReloadFromEtcdAfterCompaction(t *testing.T) {
+	etcd := newFakeEtcd(t, map[string]string{"log.level": "error"})
+	_, changes := loadWithEtcd(t, etcd)
+
+	// 中断期间删除的 key 恢复为环境变量中的值，不合法的值保留原配置
+	etcd.compact(map[string]string{
+		"rate_limit.burst":               "7",
+		"rate_limit.requests_per_second": "many",
+		"grpc.port":                      "50052",
+	})
+	cfg := waitForChange(t, changes)
+	if cfg.Log.Level != "warn" {
+		t.Errorf("expected log level from env after reload, got %s", cfg.Log.Level)
+	}
+	if cfg.RateLimit.Burst != 7 {
+		t.Errorf("expected burst from etcd after reload, got %d", cfg.RateLimit.Burst)
+	}
+	if cfg.RateLimit.RequestsPerSecond != Default().RateLimit.RequestsPerSecond {
+		t.Errorf("invalid rps should be ignored, got %v", cfg.RateLimit.RequestsPerSecond)
+	}
+	if cfg.GRPC.Port != 8080 {
+		t.Errorf("grpc.port isn't reloadable, got %d", cfg.GRPC.Port)
+	}
+
+	// 重新加载后继续监听
+	etcd.put("log.level", "debug")
+	if cfg := waitForChange(t, changes); cfg.Log.Level != "debug" {
+		t.Errorf("expected debug, got %s", cfg.Log.Level)
+	}
+}
@@ -0,0 +1,144 @@
+package config
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const featuresKey = "features"
+
+// field 是 Config 中的一个叶子配置项，key 由各级 yaml 标签用 . 连接而成
+type field struct {
+	key    string
+	env    string
+	reload bool
+	value  reflect.Value
+}
+
+func fields(cfg *Config) []field {
+	var result []field
+	collectFields(reflect.ValueOf(cfg).Elem(), "", &result)
+	return result
+}
+
+func collectFields(v reflect.Value, prefix string, result *[]field) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		structField := t.Field(i)
+		key := prefix + strings.Split(structField.Tag.Get("yaml"), ",")[0]
+		if structField.Type.Kind() == reflect.Struct && structField.Type != durationType {
+			collectFields(v.Field(i), key+".", result)
+			continue
+		}
+		*result = append(*result, field{
+			key:    key,
+			env:    structField.Tag.Get("env"),
+			reload: structField.Tag.Get("reload") == "true",
+			value:  v.Field(i),
+		})
+	}
+}
+
+func lookupField(cfg *Config, key string) (field, bool) {
+	for _, f := range fields(cfg) {
+		if f.key == key {
+			return f, true
+		}
+	}
+	return field{}, false
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// set 把字符串形式的值（来自环境变量或 etcd）写入配置项
+func (f field) set(raw string) error {
+	raw = strings.TrimSpace(raw)
+	v := f.value
+	switch {
+	case v.Type() == durationType:
+		duration, err := time.ParseDuration(raw)
+		if err != nil {
+			return errors.Wrapf(err, "%s must be a duration such as 5s", f.key)
+		}
+		v.SetInt(int64(duration))
+	case v.Kind() == reflect.String:
+		v.SetString(raw)
+	case v.Kind() == reflect.Int:
+		number, err := strconv.Atoi(raw)
+		if err != nil {
+			return errors.Wrapf(err, "%s must be an integer", f.key)
+		}
+		v.SetInt(int64(number))
+	case v.Kind() == reflect.Float64:
+		number, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return errors.Wrapf(err, "%s must be a number", f.key)
+		}
+		v.SetFloat(number)
+	case v.Kind() == reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return errors.Wrapf(err, "%s must be a boolean", f.key)
+		}
+		v.SetBool(b)
+	case v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.String:
+		var items []string
+		for _, item := range strings.Split(raw, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		v.Set(reflect.ValueOf(items))
+	default:
+		return errors.Errorf("%s can't be set from a string", f.key)
+	}
+	return nil
+}
+
+// setKey 按 key 修改配置，features.<name> 修改对应的功能开关
+func setKey(cfg *Config, key string, raw string) error {
+	if name, ok := strings.CutPrefix(key, featuresKey+"."); ok {
+		enabled, err := strconv.ParseBool(strings.TrimSpace(raw))
+		if err != nil {
+			return errors.Wrapf(err, "%s must be a boolean", key)
+		}
+		cfg.Features[name] = enabled
+		return nil
+	}
+	f, ok := lookupField(cfg, key)
+	if !ok || f.key == featuresKey {
+		return errors.Wrap(errUnknownKey, key)
+	}
+	return f.set(raw)
+}
+
+// resetKey 把 key 恢复为 base 中的值，用于 etcd 中的 key 被删除时
+func resetKey(cfg *Config, base Config, key string) error {
+	if name, ok := strings.CutPrefix(key, featuresKey+"."); ok {
+		if enabled, ok := base.Features[name]; ok {
+			cfg.Features[name] = enabled
+		} else {
+			delete(cfg.Features, name)
+		}
+		return nil
+	}
+	f, ok := lookupField(cfg, key)
+	if !ok || f.key == featuresKey {
+		return errors.Wrap(errUnknownKey, key)
+	}
+	baseField, _ := lookupField(&base, key)
+	f.value.Set(baseField.value)
+	return nil
+}
+
+func reloadable(key string) bool {
+	if strings.HasPrefix(key, featuresKey+".") {
+		return true
+	}
+	f, ok := lookupField(&Config{}, key)
+	return ok && f.reload
+}
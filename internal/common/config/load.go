@@ -0,0 +1,327 @@
+package config
+
+import (
+	"context"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"gopkg.in/yaml.v3"
+)
+
+var errUnknownKey = errors.New("unknown config key")
+
+// watchRetryInterval 是 watch 中断后重新加载失败时的重试间隔
+const watchRetryInterval = time.Second
+
+// EtcdSource 是加载和监听配置所需的 etcd 接口，*clientv3.Client 实现了它
+type EtcdSource interface {
+	Get(ctx context.Context, key string, opts ...clientv3.OpOption) (*clientv3.GetResponse, error)
+	Watch(ctx context.Context, key string, opts ...clientv3.OpOption) clientv3.WatchChan
+}
+
+type loadOptions struct {
+	file     string
+	etcd     EtcdSource
+	required []string
+}
+
+type Option func(options *loadOptions)
+
+// WithFile 指定 YAML 配置文件，默认读取 CONFIG_FILE，为空时不加载文件
+func WithFile(path string) Option {
+	return func(options *loadOptions) {
+		options.file = path
+	}
+}
+
+// WithEtcdSource 替换默认根据 etcd.endpoints 创建的 etcd 客户端
+func WithEtcdSource(source EtcdSource) Option {
+	return func(options *loadOptions) {
+		options.etcd = source
+	}
+}
+
+// WithRequired 声明服务必须配置的 key，如 mysql.dsn
+func WithRequired(keys ...string) Option {
+	return func(options *loadOptions) {
+		options.required = append(options.required, keys...)
+	}
+}
+
+// Load 加载并校验配置，配置了 etcd.config_prefix 时在 ctx 结束前持续监听 etcd 中的修改
+func Load(ctx context.Context, opts ...Option) (*Store, error) {
+	options := loadOptions{file: os.Getenv("CONFIG_FILE")}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	cfg := Default()
+	if options.file != "" {
+		if err := loadFile(&cfg, options.file); err != nil {
+			return nil, err
+		}
+	}
+	if err := loadEnv(&cfg); err != nil {
+		return nil, err
+	}
+	base := cfg.clone()
+
+	prefix := cfg.Etcd.ConfigPrefix
+	var revision int64
+	if prefix != "" {
+		prefix = strings.TrimSuffix(prefix, "/") + "/"
+		if options.etcd == nil {
+			if len(cfg.Etcd.Endpoints) == 0 {
+				return nil, errors.New("etcd.endpoints is required when etcd.config_prefix is set")
+			}
+			client, err := clientv3.New(clientv3.Config{
+				Endpoints:   cfg.Etcd.Endpoints,
+				DialTimeout: cfg.Etcd.DialTimeout,
+			})
+			if err != nil {
+				return nil, errors.Wrap(err, "unable to create etcd client for config")
+			}
+			go func() {
+				<-ctx.Done()
+				_ = client.Close()
+			}()
+			options.etcd = client
+		}
+
+		loadCtx, cancel := context.WithTimeout(ctx, cfg.Etcd.DialTimeout)
+		var err error
+		revision, err = loadEtcd(loadCtx, options.etcd, prefix, &cfg)
+		cancel()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := validate(cfg, options.required); err != nil {
+		return nil, err
+	}
+
+	store := NewStore(cfg)
+	if prefix != "" {
+		go store.watchEtcd(ctx, options.etcd, prefix, base, revision)
+	}
+	return store, nil
+}
+
+func validate(cfg Config, required []string) error {
+	var missing []string
+	for _, key := range required {
+		f, ok := lookupField(&cfg, key)
+		if !ok {
+			return errors.Wrap(errUnknownKey, key)
+		}
+		if f.value.IsZero() {
+			if f.env != "" {
+				missing = append(missing, key+" (env "+f.env+")")
+			} else {
+				missing = append(missing, key)
+			}
+		}
+	}
+	if len(missing) > 0 {
+		return errors.Errorf("missing required config: %s", strings.Join(missing, ", "))
+	}
+	return cfg.Validate()
+}
+
+func loadFile(cfg *Config, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return errors.Wrap(err, "unable to open config file")
+	}
+	defer file.Close()
+
+	decoder := yaml.NewDecoder(file)
+	decoder.KnownFields(true)
+	if err := decoder.Decode(cfg); err != nil {
+		return errors.Wrapf(err, "unable to parse config file %s", path)
+	}
+	return nil
+}
+
+func loadEnv(cfg *Config) error {
+	for _, f := range fields(cfg) {
+		if f.env == "" {
+			continue
+		}
+		value := os.Getenv(f.env)
+		if value == "" {
+			continue
+		}
+		if err := f.set(value); err != nil {
+			return errors.Wrapf(err, "invalid env %s", f.env)
+		}
+	}
+	return nil
+}
+
+// loadEtcd 读取 prefix 下的所有配置，返回读取时的 revision 供后续监听使用
+func loadEtcd(ctx context.Context, source EtcdSource, prefix string, cfg *Config) (int64, error) {
+	resp, err := source.Get(ctx, prefix, clientv3.WithPrefix())
+	if err != nil {
+		return 0, errors.Wrapf(err, "unable to load config from etcd prefix %s", prefix)
+	}
+	for _, kv := range resp.Kvs {
+		key := strings.TrimPrefix(string(kv.Key), prefix)
+		if err := setKey(cfg, key, string(kv.Value)); err != nil {
+			if errors.Cause(err) == errUnknownKey {
+				logrus.WithField("key", string(kv.Key)).Warn("Ignoring unknown config key in etcd")
+				continue
+			}
+			return 0, errors.Wrapf(err, "invalid etcd config %s", kv.Key)
+		}
+	}
+	return resp.Header.Revision, nil
+}
+
+// Store 保存当前配置，热更新时通知订阅者
+type Store struct {
+	mu      sync.RWMutex
+	current Config
+
+	// updateMu 保证修改和通知按顺序进行
+	updateMu    sync.Mutex
+	subscribers []func(Config)
+}
+
+func NewStore(cfg Config) *Store {
+	return &Store{current: cfg.clone()}
+}
+
+// Get 返回当前配置的副本
+func (s *Store) Get() Config {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.current.clone()
+}
+
+// Subscribe 注册配置变化时的回调，回调按修改顺序串行执行
+func (s *Store) Subscribe(subscriber func(Config)) {
+	s.updateMu.Lock()
+	defer s.updateMu.Unlock()
+	s.subscribers = append(s.subscribers, subscriber)
+}
+
+// update 修改配置的副本并校验，校验失败时保留原配置
+func (s *Store) update(updateFn func(cfg *Config) error) error {
+	s.updateMu.Lock()
+	defer s.updateMu.Unlock()
+
+	next := s.Get()
+	if err := updateFn(&next); err != nil {
+		return err
+	}
+	if err := next.Validate(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.current = next
+	s.mu.Unlock()
+
+	for _, subscriber := range s.subscribers {
+		subscriber(next.clone())
+	}
+	return nil
+}
+
+// watchEtcd 在 watch 中断（如 revision 已被压缩或重新连接）后重新加载配置再继续监听
+func (s *Store) watchEtcd(ctx context.Context, source EtcdSource, prefix string, base Config, revision int64) {
+	for ctx.Err() == nil {
+		watchCtx, cancel := context.WithCancel(ctx)
+		for resp := range source.Watch(watchCtx, prefix, clientv3.WithPrefix(), clientv3.WithRev(revision+1)) {
+			if err := resp.Err(); err != nil {
+				logrus.WithError(err).Warn("Config watch error")
+				break
+			}
+			s.applyEtcdEvents(prefix, base, resp.Events)
+			revision = resp.Header.Revision
+		}
+		cancel()
+
+		for ctx.Err() == nil {
+			var err error
+			if revision, err = s.reloadEtcd(ctx, source, prefix, base); err == nil {
+				break
+			}
+			logrus.WithError(err).Warn("Unable to reload config from etcd")
+			select {
+			case <-ctx.Done():
+			case <-time.After(watchRetryInterval):
+			}
+		}
+	}
+}
+
+func (s *Store) applyEtcdEvents(prefix string, base Config, events []*clientv3.Event) {
+	for _, event := range events {
+		key := strings.TrimPrefix(string(event.Kv.Key), prefix)
+		logger := logrus.WithField("key", key)
+		if !reloadable(key) {
+			logger.Warn("Config key changed in etcd, restart the service to apply it")
+			continue
+		}
+
+		err := s.update(func(cfg *Config) error {
+			if event.Type == clientv3.EventTypeDelete {
+				return resetKey(cfg, base, key)
+			}
+			return setKey(cfg, key, string(event.Kv.Value))
+		})
+		if err != nil {
+			logger.WithError(err).Error("Ignoring invalid config change from etcd")
+			continue
+		}
+		logger.Info("Config reloaded from etcd")
+	}
+}
+
+// reloadEtcd 重新读取 prefix 下的配置并替换可热更新的配置项，watch 中断期间被删除的 key 恢复为 base 中的值，
+// 不合法的值保留原配置；返回读取时的 revision 供后续监听使用
+func (s *Store) reloadEtcd(ctx context.Context, source EtcdSource, prefix string, base Config) (int64, error) {
+	resp, err := source.Get(ctx, prefix, clientv3.WithPrefix())
+	if err != nil {
+		return 0, errors.Wrapf(err, "unable to reload config from etcd prefix %s", prefix)
+	}
+
+	err = s.update(func(cfg *Config) error {
+		previous := cfg.clone()
+		reloaded := base.clone()
+		for _, f := range fields(cfg) {
+			if f.reload {
+				baseField, _ := lookupField(&reloaded, f.key)
+				f.value.Set(baseField.value)
+			}
+		}
+
+		for _, kv := range resp.Kvs {
+			key := strings.TrimPrefix(string(kv.Key), prefix)
+			if !reloadable(key) {
+				continue
+			}
+			if err := setKey(cfg, key, string(kv.Value)); err != nil {
+				logrus.WithError(err).WithField("key", key).Error("Ignoring invalid config change from etcd")
+				if err := resetKey(cfg, previous, key); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		logrus.WithError(err).Error("Ignoring invalid config reloaded from etcd")
+	} else {
+		logrus.Info("Config reloaded from etcd")
+	}
+	return resp.Header.Revision, nil
+}
@@ -0,0 +1,19 @@
+package config
+
+import (
+	"database/sql"
+
+	"github.com/pkg/errors"
+)
+
+// Open 按连接池配置打开 MySQL 连接，调用方需要导入 MySQL 驱动
+func (c MySQLConfig) Open() (*sql.DB, error) {
+	db, err := sql.Open("mysql", c.DSN)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to open MySQL connection")
+	}
+	db.SetMaxOpenConns(c.MaxOpenConns)
+	db.SetMaxIdleConns(c.MaxIdleConns)
+	db.SetConnMaxLifetime(c.ConnMaxLifetime)
+	return db, nil
+}
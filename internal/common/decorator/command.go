@@ -9,12 +9,14 @@ import (
 )
 
 func ApplyCommandDecorators[H any](handler CommandHandler[H], logger *logrus.Entry, metricsClient MetricsClient) CommandHandler[H] {
-	return commandLoggingDecorator[H]{
-		base: commandMetricsDecorator[H]{
-			base:   handler,
-			client: metricsClient,
+	return commandTracingDecorator[H]{
+		base: commandLoggingDecorator[H]{
+			base: commandMetricsDecorator[H]{
+				base:   handler,
+				client: metricsClient,
+			},
+			logger: logger,
 		},
-		logger: logger,
 	}
 }
 
@@ -22,6 +24,25 @@ type CommandHandler[C any] interface {
 	Handle(ctx context.Context, cmd C) error
 }
 
+// ApplyCommandWithResultDecorators 与 ApplyCommandDecorators 记录相同的 trace、日志和指标
+func ApplyCommandWithResultDecorators[C any, R any](handler CommandWithResultHandler[C, R], logger *logrus.Entry, metricsClient MetricsClient) CommandWithResultHandler[C, R] {
+	return commandWithResultTracingDecorator[C, R]{
+		base: commandWithResultLoggingDecorator[C, R]{
+			base: commandWithResultMetricsDecorator[C, R]{
+				base:   handler,
+				client: metricsClient,
+			},
+			logger: logger,
+		},
+	}
+}
+
+// CommandWithResultHandler 用于结果无法由调用方预先生成的命令，如登录返回的 token
+// 能预先生成的结果（如新资源的 UUID）应由调用方生成后放入命令，仍然使用 CommandHandler
+type CommandWithResultHandler[C any, R any] interface {
+	Handle(ctx context.Context, cmd C) (R, error)
+}
+
 func generateActionName(handler any) string {
 	return strings.Split(fmt.Sprintf("%T", handler), ".")[1]
 }
@@ -15,7 +15,7 @@ type commandLoggingDecorator[C any] struct {
 func (d commandLoggingDecorator[C]) Handle(ctx context.Context, cmd C) (err error) {
 	handlerType := generateActionName(cmd)
 
-	logger := d.logger.WithFields(logrus.Fields{
+	logger := d.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"command":      handlerType,
 		"command_body": fmt.Sprintf("%#v", cmd),
 	})
@@ -32,13 +32,36 @@ func (d commandLoggingDecorator[C]) Handle(ctx context.Context, cmd C) (err erro
 	return d.base.Handle(ctx, cmd)
 }
 
+type commandWithResultLoggingDecorator[C any, R any] struct {
+	base   CommandWithResultHandler[C, R]
+	logger *logrus.Entry
+}
+
+func (d commandWithResultLoggingDecorator[C, R]) Handle(ctx context.Context, cmd C) (result R, err error) {
+	logger := d.logger.WithContext(ctx).WithFields(logrus.Fields{
+		"command":      generateActionName(cmd),
+		"command_body": fmt.Sprintf("%#v", cmd),
+	})
+
+	logger.Debug("Executing command")
+	defer func() {
+		if err == nil {
+			logger.Info("Command executed successfully")
+		} else {
+			logger.WithError(err).Error("Failed to execute command")
+		}
+	}()
+
+	return d.base.Handle(ctx, cmd)
+}
+
 type queryLoggingDecorator[C any, R any] struct {
 	base   QueryHandler[C, R]
 	logger *logrus.Entry
 }
 
 func (d queryLoggingDecorator[C, R]) Handle(ctx context.Context, cmd C) (result R, err error) {
-	logger := d.logger.WithFields(logrus.Fields{
+	logger := d.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"query":      generateActionName(cmd),
 		"query_body": fmt.Sprintf("%#v", cmd),
 	})
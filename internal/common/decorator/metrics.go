@@ -2,13 +2,16 @@ package decorator
 
 import (
 	"context"
-	"fmt"
 	"strings"
 	"time"
 )
 
+// MetricsClient 记录带标签的指标，同一个指标名的 labels 键集合必须保持一致
 type MetricsClient interface {
-	Inc(key string, value int)
+	Inc(name string, labels map[string]string, value int)
+	ObserveDuration(name string, labels map[string]string, duration time.Duration)
+	// SetGauge 记录可增可减的当前值，如队列长度
+	SetGauge(name string, labels map[string]string, value float64)
 }
 
 type commandMetricsDecorator[C any] struct {
@@ -22,15 +25,26 @@ func (d commandMetricsDecorator[C]) Handle(ctx context.Context, cmd C) (err erro
 	actionName := strings.ToLower(generateActionName(cmd))
 
 	defer func() {
-		end := time.Since(start)
+		d.client.ObserveDuration("command_duration_seconds", map[string]string{"command": actionName}, time.Since(start))
+		d.client.Inc("commands_total", map[string]string{"command": actionName, "result": resultLabel(err)}, 1)
+	}()
+
+	return d.base.Handle(ctx, cmd)
+}
 
-		d.client.Inc(fmt.Sprintf("commands.%s.duration", actionName), int(end.Seconds()))
+type commandWithResultMetricsDecorator[C any, R any] struct {
+	base   CommandWithResultHandler[C, R]
+	client MetricsClient
+}
+
+func (d commandWithResultMetricsDecorator[C, R]) Handle(ctx context.Context, cmd C) (result R, err error) {
+	start := time.Now()
 
-		if err == nil {
-			d.client.Inc(fmt.Sprintf("commands.%s.success", actionName), 1)
-		} else {
-			d.client.Inc(fmt.Sprintf("commands.%s.failure", actionName), 1)
-		}
+	actionName := strings.ToLower(generateActionName(cmd))
+
+	defer func() {
+		d.client.ObserveDuration("command_duration_seconds", map[string]string{"command": actionName}, time.Since(start))
+		d.client.Inc("commands_total", map[string]string{"command": actionName, "result": resultLabel(err)}, 1)
 	}()
 
 	return d.base.Handle(ctx, cmd)
@@ -47,16 +61,16 @@ func (d queryMetricsDecorator[C, R]) Handle(ctx context.Context, query C) (resul
 	actionName := strings.ToLower(generateActionName(query))
 
 	defer func() {
-		end := time.Since(start)
-
-		d.client.Inc(fmt.Sprintf("querys.%s.duration", actionName), int(end.Seconds()))
-
-		if err == nil {
-			d.client.Inc(fmt.Sprintf("querys.%s.success", actionName), 1)
-		} else {
-			d.client.Inc(fmt.Sprintf("querys.%s.failure", actionName), 1)
-		}
+		d.client.ObserveDuration("query_duration_seconds", map[string]string{"query": actionName}, time.Since(start))
+		d.client.Inc("queries_total", map[string]string{"query": actionName, "result": resultLabel(err)}, 1)
 	}()
 
 	return d.base.Handle(ctx, query)
 }
+
+func resultLabel(err error) string {
+	if err == nil {
+		return "success"
+	}
+	return "failure"
+}
@@ -0,0 +1,103 @@
+package decorator
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+type recordingMetricsClient struct {
+	lock      sync.Mutex
+	counters  map[string]int
+	durations map[string][]time.Duration
+}
+
+func newRecordingMetricsClient() *recordingMetricsClient {
+	return &recordingMetricsClient{
+		counters:  map[string]int{},
+		durations: map[string][]time.Duration{},
+	}
+}
+
+func (r *recordingMetricsClient) Inc(name string, labels map[string]string, value int) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.counters[name+"/"+labels["result"]] += value
+}
+
+func (r *recordingMetricsClient) ObserveDuration(name string, _ map[string]string, duration time.Duration) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.durations[name] = append(r.durations[name], duration)
+}
+
+func (r *recordingMetricsClient) SetGauge(string, map[string]string, float64) {
+}
+
+type slowCommand struct{}
+
+type slowCommandHandler struct {
+	err error
+}
+
+func (h slowCommandHandler) Handle(context.Context, slowCommand) error {
+	time.Sleep(2 * time.Millisecond)
+	return h.err
+}
+
+func TestCommandMetricsDecoratorRecordsSubSecondDuration(t *testing.T) {
+	t.Parallel()
+	client := newRecordingMetricsClient()
+	handler := commandMetricsDecorator[slowCommand]{base: slowCommandHandler{}, client: client}
+
+	if err := handler.Handle(context.Background(), slowCommand{}); err != nil {
+		t.Fatal(err)
+	}
+
+	durations := client.durations["command_duration_seconds"]
+	if len(durations) != 1 || durations[0] < 2*time.Millisecond {
+		t.Fatalf("expected one duration of at least 2ms, got %v", durations)
+	}
+	if client.counters["commands_total/success"] != 1 {
+		t.Errorf("expected one success, got %v", client.counters)
+	}
+}
+
+func TestCommandMetricsDecoratorRecordsFailure(t *testing.T) {
+	t.Parallel()
+	client := newRecordingMetricsClient()
+	handler := commandMetricsDecorator[slowCommand]{base: slowCommandHandler{err: errors.New("failed")}, client: client}
+
+	if err := handler.Handle(context.Background(), slowCommand{}); err == nil {
+		t.Fatal("expected error")
+	}
+	if client.counters["commands_total/failure"] != 1 || client.counters["commands_total/success"] != 0 {
+		t.Errorf("expected one failure, got %v", client.counters)
+	}
+}
+
+type slowCommandWithResultHandler struct{}
+
+func (slowCommandWithResultHandler) Handle(context.Context, slowCommand) (string, error) {
+	time.Sleep(2 * time.Millisecond)
+	return "result", nil
+}
+
+func TestCommandWithResultMetricsDecoratorRecordsCommandMetrics(t *testing.T) {
+	t.Parallel()
+	client := newRecordingMetricsClient()
+	handler := commandWithResultMetricsDecorator[slowCommand, string]{base: slowCommandWithResultHandler{}, client: client}
+
+	result, err := handler.Handle(context.Background(), slowCommand{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result != "result" {
+		t.Errorf("expected result to be passed through, got %q", result)
+	}
+	if len(client.durations["command_duration_seconds"]) != 1 || client.counters["commands_total/success"] != 1 {
+		t.Errorf("expected command metrics, got %v %v", client.durations, client.counters)
+	}
+}
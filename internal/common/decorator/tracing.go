@@ -0,0 +1,46 @@
+package decorator
+
+import (
+	"context"
+
+	"newTiktoken/internal/common/tracing"
+)
+
+type commandTracingDecorator[C any] struct {
+	base CommandHandler[C]
+}
+
+func (d commandTracingDecorator[C]) Handle(ctx context.Context, cmd C) (err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "command "+generateActionName(cmd))
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	return d.base.Handle(ctx, cmd)
+}
+
+type commandWithResultTracingDecorator[C any, R any] struct {
+	base CommandWithResultHandler[C, R]
+}
+
+func (d commandWithResultTracingDecorator[C, R]) Handle(ctx context.Context, cmd C) (result R, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "command "+generateActionName(cmd))
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	return d.base.Handle(ctx, cmd)
+}
+
+type queryTracingDecorator[C any, R any] struct {
+	base QueryHandler[C, R]
+}
+
+func (d queryTracingDecorator[C, R]) Handle(ctx context.Context, query C) (result R, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "query "+generateActionName(query))
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	return d.base.Handle(ctx, query)
+}
@@ -0,0 +1,70 @@
+package decorator
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"newTiktoken/internal/common/tracing"
+)
+
+type tracedCommand struct{}
+
+type tracedCommandHandler struct {
+	err error
+}
+
+func (h tracedCommandHandler) Handle(ctx context.Context, _ tracedCommand) (err error) {
+	_, span := tracing.StartDBSpan(ctx, "repository.Save")
+	tracing.EndSpan(span, h.err)
+	return h.err
+}
+
+func newRecordingTracerProvider(t *testing.T) *tracetest.InMemoryExporter {
+	t.Helper()
+	exporter := tracetest.NewInMemoryExporter()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+	return exporter
+}
+
+func TestCommandTracingDecoratorCreatesParentSpan(t *testing.T) {
+	exporter := newRecordingTracerProvider(t)
+	handler := commandTracingDecorator[tracedCommand]{base: tracedCommandHandler{}}
+
+	if err := handler.Handle(context.Background(), tracedCommand{}); err != nil {
+		t.Fatal(err)
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("expected 2 spans, got %d", len(spans))
+	}
+	dbSpan, commandSpan := spans[0], spans[1]
+	if commandSpan.Name != "command tracedCommand" {
+		t.Errorf("unexpected command span name %q", commandSpan.Name)
+	}
+	if dbSpan.Parent.SpanID() != commandSpan.SpanContext.SpanID() {
+		t.Error("expected db span to be a child of the command span")
+	}
+}
+
+func TestCommandTracingDecoratorRecordsError(t *testing.T) {
+	exporter := newRecordingTracerProvider(t)
+	handler := commandTracingDecorator[tracedCommand]{base: tracedCommandHandler{err: errors.New("failed")}}
+
+	if err := handler.Handle(context.Background(), tracedCommand{}); err == nil {
+		t.Fatal("expected error")
+	}
+
+	for _, span := range exporter.GetSpans() {
+		if span.Status.Code != codes.Error {
+			t.Errorf("expected span %q to have error status, got %v", span.Name, span.Status.Code)
+		}
+	}
+}
@@ -5,11 +5,20 @@ type ErrorType struct {
 }
 
 var (
-	ErrorTypeUnknown        = ErrorType{"unknown"}
-	ErrorTypeAuthorization  = ErrorType{"authorization"}
-	ErrorTypeIncorrectInput = ErrorType{"incorrect-input"}
+	ErrorTypeUnknown            = ErrorType{"unknown"}
+	ErrorTypeAuthentication     = ErrorType{"authentication"}
+	ErrorTypeAuthorization      = ErrorType{"authorization"}
+	ErrorTypeIncorrectInput     = ErrorType{"incorrect-input"}
+	ErrorTypeNotFound           = ErrorType{"not-found"}
+	ErrorTypeConflict           = ErrorType{"conflict"}
+	ErrorTypePreconditionFailed = ErrorType{"precondition-failed"}
+	ErrorTypeResourceExhausted  = ErrorType{"resource-exhausted"}
 )
 
+func (e ErrorType) String() string {
+	return e.t
+}
+
 type SlugError struct {
 	error     string
 	slug      string
@@ -36,6 +45,15 @@ func NewSlugError(error string, slug string) SlugError {
 	}
 }
 
+// NewAuthenticationError 用于无法确认调用方身份的错误，如登录失败
+func NewAuthenticationError(error string, slug string) SlugError {
+	return SlugError{
+		error:     error,
+		slug:      slug,
+		errorType: ErrorTypeAuthentication,
+	}
+}
+
 func NewAuthorizationError(error string, slug string) SlugError {
 	return SlugError{
 		error:     error,
@@ -51,3 +69,36 @@ func NewIncorrectInputError(error string, slug string) SlugError {
 		errorType: ErrorTypeIncorrectInput,
 	}
 }
+
+func NewNotFoundError(error string, slug string) SlugError {
+	return SlugError{
+		error:     error,
+		slug:      slug,
+		errorType: ErrorTypeNotFound,
+	}
+}
+
+func NewConflictError(error string, slug string) SlugError {
+	return SlugError{
+		error:     error,
+		slug:      slug,
+		errorType: ErrorTypeConflict,
+	}
+}
+
+func NewPreconditionFailedError(error string, slug string) SlugError {
+	return SlugError{
+		error:     error,
+		slug:      slug,
+		errorType: ErrorTypePreconditionFailed,
+	}
+}
+
+// NewResourceExhaustedError 用于服务过载时快速拒绝的请求，调用方可以稍后重试
+func NewResourceExhaustedError(error string, slug string) SlugError {
+	return SlugError{
+		error:     error,
+		slug:      slug,
+		errorType: ErrorTypeResourceExhausted,
+	}
+}
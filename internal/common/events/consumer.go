@@ -0,0 +1,37 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/sirupsen/logrus"
+)
+
+// JSONHandler 把消息的 JSON payload 解码为 T 后交给 handle，handle 需要按 eventUUID 幂等处理
+// 无法解析的消息重试也不会成功，记录后跳过，避免阻塞后续消息
+func JSONHandler[T any](handle func(ctx context.Context, eventUUID string, event T) error) Handler {
+	return func(ctx context.Context, msg Message) error {
+		var event T
+		if err := json.Unmarshal(msg.Payload, &event); err != nil {
+			logrus.WithError(err).WithFields(logrus.Fields{
+				"event_uuid": msg.UUID,
+				"event_name": msg.Name,
+			}).Error("Skipping malformed event")
+			return nil
+		}
+		return handle(ctx, msg.UUID, event)
+	}
+}
+
+// RunConsumers 为 handlers 中的每个事件名启动一个订阅，订阅在 ctx 被取消时结束
+// 所有服务实例属于同一个消费组，每条事件只由一个实例处理；任意订阅失败时调用 stop 让服务优雅退出
+func RunConsumers(ctx context.Context, stop context.CancelFunc, subscriber Subscriber, handlers map[string]Handler) {
+	for eventName, handler := range handlers {
+		go func() {
+			if err := subscriber.Subscribe(ctx, eventName, handler); err != nil {
+				logrus.WithError(err).WithField("event_name", eventName).Error("Events consumer stopped, shutting down")
+				stop()
+			}
+		}()
+	}
+}
@@ -0,0 +1,83 @@
+package events_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"newTiktoken/internal/common/events"
+)
+
+type videoPublished struct {
+	AuthorUUID string `json:"author_uuid"`
+}
+
+func TestJSONHandlerDecodesPayload(t *testing.T) {
+	t.Parallel()
+	var handled []string
+	handler := events.JSONHandler(func(_ context.Context, eventUUID string, event videoPublished) error {
+		handled = append(handled, eventUUID+":"+event.AuthorUUID)
+		return nil
+	})
+
+	err := handler(context.Background(), events.Message{UUID: "event-1", Name: "VideoPublished", Payload: []byte(`{"author_uuid":"user-a"}`)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 无法解析的消息被跳过而不是返回错误，否则会被无限重新投递
+	err = handler(context.Background(), events.Message{UUID: "event-2", Name: "VideoPublished", Payload: []byte(`not json`)})
+	if err != nil {
+		t.Fatalf("expected malformed event to be skipped, got %v", err)
+	}
+
+	if len(handled) != 1 || handled[0] != "event-1:user-a" {
+		t.Errorf("expected only event-1 to be handled, got %v", handled)
+	}
+}
+
+func TestJSONHandlerReturnsHandleError(t *testing.T) {
+	t.Parallel()
+	expected := errors.New("database is down")
+	handler := events.JSONHandler(func(context.Context, string, videoPublished) error {
+		return expected
+	})
+
+	err := handler(context.Background(), events.Message{UUID: "event-1", Payload: []byte(`{}`)})
+	if !errors.Is(err, expected) {
+		t.Errorf("expected %v to be returned for redelivery, got %v", expected, err)
+	}
+}
+
+// failingSubscriber 的 VideoPublished 订阅立即失败，其他订阅阻塞到 ctx 被取消
+type failingSubscriber struct{}
+
+func (failingSubscriber) Subscribe(ctx context.Context, eventName string, _ events.Handler) error {
+	if eventName == "VideoPublished" {
+		return errors.New("broker is unreachable")
+	}
+	<-ctx.Done()
+	return nil
+}
+
+func (failingSubscriber) Close() error {
+	return nil
+}
+
+func TestRunConsumersStopsServiceWhenSubscriptionFails(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	handler := func(context.Context, events.Message) error { return nil }
+
+	events.RunConsumers(ctx, cancel, failingSubscriber{}, map[string]events.Handler{
+		"VideoPublished":  handler,
+		"FavoriteChanged": handler,
+	})
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected stop to be called after the subscription failed")
+	}
+}
@@ -0,0 +1,26 @@
+package events
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// processed_events 表由 internal/common/migrations 中的 0008_create_processed_events 迁移创建
+
+// MarkProcessed 在消费者的业务事务 tx 中记录事件已处理，事件此前已被 consumer 处理过时返回 false，
+// 调用方应直接提交事务而不再重复执行业务逻辑
+func MarkProcessed(ctx context.Context, tx *sql.Tx, consumer string, eventUUID string) (bool, error) {
+	const insertQuery = "INSERT IGNORE INTO processed_events (consumer, event_uuid, processed_at) VALUES (?, ?, ?)"
+	result, err := tx.ExecContext(ctx, insertQuery, consumer, eventUUID, time.Now().UTC())
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to mark event %s as processed", eventUUID)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get affected rows")
+	}
+	return affected == 1, nil
+}
@@ -0,0 +1,147 @@
+package events
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// outbox_events 表由 internal/common/migrations 中的 0003_create_outbox_events 迁移创建
+
+// StoreInOutbox 在业务事务 tx 中写入事件，保证事件与业务数据一起提交或回滚
+// 实现了 PartitionedEvent 的事件同时记录分区键
+func StoreInOutbox(ctx context.Context, tx *sql.Tx, events ...Event) error {
+	const insertQuery = "INSERT INTO outbox_events (event_uuid, event_name, partition_key, payload, created_at) VALUES (?, ?, ?, ?, ?)"
+	for _, event := range events {
+		payload, err := json.Marshal(event)
+		if err != nil {
+			return errors.Wrapf(err, "failed to marshal event %s", event.EventName())
+		}
+		var partitionKey string
+		if partitioned, ok := event.(PartitionedEvent); ok {
+			partitionKey = partitioned.PartitionKey()
+		}
+		_, err = tx.ExecContext(ctx, insertQuery, uuid.NewString(), event.EventName(), partitionKey, payload, time.Now().UTC())
+		if err != nil {
+			return errors.Wrapf(err, "failed to store event %s in outbox", event.EventName())
+		}
+	}
+	return nil
+}
+
+// OutboxRelay 轮询 outbox 表并把未发布的事件发布出去
+// 事件先发布再标记为已发布，进程在两者之间崩溃时事件会被重复发布，因此消费者需要按 Message.UUID 幂等处理
+type OutboxRelay struct {
+	db           *sql.DB
+	publisher    Publisher
+	logger       *logrus.Entry
+	batchSize    int
+	pollInterval time.Duration
+}
+
+func NewOutboxRelay(db *sql.DB, publisher Publisher, logger *logrus.Entry) *OutboxRelay {
+	if db == nil {
+		panic("nil db")
+	}
+	if publisher == nil {
+		panic("nil publisher")
+	}
+	return &OutboxRelay{
+		db:           db,
+		publisher:    publisher,
+		logger:       logger,
+		batchSize:    100,
+		pollInterval: time.Second,
+	}
+}
+
+// Run 持续转发事件直到 ctx 被取消；一批事件刚好填满时立即拉取下一批
+func (r *OutboxRelay) Run(ctx context.Context) error {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-timer.C:
+		}
+
+		relayed, err := r.RelayBatch(ctx)
+		if err != nil {
+			r.logger.WithError(err).Error("Failed to relay outbox events")
+		}
+		if err == nil && relayed == r.batchSize {
+			timer.Reset(0)
+		} else {
+			timer.Reset(r.pollInterval)
+		}
+	}
+}
+
+// RelayBatch 发布一批未发布的事件，SKIP LOCKED 使多个 relay 实例可以并行工作而不会重复拿到同一批事件
+func (r *OutboxRelay) RelayBatch(ctx context.Context) (relayed int, err error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to begin transaction")
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		} else if err != nil {
+			_ = tx.Rollback()
+		} else {
+			err = tx.Commit()
+		}
+	}()
+
+	const selectQuery = `
+        SELECT id, event_uuid, event_name, partition_key, payload
+        FROM outbox_events
+        WHERE published_at IS NULL
+        ORDER BY id
+        LIMIT ?
+        FOR UPDATE SKIP LOCKED`
+	rows, err := tx.QueryContext(ctx, selectQuery, r.batchSize)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to query outbox events")
+	}
+
+	var ids []any
+	var messages []Message
+	for rows.Next() {
+		var id uint64
+		var message Message
+		if err = rows.Scan(&id, &message.UUID, &message.Name, &message.Key, &message.Payload); err != nil {
+			_ = rows.Close()
+			return 0, errors.Wrap(err, "failed to scan outbox event")
+		}
+		ids = append(ids, id)
+		messages = append(messages, message)
+	}
+	_ = rows.Close()
+	if err = rows.Err(); err != nil {
+		return 0, errors.Wrap(err, "failed to iterate outbox events")
+	}
+	if len(messages) == 0 {
+		return 0, nil
+	}
+
+	if err = r.publisher.Publish(ctx, messages...); err != nil {
+		return 0, errors.Wrap(err, "failed to publish outbox events")
+	}
+
+	updateQuery := "UPDATE outbox_events SET published_at = ? WHERE id IN (" +
+		strings.TrimSuffix(strings.Repeat("?, ", len(ids)), ", ") + ")"
+	if _, err = tx.ExecContext(ctx, updateQuery, append([]any{time.Now().UTC()}, ids...)...); err != nil {
+		return 0, errors.Wrap(err, "failed to mark outbox events as published")
+	}
+	return len(messages), nil
+}
@@ -1 +1,28 @@
 package events
+
+import "context"
+
+// Event 是需要通知给其他服务的领域事件，EventName 同时作为消息队列的 topic
+type Event interface {
+	EventName() string
+}
+
+// PartitionedEvent 是需要保序的事件，PartitionKey 相同的事件发布到同一个分区，按写入 outbox 的顺序被消费
+type PartitionedEvent interface {
+	Event
+	PartitionKey() string
+}
+
+// Message 是从 outbox 中取出、待发布的事件，Key 为空时消息随机分区
+type Message struct {
+	UUID    string
+	Name    string
+	Key     string
+	Payload []byte
+}
+
+// Publisher 把事件消息发布到消息队列，需要保证返回 nil 时消息已被队列确认
+type Publisher interface {
+	Publish(ctx context.Context, messages ...Message) error
+	Close() error
+}
@@ -0,0 +1,13 @@
+package events
+
+import "context"
+
+// Handler 处理一条事件消息，返回错误时消息会被重新投递
+type Handler func(ctx context.Context, msg Message) error
+
+// Subscriber 从消息队列订阅事件；同一条消息可能被投递多次，handler 需要按 Message.UUID 幂等处理
+type Subscriber interface {
+	// Subscribe 把 topic 为 eventName 的消息依次交给 handler，直到 ctx 被取消
+	Subscribe(ctx context.Context, eventName string, handler Handler) error
+	Close() error
+}
@@ -0,0 +1,35 @@
+package watermill
+
+import (
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/sirupsen/logrus"
+)
+
+// logrusLoggerAdapter 让 watermill 复用服务的 logrus 配置
+type logrusLoggerAdapter struct {
+	entry *logrus.Entry
+}
+
+func NewLogrusLogger(entry *logrus.Entry) watermill.LoggerAdapter {
+	return logrusLoggerAdapter{entry: entry}
+}
+
+func (l logrusLoggerAdapter) Error(msg string, err error, fields watermill.LogFields) {
+	l.entry.WithFields(logrus.Fields(fields)).WithError(err).Error(msg)
+}
+
+func (l logrusLoggerAdapter) Info(msg string, fields watermill.LogFields) {
+	l.entry.WithFields(logrus.Fields(fields)).Info(msg)
+}
+
+func (l logrusLoggerAdapter) Debug(msg string, fields watermill.LogFields) {
+	l.entry.WithFields(logrus.Fields(fields)).Debug(msg)
+}
+
+func (l logrusLoggerAdapter) Trace(msg string, fields watermill.LogFields) {
+	l.entry.WithFields(logrus.Fields(fields)).Trace(msg)
+}
+
+func (l logrusLoggerAdapter) With(fields watermill.LogFields) watermill.LoggerAdapter {
+	return logrusLoggerAdapter{entry: l.entry.WithFields(logrus.Fields(fields))}
+}
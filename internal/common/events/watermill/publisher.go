@@ -1 +1,67 @@
 package watermill
+
+import (
+	"context"
+
+	"github.com/ThreeDotsLabs/watermill-kafka/v3/pkg/kafka"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/events"
+)
+
+const (
+	eventNameMetadataKey    = "event_name"
+	partitionKeyMetadataKey = "partition_key"
+)
+
+// Publisher 把 events.Message 转换为 watermill 消息，topic 为事件名
+type Publisher struct {
+	publisher message.Publisher
+}
+
+func NewPublisher(publisher message.Publisher) Publisher {
+	return Publisher{publisher: publisher}
+}
+
+func NewKafkaPublisher(brokers []string, logger *logrus.Entry) (Publisher, error) {
+	publisher, err := kafka.NewPublisher(
+		kafka.PublisherConfig{
+			Brokers:   brokers,
+			Marshaler: kafka.NewWithPartitioningMarshaler(partitionKey),
+		},
+		NewLogrusLogger(logger),
+	)
+	if err != nil {
+		return Publisher{}, errors.Wrap(err, "failed to create kafka publisher")
+	}
+	logger.WithField("brokers", brokers).Info("Kafka publisher created")
+	return NewPublisher(publisher), nil
+}
+
+func (p Publisher) Publish(ctx context.Context, messages ...events.Message) error {
+	for _, msg := range messages {
+		watermillMessage := message.NewMessage(msg.UUID, msg.Payload)
+		watermillMessage.Metadata.Set(eventNameMetadataKey, msg.Name)
+		if msg.Key != "" {
+			watermillMessage.Metadata.Set(partitionKeyMetadataKey, msg.Key)
+		}
+		watermillMessage.SetContext(ctx)
+		if err := p.publisher.Publish(msg.Name, watermillMessage); err != nil {
+			return errors.Wrapf(err, "failed to publish event %s", msg.UUID)
+		}
+	}
+	return nil
+}
+
+// partitionKey 返回事件的分区键，没有分区键的事件以消息 UUID 作为 key，仍然均匀分布到各个分区
+func partitionKey(_ string, msg *message.Message) (string, error) {
+	if key := msg.Metadata.Get(partitionKeyMetadataKey); key != "" {
+		return key, nil
+	}
+	return msg.UUID, nil
+}
+
+func (p Publisher) Close() error {
+	return p.publisher.Close()
+}
@@ -0,0 +1,79 @@
+package watermill
+
+import (
+	"context"
+
+	"github.com/IBM/sarama"
+	"github.com/ThreeDotsLabs/watermill-kafka/v3/pkg/kafka"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/events"
+)
+
+// Subscriber 把 watermill 消息转换为 events.Message，handler 返回错误时 Nack 让消息重新投递
+type Subscriber struct {
+	subscriber message.Subscriber
+	logger     *logrus.Entry
+}
+
+func NewSubscriber(subscriber message.Subscriber, logger *logrus.Entry) Subscriber {
+	return Subscriber{subscriber: subscriber, logger: logger}
+}
+
+// NewKafkaSubscriber 创建属于 consumerGroup 的订阅者，新的消费组从最早的消息开始消费
+func NewKafkaSubscriber(brokers []string, consumerGroup string, logger *logrus.Entry) (Subscriber, error) {
+	saramaConfig := kafka.DefaultSaramaSubscriberConfig()
+	saramaConfig.Consumer.Offsets.Initial = sarama.OffsetOldest
+
+	subscriber, err := kafka.NewSubscriber(
+		kafka.SubscriberConfig{
+			Brokers:               brokers,
+			Unmarshaler:           kafka.DefaultMarshaler{},
+			OverwriteSaramaConfig: saramaConfig,
+			ConsumerGroup:         consumerGroup,
+		},
+		NewLogrusLogger(logger),
+	)
+	if err != nil {
+		return Subscriber{}, errors.Wrap(err, "failed to create kafka subscriber")
+	}
+	logger.WithFields(logrus.Fields{
+		"brokers":        brokers,
+		"consumer_group": consumerGroup,
+	}).Info("Kafka subscriber created")
+	return NewSubscriber(subscriber, logger), nil
+}
+
+func (s Subscriber) Subscribe(ctx context.Context, eventName string, handler events.Handler) error {
+	messages, err := s.subscriber.Subscribe(ctx, eventName)
+	if err != nil {
+		return errors.Wrapf(err, "failed to subscribe to %s", eventName)
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case watermillMessage, ok := <-messages:
+			if !ok {
+				return nil
+			}
+			msg := events.Message{
+				UUID:    watermillMessage.UUID,
+				Name:    watermillMessage.Metadata.Get(eventNameMetadataKey),
+				Key:     watermillMessage.Metadata.Get(partitionKeyMetadataKey),
+				Payload: watermillMessage.Payload,
+			}
+			if err := handler(watermillMessage.Context(), msg); err != nil {
+				s.logger.WithError(err).WithField("event_uuid", msg.UUID).Warn("Failed to handle event, it will be redelivered")
+				watermillMessage.Nack()
+				continue
+			}
+			watermillMessage.Ack()
+		}
+	}
+}
+
+func (s Subscriber) Close() error {
+	return s.subscriber.Close()
+}
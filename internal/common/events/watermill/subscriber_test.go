@@ -0,0 +1,63 @@
+package watermill_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/events"
+	"newTiktoken/internal/common/events/watermill"
+)
+
+func TestSubscriberRedeliversFailedMessages(t *testing.T) {
+	logger := logrus.NewEntry(logrus.StandardLogger())
+	pubSub := gochannel.NewGoChannel(gochannel.Config{Persistent: true}, watermill.NewLogrusLogger(logger))
+	defer pubSub.Close()
+
+	published := events.Message{UUID: "event-1", Name: "RelationChanged", Key: "user-a", Payload: []byte(`{"status":1}`)}
+	if err := watermill.NewPublisher(pubSub).Publish(context.Background(), published); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	received := make(chan events.Message, 2)
+	attempts := 0
+	handler := func(_ context.Context, msg events.Message) error {
+		received <- msg
+		attempts++
+		if attempts == 1 {
+			return errors.New("temporary failure")
+		}
+		return nil
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- watermill.NewSubscriber(pubSub, logger).Subscribe(ctx, "RelationChanged", handler)
+	}()
+
+	for i := 0; i < 2; i++ {
+		select {
+		case msg := <-received:
+			if msg.UUID != published.UUID || msg.Name != published.Name || msg.Key != published.Key || string(msg.Payload) != string(published.Payload) {
+				t.Errorf("unexpected message %+v", msg)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("message was delivered %d times, expected 2", i)
+		}
+	}
+
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("expected nil after cancellation, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Subscribe did not return after ctx was cancelled")
+	}
+}
@@ -30,6 +30,14 @@ type UserServiceClient interface {
 	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// RPC 方法 3: 获取用户详细信息 (对应 InformationOfUser Query)
 	GetUserInformation(ctx context.Context, in *GetUserInformationRequest, opts ...grpc.CallOption) (*User, error)
+	// RPC 方法 4: 批量获取用户详细信息 (对应 InformationOfUsers Query)
+	// 列表类接口应使用该方法，避免逐个调用 GetUserInformation 产生 N+1 次请求
+	BatchGetUserInformation(ctx context.Context, in *BatchGetUserInformationRequest, opts ...grpc.CallOption) (*BatchGetUserInformationResponse, error)
+	// RPC 方法 5: 使用用户名和密码注册 (对应 Register Command)，不需要认证
+	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error)
+	// RPC 方法 6: 使用用户名和密码登录 (对应 Login Command)，不需要认证
+	// 用户名不存在和密码错误返回相同的 UNAUTHENTICATED 错误
+	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
 }
 
 type userServiceClient struct {
@@ -67,6 +75,33 @@ func (c *userServiceClient) GetUserInformation(ctx context.Context, in *GetUserI
 	return out, nil
 }
 
+func (c *userServiceClient) BatchGetUserInformation(ctx context.Context, in *BatchGetUserInformationRequest, opts ...grpc.CallOption) (*BatchGetUserInformationResponse, error) {
+	out := new(BatchGetUserInformationResponse)
+	err := c.cc.Invoke(ctx, "/user_v1.UserService/BatchGetUserInformation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error) {
+	out := new(RegisterResponse)
+	err := c.cc.Invoke(ctx, "/user_v1.UserService/Register", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, "/user_v1.UserService/Login", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility
@@ -78,6 +113,14 @@ type UserServiceServer interface {
 	UpdateUser(context.Context, *UpdateUserRequest) (*emptypb.Empty, error)
 	// RPC 方法 3: 获取用户详细信息 (对应 InformationOfUser Query)
 	GetUserInformation(context.Context, *GetUserInformationRequest) (*User, error)
+	// RPC 方法 4: 批量获取用户详细信息 (对应 InformationOfUsers Query)
+	// 列表类接口应使用该方法，避免逐个调用 GetUserInformation 产生 N+1 次请求
+	BatchGetUserInformation(context.Context, *BatchGetUserInformationRequest) (*BatchGetUserInformationResponse, error)
+	// RPC 方法 5: 使用用户名和密码注册 (对应 Register Command)，不需要认证
+	Register(context.Context, *RegisterRequest) (*RegisterResponse, error)
+	// RPC 方法 6: 使用用户名和密码登录 (对应 Login Command)，不需要认证
+	// 用户名不存在和密码错误返回相同的 UNAUTHENTICATED 错误
+	Login(context.Context, *LoginRequest) (*LoginResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -94,6 +137,15 @@ func (UnimplementedUserServiceServer) UpdateUser(context.Context, *UpdateUserReq
 func (UnimplementedUserServiceServer) GetUserInformation(context.Context, *GetUserInformationRequest) (*User, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserInformation not implemented")
 }
+func (UnimplementedUserServiceServer) BatchGetUserInformation(context.Context, *BatchGetUserInformationRequest) (*BatchGetUserInformationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetUserInformation not implemented")
+}
+func (UnimplementedUserServiceServer) Register(context.Context, *RegisterRequest) (*RegisterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+}
+func (UnimplementedUserServiceServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 
 // UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -161,6 +213,60 @@ func _UserService_GetUserInformation_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_BatchGetUserInformation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetUserInformationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).BatchGetUserInformation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user_v1.UserService/BatchGetUserInformation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).BatchGetUserInformation(ctx, req.(*BatchGetUserInformationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user_v1.UserService/Register",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Register(ctx, req.(*RegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user_v1.UserService/Login",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -180,6 +286,18 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserInformation",
 			Handler:    _UserService_GetUserInformation_Handler,
 		},
+		{
+			MethodName: "BatchGetUserInformation",
+			Handler:    _UserService_BatchGetUserInformation_Handler,
+		},
+		{
+			MethodName: "Register",
+			Handler:    _UserService_Register_Handler,
+		},
+		{
+			MethodName: "Login",
+			Handler:    _UserService_Login_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/user.proto",
@@ -0,0 +1,285 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v5.29.1
+// source: v1/user_relation.proto
+
+package user_relation
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// RelationServiceClient is the client API for RelationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RelationServiceClient interface {
+	RelationAction(ctx context.Context, in *RelationActionRequest, opts ...grpc.CallOption) (*RelationActionResponse, error)
+	RelationFollowList(ctx context.Context, in *RelationFollowListRequest, opts ...grpc.CallOption) (*RelationFollowListResponse, error)
+	RelationFollowerList(ctx context.Context, in *RelationFollowerListRequest, opts ...grpc.CallOption) (*RelationFollowerListResponse, error)
+	RelationFriendList(ctx context.Context, in *RelationFriendListRequest, opts ...grpc.CallOption) (*RelationFriendListResponse, error)
+	RelationBlockList(ctx context.Context, in *RelationBlockListRequest, opts ...grpc.CallOption) (*RelationBlockListResponse, error)
+	GetHotUsers(ctx context.Context, in *GetHotUsersRequest, opts ...grpc.CallOption) (*GetHotUsersResponse, error)
+}
+
+type relationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRelationServiceClient(cc grpc.ClientConnInterface) RelationServiceClient {
+	return &relationServiceClient{cc}
+}
+
+func (c *relationServiceClient) RelationAction(ctx context.Context, in *RelationActionRequest, opts ...grpc.CallOption) (*RelationActionResponse, error) {
+	out := new(RelationActionResponse)
+	err := c.cc.Invoke(ctx, "/relation.RelationService/RelationAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationServiceClient) RelationFollowList(ctx context.Context, in *RelationFollowListRequest, opts ...grpc.CallOption) (*RelationFollowListResponse, error) {
+	out := new(RelationFollowListResponse)
+	err := c.cc.Invoke(ctx, "/relation.RelationService/RelationFollowList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationServiceClient) RelationFollowerList(ctx context.Context, in *RelationFollowerListRequest, opts ...grpc.CallOption) (*RelationFollowerListResponse, error) {
+	out := new(RelationFollowerListResponse)
+	err := c.cc.Invoke(ctx, "/relation.RelationService/RelationFollowerList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationServiceClient) RelationFriendList(ctx context.Context, in *RelationFriendListRequest, opts ...grpc.CallOption) (*RelationFriendListResponse, error) {
+	out := new(RelationFriendListResponse)
+	err := c.cc.Invoke(ctx, "/relation.RelationService/RelationFriendList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationServiceClient) RelationBlockList(ctx context.Context, in *RelationBlockListRequest, opts ...grpc.CallOption) (*RelationBlockListResponse, error) {
+	out := new(RelationBlockListResponse)
+	err := c.cc.Invoke(ctx, "/relation.RelationService/RelationBlockList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationServiceClient) GetHotUsers(ctx context.Context, in *GetHotUsersRequest, opts ...grpc.CallOption) (*GetHotUsersResponse, error) {
+	out := new(GetHotUsersResponse)
+	err := c.cc.Invoke(ctx, "/relation.RelationService/GetHotUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RelationServiceServer is the server API for RelationService service.
+// All implementations must embed UnimplementedRelationServiceServer
+// for forward compatibility
+type RelationServiceServer interface {
+	RelationAction(context.Context, *RelationActionRequest) (*RelationActionResponse, error)
+	RelationFollowList(context.Context, *RelationFollowListRequest) (*RelationFollowListResponse, error)
+	RelationFollowerList(context.Context, *RelationFollowerListRequest) (*RelationFollowerListResponse, error)
+	RelationFriendList(context.Context, *RelationFriendListRequest) (*RelationFriendListResponse, error)
+	RelationBlockList(context.Context, *RelationBlockListRequest) (*RelationBlockListResponse, error)
+	GetHotUsers(context.Context, *GetHotUsersRequest) (*GetHotUsersResponse, error)
+	mustEmbedUnimplementedRelationServiceServer()
+}
+
+// UnimplementedRelationServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedRelationServiceServer struct {
+}
+
+func (UnimplementedRelationServiceServer) RelationAction(context.Context, *RelationActionRequest) (*RelationActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelationAction not implemented")
+}
+func (UnimplementedRelationServiceServer) RelationFollowList(context.Context, *RelationFollowListRequest) (*RelationFollowListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelationFollowList not implemented")
+}
+func (UnimplementedRelationServiceServer) RelationFollowerList(context.Context, *RelationFollowerListRequest) (*RelationFollowerListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelationFollowerList not implemented")
+}
+func (UnimplementedRelationServiceServer) RelationFriendList(context.Context, *RelationFriendListRequest) (*RelationFriendListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelationFriendList not implemented")
+}
+func (UnimplementedRelationServiceServer) RelationBlockList(context.Context, *RelationBlockListRequest) (*RelationBlockListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelationBlockList not implemented")
+}
+func (UnimplementedRelationServiceServer) GetHotUsers(context.Context, *GetHotUsersRequest) (*GetHotUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHotUsers not implemented")
+}
+func (UnimplementedRelationServiceServer) mustEmbedUnimplementedRelationServiceServer() {}
+
+// UnsafeRelationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RelationServiceServer will
+// result in compilation errors.
+type UnsafeRelationServiceServer interface {
+	mustEmbedUnimplementedRelationServiceServer()
+}
+
+func RegisterRelationServiceServer(s grpc.ServiceRegistrar, srv RelationServiceServer) {
+	s.RegisterService(&RelationService_ServiceDesc, srv)
+}
+
+func _RelationService_RelationAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelationActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationServiceServer).RelationAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relation.RelationService/RelationAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationServiceServer).RelationAction(ctx, req.(*RelationActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationService_RelationFollowList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelationFollowListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationServiceServer).RelationFollowList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relation.RelationService/RelationFollowList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationServiceServer).RelationFollowList(ctx, req.(*RelationFollowListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationService_RelationFollowerList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelationFollowerListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationServiceServer).RelationFollowerList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relation.RelationService/RelationFollowerList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationServiceServer).RelationFollowerList(ctx, req.(*RelationFollowerListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationService_RelationFriendList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelationFriendListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationServiceServer).RelationFriendList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relation.RelationService/RelationFriendList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationServiceServer).RelationFriendList(ctx, req.(*RelationFriendListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationService_RelationBlockList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelationBlockListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationServiceServer).RelationBlockList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relation.RelationService/RelationBlockList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationServiceServer).RelationBlockList(ctx, req.(*RelationBlockListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationService_GetHotUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHotUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationServiceServer).GetHotUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relation.RelationService/GetHotUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationServiceServer).GetHotUsers(ctx, req.(*GetHotUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RelationService_ServiceDesc is the grpc.ServiceDesc for RelationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RelationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "relation.RelationService",
+	HandlerType: (*RelationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RelationAction",
+			Handler:    _RelationService_RelationAction_Handler,
+		},
+		{
+			MethodName: "RelationFollowList",
+			Handler:    _RelationService_RelationFollowList_Handler,
+		},
+		{
+			MethodName: "RelationFollowerList",
+			Handler:    _RelationService_RelationFollowerList_Handler,
+		},
+		{
+			MethodName: "RelationFriendList",
+			Handler:    _RelationService_RelationFriendList_Handler,
+		},
+		{
+			MethodName: "RelationBlockList",
+			Handler:    _RelationService_RelationBlockList_Handler,
+		},
+		{
+			MethodName: "GetHotUsers",
+			Handler:    _RelationService_GetHotUsers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "v1/user_relation.proto",
+}
@@ -0,0 +1,518 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v5.29.1
+// source: v1/video_favorite.proto
+
+package video_favorite
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	video "newTiktoken/internal/common/genproto/video"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ================视频点赞、踩操作========================
+type VideoActionType int32
+
+const (
+	// 点赞
+	VideoActionType_LIKE VideoActionType = 0
+	// 踩
+	VideoActionType_DISLIKE VideoActionType = 1
+	// 取消点赞
+	VideoActionType_CANCEL_LIKE VideoActionType = 2
+	// 取消踩
+	VideoActionType_CANCEL_DISLIKE VideoActionType = 3
+	// 错误类型
+	VideoActionType_WRONG_TYPE VideoActionType = 4
+)
+
+// Enum value maps for VideoActionType.
+var (
+	VideoActionType_name = map[int32]string{
+		0: "LIKE",
+		1: "DISLIKE",
+		2: "CANCEL_LIKE",
+		3: "CANCEL_DISLIKE",
+		4: "WRONG_TYPE",
+	}
+	VideoActionType_value = map[string]int32{
+		"LIKE":           0,
+		"DISLIKE":        1,
+		"CANCEL_LIKE":    2,
+		"CANCEL_DISLIKE": 3,
+		"WRONG_TYPE":     4,
+	}
+)
+
+func (x VideoActionType) Enum() *VideoActionType {
+	p := new(VideoActionType)
+	*p = x
+	return p
+}
+
+func (x VideoActionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VideoActionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_video_favorite_proto_enumTypes[0].Descriptor()
+}
+
+func (VideoActionType) Type() protoreflect.EnumType {
+	return &file_v1_video_favorite_proto_enumTypes[0]
+}
+
+func (x VideoActionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VideoActionType.Descriptor instead.
+func (VideoActionType) EnumDescriptor() ([]byte, []int) {
+	return file_v1_video_favorite_proto_rawDescGZIP(), []int{0}
+}
+
+type FavoriteActionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// @gotags: json:"action_type"
+	ActionType VideoActionType `protobuf:"varint,3,opt,name=action_type,json=actionType,proto3,enum=favorite.VideoActionType" json:"action_type,omitempty"`
+	// @gotags: json:"video_uuid"
+	VideoUuid string `protobuf:"bytes,4,opt,name=video_uuid,json=videoUuid,proto3" json:"video_uuid,omitempty"`
+}
+
+func (x *FavoriteActionRequest) Reset() {
+	*x = FavoriteActionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_video_favorite_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FavoriteActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FavoriteActionRequest) ProtoMessage() {}
+
+func (x *FavoriteActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_video_favorite_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FavoriteActionRequest.ProtoReflect.Descriptor instead.
+func (*FavoriteActionRequest) Descriptor() ([]byte, []int) {
+	return file_v1_video_favorite_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FavoriteActionRequest) GetActionType() VideoActionType {
+	if x != nil {
+		return x.ActionType
+	}
+	return VideoActionType_LIKE
+}
+
+func (x *FavoriteActionRequest) GetVideoUuid() string {
+	if x != nil {
+		return x.VideoUuid
+	}
+	return ""
+}
+
+type FavoriteActionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// @gotags: json:"status_code"
+	StatusCode int32 `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	// @gotags: json:"status_msg"
+	StatusMsg string `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+}
+
+func (x *FavoriteActionResponse) Reset() {
+	*x = FavoriteActionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_video_favorite_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FavoriteActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FavoriteActionResponse) ProtoMessage() {}
+
+func (x *FavoriteActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_video_favorite_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FavoriteActionResponse.ProtoReflect.Descriptor instead.
+func (*FavoriteActionResponse) Descriptor() ([]byte, []int) {
+	return file_v1_video_favorite_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FavoriteActionResponse) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *FavoriteActionResponse) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+// ==============================点赞列表=======================================
+type FavoriteListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// @gotags: json:"user_uuid"
+	UserUuid string `protobuf:"bytes,3,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	// 上一页返回的 next_cursor，为空表示从第一页开始
+	// @gotags: json:"cursor"
+	Cursor string `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// 每页条数，为 0 时使用默认值
+	// @gotags: json:"limit"
+	Limit uint32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *FavoriteListRequest) Reset() {
+	*x = FavoriteListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_video_favorite_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FavoriteListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FavoriteListRequest) ProtoMessage() {}
+
+func (x *FavoriteListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_video_favorite_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FavoriteListRequest.ProtoReflect.Descriptor instead.
+func (*FavoriteListRequest) Descriptor() ([]byte, []int) {
+	return file_v1_video_favorite_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FavoriteListRequest) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *FavoriteListRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *FavoriteListRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type FavoriteListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// @gotags: json:"status_code"
+	StatusCode int32 `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"` // 状态码，0-成功，其他值-失败
+	// @gotags: json:"status_msg"
+	StatusMsg string `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"` // 返回状态描述
+	// 按点赞时间倒序排列
+	// @gotags: json:"video_list"
+	VideoList []*video.Video `protobuf:"bytes,3,rep,name=video_list,json=videoList,proto3" json:"video_list,omitempty"` // 用户点赞视频列表
+	// 下一页的游标，为空表示没有更多数据
+	// @gotags: json:"next_cursor"
+	NextCursor string `protobuf:"bytes,4,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *FavoriteListResponse) Reset() {
+	*x = FavoriteListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_video_favorite_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FavoriteListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FavoriteListResponse) ProtoMessage() {}
+
+func (x *FavoriteListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_video_favorite_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FavoriteListResponse.ProtoReflect.Descriptor instead.
+func (*FavoriteListResponse) Descriptor() ([]byte, []int) {
+	return file_v1_video_favorite_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FavoriteListResponse) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *FavoriteListResponse) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *FavoriteListResponse) GetVideoList() []*video.Video {
+	if x != nil {
+		return x.VideoList
+	}
+	return nil
+}
+
+func (x *FavoriteListResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+var File_v1_video_favorite_proto protoreflect.FileDescriptor
+
+var file_v1_video_favorite_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x76, 0x31, 0x2f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x66, 0x61, 0x76, 0x6f, 0x72,
+	0x69, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x66, 0x61, 0x76, 0x6f, 0x72,
+	0x69, 0x74, 0x65, 0x1a, 0x0e, 0x76, 0x31, 0x2f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x97, 0x01, 0x0a, 0x15, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a,
+	0x0b, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x19, 0x2e, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x2e, 0x56, 0x69,
+	0x64, 0x65, 0x6f, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x69, 0x64,
+	0x65, 0x6f, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76,
+	0x69, 0x64, 0x65, 0x6f, 0x55, 0x75, 0x69, 0x64, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x4a, 0x04,
+	0x08, 0x02, 0x10, 0x03, 0x52, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x52, 0x08, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x69, 0x64, 0x22, 0x58, 0x0a,
+	0x16, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x4d, 0x73, 0x67, 0x22, 0x84, 0x01, 0x0a, 0x13, 0x46, 0x61, 0x76, 0x6f,
+	0x72, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02,
+	0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x52,
+	0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0xa4,
+	0x01, 0x0a, 0x14, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x4d, 0x73, 0x67, 0x12, 0x2b, 0x0a, 0x0a, 0x76, 0x69, 0x64, 0x65, 0x6f,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x76, 0x69,
+	0x64, 0x65, 0x6f, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x09, 0x76, 0x69, 0x64, 0x65, 0x6f,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x2a, 0x5d, 0x0a, 0x0f, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x49, 0x4b, 0x45,
+	0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x49, 0x53, 0x4c, 0x49, 0x4b, 0x45, 0x10, 0x01, 0x12,
+	0x0f, 0x0a, 0x0b, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x5f, 0x4c, 0x49, 0x4b, 0x45, 0x10, 0x02,
+	0x12, 0x12, 0x0a, 0x0e, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x5f, 0x44, 0x49, 0x53, 0x4c, 0x49,
+	0x4b, 0x45, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x57, 0x52, 0x4f, 0x4e, 0x47, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x10, 0x04, 0x32, 0xb5, 0x01, 0x0a, 0x0f, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74,
+	0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x0e, 0x46, 0x61, 0x76, 0x6f,
+	0x72, 0x69, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x66, 0x61, 0x76,
+	0x6f, 0x72, 0x69, 0x74, 0x65, 0x2e, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x66, 0x61,
+	0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x2e, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a,
+	0x0c, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x2e,
+	0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x2e, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x66,
+	0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x2e, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2a, 0x5a, 0x28,
+	0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2f, 0x67, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f,
+	0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_v1_video_favorite_proto_rawDescOnce sync.Once
+	file_v1_video_favorite_proto_rawDescData = file_v1_video_favorite_proto_rawDesc
+)
+
+func file_v1_video_favorite_proto_rawDescGZIP() []byte {
+	file_v1_video_favorite_proto_rawDescOnce.Do(func() {
+		file_v1_video_favorite_proto_rawDescData = protoimpl.X.CompressGZIP(file_v1_video_favorite_proto_rawDescData)
+	})
+	return file_v1_video_favorite_proto_rawDescData
+}
+
+var file_v1_video_favorite_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_v1_video_favorite_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_v1_video_favorite_proto_goTypes = []interface{}{
+	(VideoActionType)(0),           // 0: favorite.VideoActionType
+	(*FavoriteActionRequest)(nil),  // 1: favorite.FavoriteActionRequest
+	(*FavoriteActionResponse)(nil), // 2: favorite.FavoriteActionResponse
+	(*FavoriteListRequest)(nil),    // 3: favorite.FavoriteListRequest
+	(*FavoriteListResponse)(nil),   // 4: favorite.FavoriteListResponse
+	(*video.Video)(nil),            // 5: video.Video
+}
+var file_v1_video_favorite_proto_depIdxs = []int32{
+	0, // 0: favorite.FavoriteActionRequest.action_type:type_name -> favorite.VideoActionType
+	5, // 1: favorite.FavoriteListResponse.video_list:type_name -> video.Video
+	1, // 2: favorite.FavoriteService.FavoriteAction:input_type -> favorite.FavoriteActionRequest
+	3, // 3: favorite.FavoriteService.FavoriteList:input_type -> favorite.FavoriteListRequest
+	2, // 4: favorite.FavoriteService.FavoriteAction:output_type -> favorite.FavoriteActionResponse
+	4, // 5: favorite.FavoriteService.FavoriteList:output_type -> favorite.FavoriteListResponse
+	4, // [4:6] is the sub-list for method output_type
+	2, // [2:4] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_v1_video_favorite_proto_init() }
+func file_v1_video_favorite_proto_init() {
+	if File_v1_video_favorite_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_v1_video_favorite_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FavoriteActionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_video_favorite_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FavoriteActionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_video_favorite_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FavoriteListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_video_favorite_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FavoriteListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_v1_video_favorite_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_v1_video_favorite_proto_goTypes,
+		DependencyIndexes: file_v1_video_favorite_proto_depIdxs,
+		EnumInfos:         file_v1_video_favorite_proto_enumTypes,
+		MessageInfos:      file_v1_video_favorite_proto_msgTypes,
+	}.Build()
+	File_v1_video_favorite_proto = out.File
+	file_v1_video_favorite_proto_rawDesc = nil
+	file_v1_video_favorite_proto_goTypes = nil
+	file_v1_video_favorite_proto_depIdxs = nil
+}
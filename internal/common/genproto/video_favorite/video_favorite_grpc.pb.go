@@ -0,0 +1,141 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v5.29.1
+// source: v1/video_favorite.proto
+
+package video_favorite
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// FavoriteServiceClient is the client API for FavoriteService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FavoriteServiceClient interface {
+	FavoriteAction(ctx context.Context, in *FavoriteActionRequest, opts ...grpc.CallOption) (*FavoriteActionResponse, error)
+	FavoriteList(ctx context.Context, in *FavoriteListRequest, opts ...grpc.CallOption) (*FavoriteListResponse, error)
+}
+
+type favoriteServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFavoriteServiceClient(cc grpc.ClientConnInterface) FavoriteServiceClient {
+	return &favoriteServiceClient{cc}
+}
+
+func (c *favoriteServiceClient) FavoriteAction(ctx context.Context, in *FavoriteActionRequest, opts ...grpc.CallOption) (*FavoriteActionResponse, error) {
+	out := new(FavoriteActionResponse)
+	err := c.cc.Invoke(ctx, "/favorite.FavoriteService/FavoriteAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteServiceClient) FavoriteList(ctx context.Context, in *FavoriteListRequest, opts ...grpc.CallOption) (*FavoriteListResponse, error) {
+	out := new(FavoriteListResponse)
+	err := c.cc.Invoke(ctx, "/favorite.FavoriteService/FavoriteList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FavoriteServiceServer is the server API for FavoriteService service.
+// All implementations must embed UnimplementedFavoriteServiceServer
+// for forward compatibility
+type FavoriteServiceServer interface {
+	FavoriteAction(context.Context, *FavoriteActionRequest) (*FavoriteActionResponse, error)
+	FavoriteList(context.Context, *FavoriteListRequest) (*FavoriteListResponse, error)
+	mustEmbedUnimplementedFavoriteServiceServer()
+}
+
+// UnimplementedFavoriteServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedFavoriteServiceServer struct {
+}
+
+func (UnimplementedFavoriteServiceServer) FavoriteAction(context.Context, *FavoriteActionRequest) (*FavoriteActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FavoriteAction not implemented")
+}
+func (UnimplementedFavoriteServiceServer) FavoriteList(context.Context, *FavoriteListRequest) (*FavoriteListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FavoriteList not implemented")
+}
+func (UnimplementedFavoriteServiceServer) mustEmbedUnimplementedFavoriteServiceServer() {}
+
+// UnsafeFavoriteServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FavoriteServiceServer will
+// result in compilation errors.
+type UnsafeFavoriteServiceServer interface {
+	mustEmbedUnimplementedFavoriteServiceServer()
+}
+
+func RegisterFavoriteServiceServer(s grpc.ServiceRegistrar, srv FavoriteServiceServer) {
+	s.RegisterService(&FavoriteService_ServiceDesc, srv)
+}
+
+func _FavoriteService_FavoriteAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FavoriteActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteServiceServer).FavoriteAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/favorite.FavoriteService/FavoriteAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteServiceServer).FavoriteAction(ctx, req.(*FavoriteActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FavoriteService_FavoriteList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FavoriteListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteServiceServer).FavoriteList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/favorite.FavoriteService/FavoriteList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteServiceServer).FavoriteList(ctx, req.(*FavoriteListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FavoriteService_ServiceDesc is the grpc.ServiceDesc for FavoriteService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FavoriteService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "favorite.FavoriteService",
+	HandlerType: (*FavoriteServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FavoriteAction",
+			Handler:    _FavoriteService_FavoriteAction_Handler,
+		},
+		{
+			MethodName: "FavoriteList",
+			Handler:    _FavoriteService_FavoriteList_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "v1/video_favorite.proto",
+}
@@ -0,0 +1,117 @@
+package keys
+
+import (
+	"context"
+	"crypto/ecdsa"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"newTiktoken/internal/common/config"
+)
+
+// Keys 是服务使用的密钥，Set 为 nil 时没有配置公钥，Signer 为 nil 时服务没有自己的私钥
+type Keys struct {
+	Set    Set
+	Signer Signer
+	close  func()
+}
+
+// Close 撤销服务在 etcd 中的租约，公钥在宽限期后失效
+func (k Keys) Close() {
+	if k.close != nil {
+		k.close()
+	}
+}
+
+// FromConfig 按配置加载服务 service 的密钥：
+// 配置了 keys.etcd_prefix 时发布自己的公钥并监听所有服务的公钥，私钥从 auth.signing_key_file 加载，未配置时生成并定期轮换；
+// 否则从 auth.public_keys_dir 加载公钥，配置了 auth.signing_key_file 时使用该私钥签发
+func FromConfig(ctx context.Context, cfg config.Config, service string) (Keys, error) {
+	var privateKey *ecdsa.PrivateKey
+	if cfg.Auth.SigningKeyFile != "" {
+		var err error
+		if privateKey, err = LoadPrivateKey(cfg.Auth.SigningKeyFile); err != nil {
+			return Keys{}, err
+		}
+	}
+	if cfg.Keys.EtcdPrefix != "" {
+		return fromEtcd(ctx, cfg, service, privateKey)
+	}
+
+	var keys Keys
+	if cfg.Auth.PublicKeysDir != "" {
+		set, err := LoadDir(cfg.Auth.PublicKeysDir)
+		if err != nil {
+			return Keys{}, err
+		}
+		keys.Set = set
+	}
+	if privateKey != nil {
+		keyID, err := KeyID(&privateKey.PublicKey)
+		if err != nil {
+			return Keys{}, err
+		}
+		keys.Signer = staticSigner{privateKey: privateKey, keyID: keyID}
+	}
+	return keys, nil
+}
+
+func fromEtcd(ctx context.Context, cfg config.Config, service string, privateKey *ecdsa.PrivateKey) (Keys, error) {
+	rotate := privateKey == nil && cfg.Keys.RotationInterval > 0
+	if privateKey == nil {
+		var err error
+		if privateKey, err = GenerateKey(); err != nil {
+			return Keys{}, err
+		}
+	}
+
+	client, err := clientv3.New(clientv3.Config{
+		Endpoints:   cfg.Etcd.Endpoints,
+		DialTimeout: cfg.Etcd.DialTimeout,
+	})
+	if err != nil {
+		return Keys{}, errors.Wrap(err, "unable to create etcd client for keys")
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	fail := func(err error) (Keys, error) {
+		cancel()
+		_ = client.Close()
+		return Keys{}, err
+	}
+
+	loadCtx, cancelLoad := context.WithTimeout(ctx, cfg.Etcd.DialTimeout)
+	defer cancelLoad()
+	set := newEtcdSet(client, cfg.Keys.EtcdPrefix)
+	revision, err := set.load(loadCtx)
+	if err != nil {
+		return fail(err)
+	}
+	go set.watch(ctx, revision)
+	publisher, err := Publish(ctx, client, cfg.Keys.EtcdPrefix, service, privateKey, cfg.Keys.LeaseTTL, cfg.Keys.GracePeriod)
+	if err != nil {
+		return fail(err)
+	}
+	if rotate {
+		go publisher.RunRotation(ctx, cfg.Keys.RotationInterval)
+	}
+
+	return Keys{Set: set, Signer: publisher, close: func() {
+		closeCtx, cancelClose := context.WithTimeout(context.Background(), cfg.Etcd.DialTimeout)
+		defer cancelClose()
+		if err := publisher.Close(closeCtx); err != nil {
+			logrus.WithError(err).WithField("service", service).Warn("Unable to unpublish public key")
+		}
+		cancel()
+		_ = client.Close()
+	}}, nil
+}
+
+type staticSigner struct {
+	privateKey *ecdsa.PrivateKey
+	keyID      string
+}
+
+func (s staticSigner) SigningKey() (*ecdsa.PrivateKey, string) {
+	return s.privateKey, s.keyID
+}
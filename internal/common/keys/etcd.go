@@ -0,0 +1,189 @@
+package keys
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// etcd 中的公钥：
+//
+//	<prefix>/<service>/current/<instance> 是实例正在使用的公钥，绑定实例的租约，实例下线后自动删除
+//	<prefix>/<service>/retired/<kid>      是轮换或下线前使用的公钥，绑定有效期为宽限期的租约
+const (
+	currentKeys = "current"
+	retiredKeys = "retired"
+)
+
+// watchRetryInterval 是 watch 失败后重新加载的间隔
+const watchRetryInterval = time.Second
+
+func currentKeyPath(prefix, service, instance string) string {
+	return strings.TrimSuffix(prefix, "/") + "/" + service + "/" + currentKeys + "/" + instance
+}
+
+func retiredKeyPath(prefix, service, keyID string) string {
+	return strings.TrimSuffix(prefix, "/") + "/" + service + "/" + retiredKeys + "/" + keyID
+}
+
+// EtcdSet 监听 etcd 中所有服务的公钥，在内存中保存最新的公钥
+type EtcdSet struct {
+	client *clientv3.Client
+	prefix string
+
+	mu sync.RWMutex
+	// entries 的 key 为 etcd 中的 key
+	entries  map[string]etcdEntry
+	services map[string]serviceKeys
+}
+
+type etcdEntry struct {
+	service   string
+	current   bool
+	keyID     string
+	publicKey *ecdsa.PublicKey
+}
+
+type serviceKeys struct {
+	publicKeys map[string]*ecdsa.PublicKey
+	online     bool
+}
+
+// WatchEtcd 加载 prefix 下的公钥，并在 ctx 结束前持续监听修改
+func WatchEtcd(ctx context.Context, client *clientv3.Client, prefix string) (*EtcdSet, error) {
+	s := newEtcdSet(client, prefix)
+	revision, err := s.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	go s.watch(ctx, revision)
+	return s, nil
+}
+
+func newEtcdSet(client *clientv3.Client, prefix string) *EtcdSet {
+	return &EtcdSet{client: client, prefix: strings.TrimSuffix(prefix, "/") + "/"}
+}
+
+func (s *EtcdSet) PublicKeys(service string) map[string]*ecdsa.PublicKey {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.services[service].publicKeys
+}
+
+func (s *EtcdSet) Online(service string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.services[service].online
+}
+
+// load 读取 prefix 下的所有公钥，返回读取时的 revision 供后续监听使用
+func (s *EtcdSet) load(ctx context.Context) (int64, error) {
+	resp, err := s.client.Get(ctx, s.prefix, clientv3.WithPrefix())
+	if err != nil {
+		return 0, errors.Wrapf(err, "unable to load public keys from etcd prefix %s", s.prefix)
+	}
+	entries := make(map[string]etcdEntry, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		entry, err := s.parseEntry(string(kv.Key), kv.Value)
+		if err != nil {
+			logrus.WithError(err).WithField("key", string(kv.Key)).Warn("Ignoring invalid public key in etcd")
+			continue
+		}
+		entries[string(kv.Key)] = entry
+	}
+
+	s.mu.Lock()
+	s.entries = entries
+	s.rebuild()
+	s.mu.Unlock()
+	return resp.Header.Revision, nil
+}
+
+// watch 在 watch 中断（如 revision 已被压缩）后重新加载全部公钥再继续监听
+func (s *EtcdSet) watch(ctx context.Context, revision int64) {
+	for ctx.Err() == nil {
+		watchCtx, cancel := context.WithCancel(ctx)
+		for resp := range s.client.Watch(watchCtx, s.prefix, clientv3.WithPrefix(), clientv3.WithRev(revision+1)) {
+			if err := resp.Err(); err != nil {
+				logrus.WithError(err).Warn("Public keys watch error")
+				break
+			}
+			s.apply(resp.Events)
+			revision = resp.Header.Revision
+		}
+		cancel()
+
+		for ctx.Err() == nil {
+			var err error
+			if revision, err = s.load(ctx); err == nil {
+				break
+			}
+			logrus.WithError(err).Warn("Unable to reload public keys")
+			select {
+			case <-ctx.Done():
+			case <-time.After(watchRetryInterval):
+			}
+		}
+	}
+}
+
+func (s *EtcdSet) apply(events []*clientv3.Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, event := range events {
+		key := string(event.Kv.Key)
+		if event.Type == clientv3.EventTypeDelete {
+			delete(s.entries, key)
+			continue
+		}
+		entry, err := s.parseEntry(key, event.Kv.Value)
+		if err != nil {
+			logrus.WithError(err).WithField("key", key).Warn("Ignoring invalid public key in etcd")
+			continue
+		}
+		s.entries[key] = entry
+	}
+	s.rebuild()
+}
+
+// rebuild 重新生成各服务的公钥，已返回给调用方的 map 不会被修改
+func (s *EtcdSet) rebuild() {
+	services := map[string]serviceKeys{}
+	for _, entry := range s.entries {
+		keys, ok := services[entry.service]
+		if !ok {
+			keys.publicKeys = map[string]*ecdsa.PublicKey{}
+		}
+		keys.publicKeys[entry.keyID] = entry.publicKey
+		keys.online = keys.online || entry.current
+		services[entry.service] = keys
+	}
+	s.services = services
+}
+
+func (s *EtcdSet) parseEntry(key string, value []byte) (etcdEntry, error) {
+	parts := strings.Split(strings.TrimPrefix(key, s.prefix), "/")
+	if len(parts) != 3 || parts[0] == "" || (parts[1] != currentKeys && parts[1] != retiredKeys) {
+		return etcdEntry{}, errors.Errorf("unexpected key %s", key)
+	}
+	publicKeys, err := ParsePublicKeys(value)
+	if err != nil {
+		return etcdEntry{}, err
+	}
+	if len(publicKeys) != 1 {
+		return etcdEntry{}, errors.Errorf("expected one public key, got %d", len(publicKeys))
+	}
+
+	entry := etcdEntry{service: parts[0], current: parts[1] == currentKeys}
+	for keyID, publicKey := range publicKeys {
+		entry.keyID, entry.publicKey = keyID, publicKey
+	}
+	return entry, nil
+}
@@ -0,0 +1,287 @@
+package keys_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"net/url"
+	"testing"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/server/v3/embed"
+	"newTiktoken/internal/common/config"
+	"newTiktoken/internal/common/keys"
+)
+
+const (
+	testPrefix   = "/keys"
+	testService  = "user-service"
+	testLeaseTTL = time.Second
+)
+
+func TestEtcdSetLoadsAndWatchesPublishedKeys(t *testing.T) {
+	t.Parallel()
+
+	client := newEmbeddedEtcd(t)
+	ctx := testContext(t)
+
+	// 先发布的公钥在加载时读取，之后发布的公钥通过 watch 获取
+	first := publish(t, ctx, client, testService, newKey(t), time.Minute)
+	set, err := keys.WatchEtcd(ctx, client, testPrefix)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second := publish(t, ctx, client, "video-service", newKey(t), time.Minute)
+
+	waitFor(t, "published keys", func() bool {
+		return hasKey(set, testService, first) && hasKey(set, "video-service", second) &&
+			set.Online(testService) && set.Online("video-service")
+	})
+	if set.Online("video-comment-service") || len(set.PublicKeys("video-comment-service")) != 0 {
+		t.Error("expected a service without keys to be offline")
+	}
+}
+
+func TestPublisherRotateKeepsOldKeyForGracePeriod(t *testing.T) {
+	t.Parallel()
+
+	client := newEmbeddedEtcd(t)
+	ctx := testContext(t)
+	set, err := keys.WatchEtcd(ctx, client, testPrefix)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	publisher := publish(t, ctx, client, testService, newKey(t), 2*time.Second)
+	_, oldKeyID := publisher.SigningKey()
+	newPrivateKey := newKey(t)
+	if err := publisher.Rotate(ctx, newPrivateKey); err != nil {
+		t.Fatal(err)
+	}
+	privateKey, newKeyID := publisher.SigningKey()
+	if !privateKey.Equal(newPrivateKey) || newKeyID == oldKeyID {
+		t.Fatal("expected the publisher to sign with the new key")
+	}
+
+	waitFor(t, "both keys during the grace period", func() bool {
+		publicKeys := set.PublicKeys(testService)
+		return publicKeys[oldKeyID] != nil && publicKeys[newKeyID] != nil
+	})
+	waitFor(t, "old key to expire", func() bool {
+		publicKeys := set.PublicKeys(testService)
+		return publicKeys[oldKeyID] == nil && publicKeys[newKeyID] != nil
+	})
+	if !set.Online(testService) {
+		t.Error("expected the service to stay online")
+	}
+}
+
+func TestPublisherClose(t *testing.T) {
+	t.Parallel()
+
+	client := newEmbeddedEtcd(t)
+	ctx := testContext(t)
+	set, err := keys.WatchEtcd(ctx, client, testPrefix)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 两个实例使用同一私钥，一个实例下线后服务仍然在线
+	privateKey := newKey(t)
+	closed := publish(t, ctx, client, testService, privateKey, 2*time.Second)
+	running := publish(t, ctx, client, testService, privateKey, 2*time.Second)
+	_, keyID := running.SigningKey()
+	waitFor(t, "published key", func() bool { return set.Online(testService) })
+
+	if err := closed.Close(ctx); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(100 * time.Millisecond)
+	if !set.Online(testService) {
+		t.Fatal("expected the service to stay online while an instance is running")
+	}
+
+	if err := running.Close(ctx); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, "service to go offline", func() bool { return !set.Online(testService) })
+	if set.PublicKeys(testService)[keyID] == nil {
+		t.Error("expected the key to stay valid during the grace period")
+	}
+	waitFor(t, "retired key to expire", func() bool { return len(set.PublicKeys(testService)) == 0 })
+}
+
+func TestPublisherKeyExpiresWithLease(t *testing.T) {
+	t.Parallel()
+
+	client := newEmbeddedEtcd(t)
+	ctx := testContext(t)
+	set, err := keys.WatchEtcd(ctx, client, testPrefix)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 实例停止续约（如进程崩溃）后公钥在租约到期时被删除
+	publishCtx, stopPublishing := context.WithCancel(ctx)
+	publish(t, publishCtx, client, testService, newKey(t), time.Minute)
+	waitFor(t, "published key", func() bool { return set.Online(testService) })
+
+	stopPublishing()
+	waitFor(t, "key to expire", func() bool { return len(set.PublicKeys(testService)) == 0 })
+	if set.Online(testService) {
+		t.Error("expected the service to be offline")
+	}
+}
+
+func TestPublisherRepublishesAfterLeaseIsRevoked(t *testing.T) {
+	t.Parallel()
+
+	client := newEmbeddedEtcd(t)
+	ctx := testContext(t)
+	set, err := keys.WatchEtcd(ctx, client, testPrefix)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	publisher := publish(t, ctx, client, testService, newKey(t), time.Minute)
+	waitFor(t, "published key", func() bool { return hasKey(set, testService, publisher) })
+
+	// 撤销租约后公钥被立即删除，续约中断，Publisher 应使用新的租约重新发布同一公钥
+	revoked := currentKeyLease(t, ctx, client)
+	if _, err := client.Revoke(ctx, revoked); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, "key to be republished with a new lease", func() bool {
+		resp, err := client.Get(ctx, testPrefix+"/"+testService+"/current/", clientv3.WithPrefix())
+		return err == nil && len(resp.Kvs) == 1 && clientv3.LeaseID(resp.Kvs[0].Lease) != revoked
+	})
+	waitFor(t, "service to be online again", func() bool {
+		return set.Online(testService) && hasKey(set, testService, publisher)
+	})
+
+	if err := publisher.Close(ctx); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, "service to go offline", func() bool { return !set.Online(testService) })
+}
+
+func TestFromConfigWithEtcd(t *testing.T) {
+	t.Parallel()
+
+	client := newEmbeddedEtcd(t)
+	ctx := testContext(t)
+	cfg := config.Default()
+	cfg.Etcd.Endpoints = client.Endpoints()
+	cfg.Keys.EtcdPrefix = testPrefix
+	cfg.Keys.LeaseTTL = testLeaseTTL
+
+	serviceKeys, err := keys.FromConfig(ctx, cfg, "video-service")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 没有配置私钥时生成私钥，并通过自己的 Set 看到已发布的公钥
+	privateKey, keyID := serviceKeys.Signer.SigningKey()
+	waitFor(t, "generated key", func() bool {
+		publicKey := serviceKeys.Set.PublicKeys("video-service")[keyID]
+		return publicKey != nil && publicKey.Equal(&privateKey.PublicKey) && serviceKeys.Set.Online("video-service")
+	})
+
+	set, err := keys.WatchEtcd(ctx, client, testPrefix)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serviceKeys.Close()
+	waitFor(t, "service to go offline", func() bool { return !set.Online("video-service") })
+}
+
+func newEmbeddedEtcd(t *testing.T) *clientv3.Client {
+	t.Helper()
+
+	cfg := embed.NewConfig()
+	cfg.Dir = t.TempDir()
+	cfg.LogLevel = "panic"
+	localhost, err := url.Parse("http://127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.ListenClientUrls = []url.URL{*localhost}
+	cfg.ListenPeerUrls = []url.URL{*localhost}
+
+	etcd, err := embed.StartEtcd(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(etcd.Close)
+	select {
+	case <-etcd.Server.ReadyNotify():
+	case <-time.After(10 * time.Second):
+		t.Fatal("embedded etcd didn't start")
+	}
+
+	client, err := clientv3.New(clientv3.Config{
+		Endpoints:   []string{etcd.Clients[0].Addr().String()},
+		DialTimeout: 5 * time.Second,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+	return client
+}
+
+func testContext(t *testing.T) context.Context {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	t.Cleanup(cancel)
+	return ctx
+}
+
+func publish(
+	t *testing.T,
+	ctx context.Context,
+	client *clientv3.Client,
+	service string,
+	privateKey *ecdsa.PrivateKey,
+	gracePeriod time.Duration,
+) *keys.Publisher {
+	t.Helper()
+
+	publisher, err := keys.Publish(ctx, client, testPrefix, service, privateKey, testLeaseTTL, gracePeriod)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return publisher
+}
+
+// currentKeyLease 返回 testService 唯一的 current 公钥绑定的租约
+func currentKeyLease(t *testing.T, ctx context.Context, client *clientv3.Client) clientv3.LeaseID {
+	t.Helper()
+
+	resp, err := client.Get(ctx, testPrefix+"/"+testService+"/current/", clientv3.WithPrefix())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Kvs) != 1 {
+		t.Fatalf("expected one current key, got %d", len(resp.Kvs))
+	}
+	return clientv3.LeaseID(resp.Kvs[0].Lease)
+}
+
+func hasKey(set keys.Set, service string, publisher *keys.Publisher) bool {
+	privateKey, keyID := publisher.SigningKey()
+	publicKey := set.PublicKeys(service)[keyID]
+	return publicKey != nil && publicKey.Equal(&privateKey.PublicKey)
+}
+
+func waitFor(t *testing.T, description string, condition func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(10 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", description)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+}
@@ -0,0 +1,108 @@
+package keys
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+// Set 保存各服务签发 token 使用的公钥，服务只有在登记了公钥后才被认为已上线
+type Set interface {
+	// PublicKeys 返回服务当前有效的公钥（包括宽限期内的旧公钥），key 为 KeyID，
+	// 服务没有登记公钥时返回空 map，调用方不能修改返回的 map
+	PublicKeys(service string) map[string]*ecdsa.PublicKey
+	// Online 返回服务是否有正在使用的公钥，只剩宽限期内的旧公钥时服务已下线
+	Online(service string) bool
+}
+
+// Signer 是服务签发 token 使用的私钥，轮换后返回新的私钥
+type Signer interface {
+	SigningKey() (privateKey *ecdsa.PrivateKey, keyID string)
+}
+
+// KeyID 是公钥 DER 编码的 SHA-256，放在 token 的 kid 中，用于在轮换期间选择公钥
+func KeyID(publicKey *ecdsa.PublicKey) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to marshal public key")
+	}
+	sum := sha256.Sum256(der)
+	return base64.RawURLEncoding.EncodeToString(sum[:]), nil
+}
+
+// LoadPrivateKey 读取 PEM 格式的 P-256 私钥，支持 SEC 1（EC PRIVATE KEY）和 PKCS #8（PRIVATE KEY）
+func LoadPrivateKey(path string) (*ecdsa.PrivateKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read private key %s", path)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.Errorf("no PEM block in %s", path)
+	}
+
+	var key any
+	switch block.Type {
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, errors.Errorf("unexpected PEM block %q in %s", block.Type, path)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to parse private key %s", path)
+	}
+	privateKey, ok := key.(*ecdsa.PrivateKey)
+	if !ok || privateKey.Curve != elliptic.P256() {
+		return nil, errors.Errorf("%s is not a P-256 private key", path)
+	}
+	return privateKey, nil
+}
+
+// ParsePublicKeys 解析 PEM 中所有的 PUBLIC KEY，轮换期间一个服务可以同时有多个公钥
+func ParsePublicKeys(data []byte) (map[string]*ecdsa.PublicKey, error) {
+	publicKeys := map[string]*ecdsa.PublicKey{}
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "PUBLIC KEY" {
+			return nil, errors.Errorf("unexpected PEM block %q", block.Type)
+		}
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to parse public key")
+		}
+		publicKey, ok := key.(*ecdsa.PublicKey)
+		if !ok || publicKey.Curve != elliptic.P256() {
+			return nil, errors.New("public key is not a P-256 key")
+		}
+		keyID, err := KeyID(publicKey)
+		if err != nil {
+			return nil, err
+		}
+		publicKeys[keyID] = publicKey
+	}
+	if len(publicKeys) == 0 {
+		return nil, errors.New("no public key found")
+	}
+	return publicKeys, nil
+}
+
+// MarshalPublicKey 把公钥编码为 PEM，与 ParsePublicKeys 对应
+func MarshalPublicKey(publicKey *ecdsa.PublicKey) ([]byte, error) {
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to marshal public key")
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), nil
+}
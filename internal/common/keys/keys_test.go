@@ -0,0 +1,126 @@
+package keys_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"newTiktoken/internal/common/keys"
+)
+
+func TestLoadDir(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	current, previous := newKey(t), newKey(t)
+	writeFile(t, filepath.Join(dir, "user-service.pem"), marshalPublicKey(t, current), marshalPublicKey(t, previous))
+	writeFile(t, filepath.Join(dir, "README"), []byte("not a key"))
+
+	set, err := keys.LoadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKeys := set.PublicKeys("user-service")
+	if len(publicKeys) != 2 {
+		t.Fatalf("expected 2 public keys, got %d", len(publicKeys))
+	}
+	for _, privateKey := range []*ecdsa.PrivateKey{current, previous} {
+		keyID, err := keys.KeyID(&privateKey.PublicKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if publicKey, ok := publicKeys[keyID]; !ok || !publicKey.Equal(&privateKey.PublicKey) {
+			t.Errorf("public key %s not loaded", keyID)
+		}
+	}
+	if got := set.PublicKeys("video-service"); len(got) != 0 {
+		t.Errorf("expected no public keys, got %d", len(got))
+	}
+}
+
+func TestLoadDirRejectsInvalidKeys(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "user-service.pem"), []byte("not a key"))
+
+	if _, err := keys.LoadDir(dir); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestLoadPrivateKey(t *testing.T) {
+	t.Parallel()
+
+	privateKey := newKey(t)
+	sec1, err := x509.MarshalECPrivateKey(privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		Name  string
+		Block *pem.Block
+	}{
+		{Name: "sec1", Block: &pem.Block{Type: "EC PRIVATE KEY", Bytes: sec1}},
+		{Name: "pkcs8", Block: &pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8}},
+	}
+	for _, c := range testCases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), "key.pem")
+			writeFile(t, path, pem.EncodeToMemory(c.Block))
+
+			got, err := keys.LoadPrivateKey(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got.Equal(privateKey) {
+				t.Error("loaded a different private key")
+			}
+		})
+	}
+}
+
+func newKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return privateKey
+}
+
+func marshalPublicKey(t *testing.T, privateKey *ecdsa.PrivateKey) []byte {
+	t.Helper()
+
+	data, err := keys.MarshalPublicKey(&privateKey.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func writeFile(t *testing.T, path string, parts ...[]byte) {
+	t.Helper()
+
+	var data []byte
+	for _, part := range parts {
+		data = append(data, part...)
+	}
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,273 @@
+package keys
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// 租约失效后重新发布公钥失败时的重试间隔，每次失败翻倍
+const (
+	minRepublishBackoff = 100 * time.Millisecond
+	maxRepublishBackoff = 10 * time.Second
+)
+
+// Publisher 把服务实例正在使用的公钥发布到 etcd，绑定实例的租约，实例停止续约后公钥被自动删除
+// 租约在实例运行时失效（如 etcd 长时间不可用或租约被撤销）时申请新的租约并重新发布公钥
+// 轮换和关闭时旧公钥在宽限期内仍然有效，使用旧私钥签发的 token 在宽限期内仍能通过校验
+type Publisher struct {
+	client      *clientv3.Client
+	prefix      string
+	service     string
+	leaseTTL    time.Duration
+	gracePeriod time.Duration
+
+	// publishMu 保护 leaseID，并使轮换与重新发布不会交错写入 current 公钥
+	publishMu sync.Mutex
+	leaseID   clientv3.LeaseID
+	// stopKeepAlive 停止续约，Close 后不再续约；keepAliveDone 在续约协程退出后关闭
+	stopKeepAlive context.CancelFunc
+	keepAliveDone chan struct{}
+
+	mu         sync.RWMutex
+	privateKey *ecdsa.PrivateKey
+	keyID      string
+}
+
+// Publish 申请有效期为 leaseTTL 的租约并发布 privateKey 的公钥，在 ctx 结束或 Close 前持续续约
+func Publish(
+	ctx context.Context,
+	client *clientv3.Client,
+	prefix string,
+	service string,
+	privateKey *ecdsa.PrivateKey,
+	leaseTTL time.Duration,
+	gracePeriod time.Duration,
+) (*Publisher, error) {
+	if client == nil {
+		return nil, errors.New("nil etcd client")
+	}
+	if service == "" {
+		return nil, errors.New("empty service")
+	}
+	keyID, err := KeyID(&privateKey.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	lease, err := client.Grant(ctx, ttlSeconds(leaseTTL))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to grant lease for public key")
+	}
+	p := &Publisher{
+		client:        client,
+		prefix:        prefix,
+		service:       service,
+		leaseTTL:      leaseTTL,
+		gracePeriod:   gracePeriod,
+		leaseID:       lease.ID,
+		keepAliveDone: make(chan struct{}),
+		privateKey:    privateKey,
+		keyID:         keyID,
+	}
+	if err := p.putCurrent(ctx, privateKey); err != nil {
+		return nil, err
+	}
+
+	keepAliveCtx, stopKeepAlive := context.WithCancel(ctx)
+	keepAlive, err := client.KeepAlive(keepAliveCtx, lease.ID)
+	if err != nil {
+		stopKeepAlive()
+		return nil, errors.Wrap(err, "unable to keep public key lease alive")
+	}
+	p.stopKeepAlive = stopKeepAlive
+	go func() {
+		defer close(p.keepAliveDone)
+		p.keepAlive(keepAliveCtx, keepAlive)
+	}()
+
+	return p, nil
+}
+
+// keepAlive 消费续约响应直到 ctx 结束，续约中断说明租约已失效，此时公钥已被删除，服务被视为下线
+// 失效后申请新的租约重新发布公钥，失败时按指数退避重试
+func (p *Publisher) keepAlive(ctx context.Context, keepAlive <-chan *clientv3.LeaseKeepAliveResponse) {
+	for {
+		for range keepAlive {
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		logrus.WithField("service", p.service).Error("Public key lease expired, republishing the public key")
+
+		backoff := minRepublishBackoff
+		for {
+			var err error
+			keepAlive, err = p.republish(ctx)
+			if err == nil {
+				break
+			}
+			if ctx.Err() != nil {
+				return
+			}
+			logrus.WithError(err).WithField("service", p.service).Error("Unable to republish public key")
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(backoff):
+			}
+			backoff = min(2*backoff, maxRepublishBackoff)
+		}
+		logrus.WithField("service", p.service).Info("Republished public key with a new lease")
+	}
+}
+
+// republish 申请新的租约并用它发布当前公钥，返回新租约的续约响应
+// 失败时新租约不再续约，会在 leaseTTL 后自动过期
+func (p *Publisher) republish(ctx context.Context) (<-chan *clientv3.LeaseKeepAliveResponse, error) {
+	p.publishMu.Lock()
+	defer p.publishMu.Unlock()
+
+	lease, err := p.client.Grant(ctx, ttlSeconds(p.leaseTTL))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to grant lease for public key")
+	}
+	p.leaseID = lease.ID
+	privateKey, _ := p.SigningKey()
+	if err := p.putCurrent(ctx, privateKey); err != nil {
+		return nil, err
+	}
+	keepAlive, err := p.client.KeepAlive(ctx, lease.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to keep public key lease alive")
+	}
+	return keepAlive, nil
+}
+
+// SigningKey 返回公钥已发布的私钥
+func (p *Publisher) SigningKey() (*ecdsa.PrivateKey, string) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.privateKey, p.keyID
+}
+
+// Rotate 发布新的公钥并开始使用 privateKey 签发，旧公钥在宽限期内仍然有效
+func (p *Publisher) Rotate(ctx context.Context, privateKey *ecdsa.PrivateKey) error {
+	keyID, err := KeyID(&privateKey.PublicKey)
+	if err != nil {
+		return err
+	}
+	p.publishMu.Lock()
+	defer p.publishMu.Unlock()
+	if err := p.retire(ctx); err != nil {
+		return err
+	}
+	if err := p.putCurrent(ctx, privateKey); err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	p.privateKey, p.keyID = privateKey, keyID
+	p.mu.Unlock()
+	logrus.WithFields(logrus.Fields{"service": p.service, "kid": keyID}).Info("Rotated signing key")
+	return nil
+}
+
+// RunRotation 每隔 interval 生成新的私钥并轮换，直到 ctx 结束；轮换失败时继续使用当前私钥
+func (p *Publisher) RunRotation(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		privateKey, err := GenerateKey()
+		if err == nil {
+			err = p.Rotate(ctx, privateKey)
+		}
+		if err != nil {
+			logrus.WithError(err).WithField("service", p.service).Error("Unable to rotate signing key")
+		}
+	}
+}
+
+// Close 把当前公钥保留宽限期后撤销租约，实例不再被认为在线
+func (p *Publisher) Close(ctx context.Context) error {
+	p.stopKeepAlive()
+	<-p.keepAliveDone
+	p.publishMu.Lock()
+	defer p.publishMu.Unlock()
+	retireErr := p.retire(ctx)
+	if _, err := p.client.Revoke(ctx, p.leaseID); err != nil {
+		return errors.Wrap(err, "unable to revoke public key lease")
+	}
+	return retireErr
+}
+
+func (p *Publisher) putCurrent(ctx context.Context, privateKey *ecdsa.PrivateKey) error {
+	data, err := MarshalPublicKey(&privateKey.PublicKey)
+	if err != nil {
+		return err
+	}
+	key := currentKeyPath(p.prefix, p.service, p.instance())
+	if _, err := p.client.Put(ctx, key, string(data), clientv3.WithLease(p.leaseID)); err != nil {
+		return errors.Wrapf(err, "unable to publish public key %s", key)
+	}
+	return nil
+}
+
+// retire 把当前公钥写入绑定宽限期租约的 retired key
+func (p *Publisher) retire(ctx context.Context) error {
+	if p.gracePeriod <= 0 {
+		return nil
+	}
+	privateKey, keyID := p.SigningKey()
+	data, err := MarshalPublicKey(&privateKey.PublicKey)
+	if err != nil {
+		return err
+	}
+	lease, err := p.client.Grant(ctx, ttlSeconds(p.gracePeriod))
+	if err != nil {
+		return errors.Wrap(err, "unable to grant lease for retired public key")
+	}
+	key := retiredKeyPath(p.prefix, p.service, keyID)
+	if _, err := p.client.Put(ctx, key, string(data), clientv3.WithLease(lease.ID)); err != nil {
+		return errors.Wrapf(err, "unable to publish retired public key %s", key)
+	}
+	return nil
+}
+
+// instance 使用租约 ID 区分同一服务的多个实例，调用方需要持有 publishMu（Publish 中除外）
+func (p *Publisher) instance() string {
+	return strconv.FormatInt(int64(p.leaseID), 16)
+}
+
+// GenerateKey 生成 P-256 私钥
+func GenerateKey() (*ecdsa.PrivateKey, error) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to generate private key")
+	}
+	return privateKey, nil
+}
+
+// ttlSeconds 向上取整，etcd 租约的最小单位为秒
+func ttlSeconds(ttl time.Duration) int64 {
+	seconds := int64((ttl + time.Second - 1) / time.Second)
+	if seconds < 1 {
+		return 1
+	}
+	return seconds
+}
@@ -0,0 +1,42 @@
+package keys
+
+import (
+	"crypto/ecdsa"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// StaticSet 是启动时加载、之后不再变化的公钥
+type StaticSet map[string]map[string]*ecdsa.PublicKey
+
+// LoadDir 读取 dir 中的 <service>.pem，每个文件可以包含多个公钥
+func LoadDir(dir string) (StaticSet, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to list public keys in %s", dir)
+	}
+	set := StaticSet{}
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to read %s", path)
+		}
+		publicKeys, err := ParsePublicKeys(data)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid public keys in %s", path)
+		}
+		set[strings.TrimSuffix(filepath.Base(path), ".pem")] = publicKeys
+	}
+	return set, nil
+}
+
+func (s StaticSet) PublicKeys(service string) map[string]*ecdsa.PublicKey {
+	return s[service]
+}
+
+func (s StaticSet) Online(service string) bool {
+	return len(s[service]) > 0
+}
@@ -1,6 +1,7 @@
 package logs
 
 import (
+	"newTiktoken/internal/common/config"
 	"os"
 	"strconv"
 
@@ -29,3 +30,11 @@ func SetFormatter(logger *logrus.Logger) {
 		})
 	}
 }
+
+// WatchLevel 使用配置中的日志级别，并在配置热更新时跟着修改
+func WatchLevel(store *config.Store) {
+	logrus.SetLevel(store.Get().Log.LogrusLevel())
+	store.Subscribe(func(cfg config.Config) {
+		logrus.SetLevel(cfg.Log.LogrusLevel())
+	})
+}
@@ -0,0 +1,26 @@
+package logs
+
+import (
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TraceHook 为通过 WithContext 带上 span 的日志添加 trace_id 和 span_id
+type TraceHook struct{}
+
+func (TraceHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (TraceHook) Fire(entry *logrus.Entry) error {
+	if entry.Context == nil {
+		return nil
+	}
+	spanContext := trace.SpanContextFromContext(entry.Context)
+	if !spanContext.IsValid() {
+		return nil
+	}
+	entry.Data["trace_id"] = spanContext.TraceID().String()
+	entry.Data["span_id"] = spanContext.SpanID().String()
+	return nil
+}
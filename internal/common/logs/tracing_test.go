@@ -0,0 +1,51 @@
+package logs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestTraceHookAddsTraceID(t *testing.T) {
+	t.Parallel()
+	buf := &bytes.Buffer{}
+	logger := logrus.New()
+	logger.SetOutput(buf)
+	logger.SetFormatter(&logrus.JSONFormatter{})
+	logger.AddHook(TraceHook{})
+
+	ctx, span := sdktrace.NewTracerProvider().Tracer("test").Start(context.Background(), "test")
+	defer span.End()
+	logger.WithContext(ctx).Info("with span")
+
+	var fields map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &fields); err != nil {
+		t.Fatal(err)
+	}
+	if fields["trace_id"] != span.SpanContext().TraceID().String() {
+		t.Errorf("expected trace_id %s, got %v", span.SpanContext().TraceID(), fields["trace_id"])
+	}
+}
+
+func TestTraceHookIgnoresEntriesWithoutSpan(t *testing.T) {
+	t.Parallel()
+	buf := &bytes.Buffer{}
+	logger := logrus.New()
+	logger.SetOutput(buf)
+	logger.SetFormatter(&logrus.JSONFormatter{})
+	logger.AddHook(TraceHook{})
+
+	logger.WithContext(context.Background()).Info("without span")
+
+	var fields map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &fields); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := fields["trace_id"]; ok {
+		t.Errorf("unexpected trace_id %v", fields["trace_id"])
+	}
+}
@@ -1,7 +1,14 @@
 package metrics
 
+import "time"
+
 type NoOp struct{}
 
-func (d NoOp) Inc(_ string, _ int) {
-	// todo - add some implementation!
+func (d NoOp) Inc(_ string, _ map[string]string, _ int) {
+}
+
+func (d NoOp) ObserveDuration(_ string, _ map[string]string, _ time.Duration) {
+}
+
+func (d NoOp) SetGauge(_ string, _ map[string]string, _ float64) {
 }
@@ -0,0 +1,177 @@
+package metrics
+
+import (
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/sirupsen/logrus"
+)
+
+// PrometheusMetrics 按指标名懒加载 CounterVec、HistogramVec 和 GaugeVec
+// 指标第一次被使用时以 labels 的键集合作为标签名注册，之后同名指标必须使用相同的键集合
+type PrometheusMetrics struct {
+	namespace  string
+	registerer prometheus.Registerer
+
+	lock       sync.Mutex
+	counters   map[string]*prometheus.CounterVec
+	histograms map[string]*prometheus.HistogramVec
+	gauges     map[string]*prometheus.GaugeVec
+}
+
+func NewPrometheusMetrics(namespace string, registerer prometheus.Registerer) *PrometheusMetrics {
+	if registerer == nil {
+		panic("nil registerer")
+	}
+	return &PrometheusMetrics{
+		namespace:  sanitizeName(namespace),
+		registerer: registerer,
+		counters:   map[string]*prometheus.CounterVec{},
+		histograms: map[string]*prometheus.HistogramVec{},
+		gauges:     map[string]*prometheus.GaugeVec{},
+	}
+}
+
+func (p *PrometheusMetrics) Inc(name string, labels map[string]string, value int) {
+	counter, err := p.counter(name, labels)
+	if err != nil {
+		logrus.WithError(err).WithField("metric", name).Warn("Unable to register counter")
+		return
+	}
+	c, err := counter.GetMetricWith(labels)
+	if err != nil {
+		logrus.WithError(err).WithField("metric", name).Warn("Invalid counter labels")
+		return
+	}
+	c.Add(float64(value))
+}
+
+func (p *PrometheusMetrics) ObserveDuration(name string, labels map[string]string, duration time.Duration) {
+	histogram, err := p.histogram(name, labels)
+	if err != nil {
+		logrus.WithError(err).WithField("metric", name).Warn("Unable to register histogram")
+		return
+	}
+	h, err := histogram.GetMetricWith(labels)
+	if err != nil {
+		logrus.WithError(err).WithField("metric", name).Warn("Invalid histogram labels")
+		return
+	}
+	h.Observe(duration.Seconds())
+}
+
+func (p *PrometheusMetrics) SetGauge(name string, labels map[string]string, value float64) {
+	gauge, err := p.gauge(name, labels)
+	if err != nil {
+		logrus.WithError(err).WithField("metric", name).Warn("Unable to register gauge")
+		return
+	}
+	g, err := gauge.GetMetricWith(labels)
+	if err != nil {
+		logrus.WithError(err).WithField("metric", name).Warn("Invalid gauge labels")
+		return
+	}
+	g.Set(value)
+}
+
+func (p *PrometheusMetrics) counter(name string, labels map[string]string) (*prometheus.CounterVec, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if counter, ok := p.counters[name]; ok {
+		return counter, nil
+	}
+	counter := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: p.namespace,
+		Name:      sanitizeName(name),
+		Help:      name,
+	}, labelNames(labels))
+	if err := p.registerer.Register(counter); err != nil {
+		return nil, err
+	}
+	p.counters[name] = counter
+	return counter, nil
+}
+
+func (p *PrometheusMetrics) histogram(name string, labels map[string]string) (*prometheus.HistogramVec, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if histogram, ok := p.histograms[name]; ok {
+		return histogram, nil
+	}
+	histogram := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: p.namespace,
+		Name:      sanitizeName(name),
+		Help:      name,
+		Buckets:   prometheus.DefBuckets,
+	}, labelNames(labels))
+	if err := p.registerer.Register(histogram); err != nil {
+		return nil, err
+	}
+	p.histograms[name] = histogram
+	return histogram, nil
+}
+
+func (p *PrometheusMetrics) gauge(name string, labels map[string]string) (*prometheus.GaugeVec, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if gauge, ok := p.gauges[name]; ok {
+		return gauge, nil
+	}
+	gauge := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: p.namespace,
+		Name:      sanitizeName(name),
+		Help:      name,
+	}, labelNames(labels))
+	if err := p.registerer.Register(gauge); err != nil {
+		return nil, err
+	}
+	p.gauges[name] = gauge
+	return gauge, nil
+}
+
+func labelNames(labels map[string]string) []string {
+	names := make([]string, 0, len(labels))
+	for name := range labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// sanitizeName 把 "user-service" 之类的名字转换成合法的 Prometheus 指标名
+func sanitizeName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' || r == ':' {
+			return r
+		}
+		return '_'
+	}, name)
+}
+
+// RunMetricsServer 在 METRICS_PORT（默认 9090）上暴露默认 registry 的 /metrics
+func RunMetricsServer() {
+	port := os.Getenv("METRICS_PORT")
+	if port == "" {
+		port = "9090"
+	}
+	RunMetricsServerOnAddr(":"+port, prometheus.DefaultGatherer)
+}
+
+func RunMetricsServerOnAddr(addr string, gatherer prometheus.Gatherer) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(gatherer, promhttp.HandlerOpts{}))
+
+	logrus.WithField("metricsEndpoint", addr).Info("Starting: metrics HTTP server")
+	if err := http.ListenAndServe(addr, mux); err != nil {
+		logrus.WithError(err).Error("Metrics HTTP server stopped")
+	}
+}
@@ -0,0 +1,85 @@
+package metrics_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"newTiktoken/internal/common/metrics"
+)
+
+func TestPrometheusMetricsInc(t *testing.T) {
+	t.Parallel()
+	registry := prometheus.NewRegistry()
+	client := metrics.NewPrometheusMetrics("test-service", registry)
+
+	client.Inc("commands_total", map[string]string{"command": "followuser", "result": "success"}, 1)
+	client.Inc("commands_total", map[string]string{"command": "followuser", "result": "success"}, 2)
+	client.Inc("commands_total", map[string]string{"command": "followuser", "result": "failure"}, 1)
+
+	expected := `
+# HELP test_service_commands_total commands_total
+# TYPE test_service_commands_total counter
+test_service_commands_total{command="followuser",result="failure"} 1
+test_service_commands_total{command="followuser",result="success"} 3
+`
+	if err := testutil.GatherAndCompare(registry, strings.NewReader(expected), "test_service_commands_total"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPrometheusMetricsSetGauge(t *testing.T) {
+	t.Parallel()
+	registry := prometheus.NewRegistry()
+	client := metrics.NewPrometheusMetrics("test-service", registry)
+
+	client.SetGauge("queue_depth", map[string]string{"pool": "password_hasher"}, 3)
+	client.SetGauge("queue_depth", map[string]string{"pool": "password_hasher"}, 1)
+
+	expected := `
+# HELP test_service_queue_depth queue_depth
+# TYPE test_service_queue_depth gauge
+test_service_queue_depth{pool="password_hasher"} 1
+`
+	if err := testutil.GatherAndCompare(registry, strings.NewReader(expected), "test_service_queue_depth"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPrometheusMetricsObserveSubSecondDuration(t *testing.T) {
+	t.Parallel()
+	registry := prometheus.NewRegistry()
+	client := metrics.NewPrometheusMetrics("test", registry)
+
+	client.ObserveDuration("command_duration_seconds", map[string]string{"command": "followuser"}, 3*time.Millisecond)
+
+	families, err := registry.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(families) != 1 {
+		t.Fatalf("expected 1 metric family, got %d", len(families))
+	}
+	histogram := families[0].GetMetric()[0].GetHistogram()
+	if histogram.GetSampleCount() != 1 {
+		t.Fatalf("expected 1 sample, got %d", histogram.GetSampleCount())
+	}
+	if sum := histogram.GetSampleSum(); sum <= 0 || sum >= 1 {
+		t.Errorf("expected sub-second duration to be recorded, got %f", sum)
+	}
+}
+
+func TestPrometheusMetricsIgnoresMismatchedLabels(t *testing.T) {
+	t.Parallel()
+	registry := prometheus.NewRegistry()
+	client := metrics.NewPrometheusMetrics("test", registry)
+
+	client.Inc("requests_total", map[string]string{"method": "a"}, 1)
+	client.Inc("requests_total", map[string]string{"other": "b"}, 1)
+
+	if count := testutil.CollectAndCount(registry, "test_requests_total"); count != 1 {
+		t.Errorf("expected only the valid series to be recorded, got %d", count)
+	}
+}
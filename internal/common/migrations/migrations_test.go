@@ -0,0 +1,123 @@
+package migrations
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestEmbeddedMigrations(t *testing.T) {
+	migrations, err := Embedded()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(migrations) == 0 {
+		t.Fatal("expected embedded migrations")
+	}
+	for i, migration := range migrations {
+		if migration.Version != i+1 {
+			t.Errorf("expected version %d, got %d_%s", i+1, migration.Version, migration.Name)
+		}
+	}
+
+	schema := ""
+	for _, migration := range migrations {
+		schema += migration.Up
+	}
+	for _, uniqueKey := range []string{
+		"UNIQUE KEY uk_users_user_uuid (user_uuid)",
+		"UNIQUE KEY uk_user_relations_parties (active_party_uuid, passive_party_uuid)",
+	} {
+		if !strings.Contains(schema, uniqueKey) {
+			t.Errorf("expected migrations to create %s", uniqueKey)
+		}
+	}
+}
+
+func TestLoad(t *testing.T) {
+	fsys := fstest.MapFS{
+		"0002_add_index.up.sql":      {Data: []byte("CREATE INDEX idx ON users (user_name);")},
+		"0002_add_index.down.sql":    {Data: []byte("DROP INDEX idx ON users;")},
+		"0001_create_users.up.sql":   {Data: []byte("CREATE TABLE users (id INT);")},
+		"0001_create_users.down.sql": {Data: []byte("DROP TABLE users;")},
+		"README.md":                  {Data: []byte("ignored")},
+	}
+
+	migrations, err := Load(fsys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(migrations) != 2 || migrations[0].Name != "create_users" || migrations[1].Name != "add_index" {
+		t.Fatalf("unexpected migrations %+v", migrations)
+	}
+	if migrations[1].Down != "DROP INDEX idx ON users;" {
+		t.Errorf("unexpected down script %q", migrations[1].Down)
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Files         fstest.MapFS
+		ExpectedError string
+	}{
+		{
+			Name: "missing_down",
+			Files: fstest.MapFS{
+				"0001_create_users.up.sql": {Data: []byte("CREATE TABLE users (id INT);")},
+			},
+			ExpectedError: "needs both up and down",
+		},
+		{
+			Name: "invalid_name",
+			Files: fstest.MapFS{
+				"create_users.sql": {Data: []byte("CREATE TABLE users (id INT);")},
+			},
+			ExpectedError: "doesn't match",
+		},
+		{
+			Name: "conflicting_names",
+			Files: fstest.MapFS{
+				"0001_create_users.up.sql":    {Data: []byte("CREATE TABLE users (id INT);")},
+				"0001_create_people.down.sql": {Data: []byte("DROP TABLE users;")},
+			},
+			ExpectedError: "has two names",
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.Name, func(t *testing.T) {
+			_, err := Load(c.Files)
+			if err == nil || !strings.Contains(err.Error(), c.ExpectedError) {
+				t.Errorf("expected error containing %q, got %v", c.ExpectedError, err)
+			}
+		})
+	}
+}
+
+func TestStatements(t *testing.T) {
+	script := `-- 第一条语句
+CREATE TABLE a (
+    id INT
+);
+
+INSERT INTO a VALUES (1);
+-- 最后一条语句可以没有分号
+INSERT INTO a VALUES (2)
+`
+	expected := []string{
+		"CREATE TABLE a (\n    id INT\n)",
+		"INSERT INTO a VALUES (1)",
+		"INSERT INTO a VALUES (2)",
+	}
+
+	got := statements(script)
+	if len(got) != len(expected) {
+		t.Fatalf("expected %d statements, got %q", len(expected), got)
+	}
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("statement %d: expected %q, got %q", i, expected[i], got[i])
+		}
+	}
+}
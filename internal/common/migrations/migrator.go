@@ -0,0 +1,181 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	versionTable = "schema_migrations"
+	lockName     = "newtiktoken.schema_migrations"
+)
+
+// Status 是一个迁移的执行状态，AppliedAt 为 nil 表示尚未执行
+type Status struct {
+	Migration
+	AppliedAt *time.Time
+}
+
+// Migrator 在 MySQL 上执行迁移并在 schema_migrations 表中记录已执行的版本
+// 所有操作都在持有 GET_LOCK 的同一个连接上进行，多个 Pod 同时执行时会依次等待
+type Migrator struct {
+	db          *sql.DB
+	migrations  []Migration
+	lockTimeout time.Duration
+}
+
+func NewMigrator(db *sql.DB, migrations []Migration, lockTimeout time.Duration) *Migrator {
+	if db == nil {
+		panic("nil db")
+	}
+	return &Migrator{db: db, migrations: migrations, lockTimeout: lockTimeout}
+}
+
+// Up 按版本顺序执行所有未执行的迁移，返回本次执行的迁移
+func (m *Migrator) Up(ctx context.Context) (applied []Migration, err error) {
+	err = m.withLock(ctx, func(conn *sql.Conn) error {
+		appliedVersions, err := m.appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, migration := range m.migrations {
+			if _, ok := appliedVersions[migration.Version]; ok {
+				continue
+			}
+			if err := m.run(ctx, conn, migration, migration.Up); err != nil {
+				return err
+			}
+			if _, err := conn.ExecContext(ctx,
+				"INSERT INTO "+versionTable+" (version, name, applied_at) VALUES (?, ?, ?)",
+				migration.Version, migration.Name, time.Now().UTC(),
+			); err != nil {
+				return errors.Wrapf(err, "unable to record migration %d", migration.Version)
+			}
+			applied = append(applied, migration)
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// Down 按版本倒序回滚最近执行的 steps 个迁移，返回本次回滚的迁移
+func (m *Migrator) Down(ctx context.Context, steps int) (reverted []Migration, err error) {
+	err = m.withLock(ctx, func(conn *sql.Conn) error {
+		appliedVersions, err := m.appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for i := len(m.migrations) - 1; i >= 0 && len(reverted) < steps; i-- {
+			migration := m.migrations[i]
+			if _, ok := appliedVersions[migration.Version]; !ok {
+				continue
+			}
+			if err := m.run(ctx, conn, migration, migration.Down); err != nil {
+				return err
+			}
+			if _, err := conn.ExecContext(ctx, "DELETE FROM "+versionTable+" WHERE version = ?", migration.Version); err != nil {
+				return errors.Wrapf(err, "unable to remove record of migration %d", migration.Version)
+			}
+			reverted = append(reverted, migration)
+		}
+		return nil
+	})
+	return reverted, err
+}
+
+// Status 返回每个迁移是否已执行
+func (m *Migrator) Status(ctx context.Context) (statuses []Status, err error) {
+	err = m.withLock(ctx, func(conn *sql.Conn) error {
+		appliedVersions, err := m.appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, migration := range m.migrations {
+			status := Status{Migration: migration}
+			if appliedAt, ok := appliedVersions[migration.Version]; ok {
+				status.AppliedAt = &appliedAt
+			}
+			statuses = append(statuses, status)
+		}
+		return nil
+	})
+	return statuses, err
+}
+
+// Version 返回已执行的最大版本，没有执行过迁移时返回 0
+func (m *Migrator) Version(ctx context.Context) (version int, err error) {
+	err = m.withLock(ctx, func(conn *sql.Conn) error {
+		return conn.QueryRowContext(ctx, "SELECT COALESCE(MAX(version), 0) FROM "+versionTable).Scan(&version)
+	})
+	return version, errors.Wrap(err, "unable to query schema version")
+}
+
+func (m *Migrator) run(ctx context.Context, conn *sql.Conn, migration Migration, script string) error {
+	logrus.WithFields(logrus.Fields{
+		"version": migration.Version,
+		"name":    migration.Name,
+	}).Info("Running migration")
+
+	// MySQL 的 DDL 会隐式提交事务，因此逐条执行，失败时需要人工处理后重试
+	for _, statement := range statements(script) {
+		if _, err := conn.ExecContext(ctx, statement); err != nil {
+			return errors.Wrapf(err, "migration %d_%s failed", migration.Version, migration.Name)
+		}
+	}
+	return nil
+}
+
+func (m *Migrator) appliedVersions(ctx context.Context, conn *sql.Conn) (map[int]time.Time, error) {
+	rows, err := conn.QueryContext(ctx, "SELECT version, applied_at FROM "+versionTable)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to query applied migrations")
+	}
+	defer rows.Close()
+
+	applied := map[int]time.Time{}
+	for rows.Next() {
+		var version int
+		var appliedAt time.Time
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, errors.Wrap(err, "unable to scan applied migration")
+		}
+		applied[version] = appliedAt
+	}
+	return applied, errors.Wrap(rows.Err(), "unable to iterate applied migrations")
+}
+
+// withLock 获取 MySQL 命名锁并确保版本表存在后执行 fn
+// GET_LOCK 绑定在连接上，因此 fn 中的所有语句都必须使用同一个 conn
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *sql.Conn) error) (err error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return errors.Wrap(err, "unable to get connection")
+	}
+	defer conn.Close()
+
+	var locked sql.NullInt64
+	if err := conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, ?)", lockName, int(m.lockTimeout.Seconds())).Scan(&locked); err != nil {
+		return errors.Wrap(err, "unable to acquire migration lock")
+	}
+	if !locked.Valid || locked.Int64 != 1 {
+		return errors.Errorf("timed out after %s waiting for migration lock, another migration may be running", m.lockTimeout)
+	}
+	defer func() {
+		if _, releaseErr := conn.ExecContext(context.Background(), "SELECT RELEASE_LOCK(?)", lockName); releaseErr != nil && err == nil {
+			err = errors.Wrap(releaseErr, "unable to release migration lock")
+		}
+	}()
+
+	if _, err := conn.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS `+versionTable+` (
+        version    BIGINT UNSIGNED NOT NULL PRIMARY KEY,
+        name       VARCHAR(255)    NOT NULL,
+        applied_at DATETIME(6)     NOT NULL
+    ) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4`); err != nil {
+		return errors.Wrap(err, "unable to create version table")
+	}
+	return fn(conn)
+}
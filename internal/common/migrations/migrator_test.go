@@ -0,0 +1,142 @@
+package migrations_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"newTiktoken/internal/common/migrations"
+)
+
+// newTestDatabase 在 MYSQL_DSN 指向的实例上创建临时数据库，避免回滚迁移时删除其他测试使用的表
+func newTestDatabase(t *testing.T) *sql.DB {
+	t.Helper()
+	dsn := os.Getenv("MYSQL_DSN")
+	if dsn == "" {
+		t.Skip("MYSQL_DSN is not set")
+	}
+
+	admin, err := sql.Open("mysql", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = admin.Close() })
+
+	name := fmt.Sprintf("migrations_test_%d", time.Now().UnixNano())
+	if _, err := admin.Exec("CREATE DATABASE " + name); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _, _ = admin.Exec("DROP DATABASE " + name) })
+
+	cfg, err := mysql.ParseDSN(dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.DBName = name
+	cfg.ParseTime = true
+	db, err := sql.Open("mysql", cfg.FormatDSN())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+	return db
+}
+
+func TestMigrator(t *testing.T) {
+	db := newTestDatabase(t)
+	embedded, err := migrations.Embedded()
+	if err != nil {
+		t.Fatal(err)
+	}
+	migrator := migrations.NewMigrator(db, embedded, 10*time.Second)
+	ctx := context.Background()
+	latest := embedded[len(embedded)-1].Version
+
+	applied, err := migrator.Up(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(applied) != len(embedded) {
+		t.Errorf("expected %d applied migrations, got %d", len(embedded), len(applied))
+	}
+	if applied, err := migrator.Up(ctx); err != nil || len(applied) != 0 {
+		t.Errorf("expected second up to be a no-op, got %v, %v", applied, err)
+	}
+
+	if version, err := migrator.Version(ctx); err != nil || version != latest {
+		t.Errorf("expected version %d, got %d, %v", latest, version, err)
+	}
+
+	// 唯一键阻止重复的用户和关系
+	now := time.Now().UTC()
+	if _, err := db.Exec("INSERT INTO users (user_uuid, user_name, created_at, updated_at) VALUES (?, ?, ?, ?)", "u1", "a", now, now); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec("INSERT INTO users (user_uuid, user_name, created_at, updated_at) VALUES (?, ?, ?, ?)", "u1", "b", now, now); err == nil {
+		t.Error("expected duplicate user_uuid to be rejected")
+	}
+
+	reverted, err := migrator.Down(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reverted) != 1 || reverted[0].Version != latest {
+		t.Errorf("expected to revert version %d, got %+v", latest, reverted)
+	}
+
+	statuses, err := migrator.Status(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, status := range statuses {
+		pending := status.AppliedAt == nil
+		if pending != (status.Version == latest) {
+			t.Errorf("unexpected status of %d_%s: applied at %v", status.Version, status.Name, status.AppliedAt)
+		}
+	}
+
+	if _, err := migrator.Down(ctx, len(embedded)); err != nil {
+		t.Fatal(err)
+	}
+	if version, err := migrator.Version(ctx); err != nil || version != 0 {
+		t.Errorf("expected version 0 after reverting everything, got %d, %v", version, err)
+	}
+}
+
+func TestMigratorConcurrentUp(t *testing.T) {
+	db := newTestDatabase(t)
+	embedded, err := migrations.Embedded()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const workers = 5
+	var wg sync.WaitGroup
+	appliedCounts := make(chan int, workers)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			applied, err := migrations.NewMigrator(db, embedded, 30*time.Second).Up(context.Background())
+			if err != nil {
+				t.Error(err)
+			}
+			appliedCounts <- len(applied)
+		}()
+	}
+	wg.Wait()
+	close(appliedCounts)
+
+	total := 0
+	for count := range appliedCounts {
+		total += count
+	}
+	if total != len(embedded) {
+		t.Errorf("expected each migration to be applied once, got %d applications", total)
+	}
+}
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS users (
+    id              BIGINT UNSIGNED   AUTO_INCREMENT PRIMARY KEY,
+    user_uuid       VARCHAR(128)      NOT NULL,
+    user_name       VARCHAR(64)       NOT NULL,
+    age             SMALLINT UNSIGNED NULL,
+    gender          TINYINT UNSIGNED  NULL,
+    following_count BIGINT UNSIGNED   NOT NULL DEFAULT 0,
+    follower_count  BIGINT UNSIGNED   NOT NULL DEFAULT 0,
+    total_favorite  BIGINT UNSIGNED   NOT NULL DEFAULT 0,
+    work_count      BIGINT UNSIGNED   NOT NULL DEFAULT 0,
+    favorite_count  BIGINT UNSIGNED   NOT NULL DEFAULT 0,
+    created_at      DATETIME(6)       NOT NULL,
+    updated_at      DATETIME(6)       NOT NULL,
+    UNIQUE KEY uk_users_user_uuid (user_uuid)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
@@ -0,0 +1,12 @@
+-- 两个非唯一索引分别服务关注列表和粉丝列表的 (updated_at, 对方uuid) 键集分页
+CREATE TABLE IF NOT EXISTS user_relations (
+    id                 BIGINT UNSIGNED  AUTO_INCREMENT PRIMARY KEY,
+    active_party_uuid  VARCHAR(128)     NOT NULL,
+    passive_party_uuid VARCHAR(128)     NOT NULL,
+    status             TINYINT UNSIGNED NOT NULL,
+    created_at         DATETIME(6)      NOT NULL,
+    updated_at         DATETIME(6)      NOT NULL,
+    UNIQUE KEY uk_user_relations_parties (active_party_uuid, passive_party_uuid),
+    KEY idx_user_relations_active (active_party_uuid, status, updated_at, passive_party_uuid),
+    KEY idx_user_relations_passive (passive_party_uuid, status, updated_at, active_party_uuid)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS outbox_events (
+    id           BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    event_uuid   CHAR(36)        NOT NULL,
+    event_name   VARCHAR(128)    NOT NULL,
+    payload      JSON            NOT NULL,
+    created_at   DATETIME(6)     NOT NULL,
+    published_at DATETIME(6)     NULL,
+    UNIQUE KEY uk_outbox_events_event_uuid (event_uuid),
+    KEY idx_outbox_events_unpublished (published_at, id)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
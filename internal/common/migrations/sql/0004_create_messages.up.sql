@@ -0,0 +1,10 @@
+-- 好友列表按 (发送方, 接收方) 两个方向查询最新一条消息
+CREATE TABLE IF NOT EXISTS messages (
+    id             BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    from_user_uuid VARCHAR(128)    NOT NULL,
+    to_user_uuid   VARCHAR(128)    NOT NULL,
+    content        TEXT            NOT NULL,
+    created_at     DATETIME(6)     NOT NULL,
+    KEY idx_messages_from_to (from_user_uuid, to_user_uuid, created_at),
+    KEY idx_messages_to_from (to_user_uuid, from_user_uuid, created_at)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
@@ -0,0 +1,16 @@
+-- Feed 按投稿时间倒序分页，发布列表按作者和投稿时间查询
+CREATE TABLE IF NOT EXISTS videos (
+    id             BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    video_uuid     VARCHAR(128)    NOT NULL,
+    author_uuid    VARCHAR(128)    NOT NULL,
+    title          VARCHAR(128)    NOT NULL,
+    play_url       VARCHAR(1024)   NOT NULL,
+    favorite_count BIGINT UNSIGNED NOT NULL DEFAULT 0,
+    comment_count  BIGINT UNSIGNED NOT NULL DEFAULT 0,
+    share_count    BIGINT UNSIGNED NOT NULL DEFAULT 0,
+    created_at     DATETIME(6)     NOT NULL,
+    updated_at     DATETIME(6)     NOT NULL,
+    UNIQUE KEY uk_videos_video_uuid (video_uuid),
+    KEY idx_videos_created_at (created_at),
+    KEY idx_videos_author_created_at (author_uuid, created_at)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
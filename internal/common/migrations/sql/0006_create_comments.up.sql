@@ -0,0 +1,12 @@
+-- 评论软删除，列表按 (video_uuid, created_at, comment_uuid) 做键集分页
+CREATE TABLE IF NOT EXISTS comments (
+    id           BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    comment_uuid VARCHAR(128)    NOT NULL,
+    video_uuid   VARCHAR(128)    NOT NULL,
+    author_uuid  VARCHAR(128)    NOT NULL,
+    content      VARCHAR(1024)   NOT NULL,
+    created_at   DATETIME(6)     NOT NULL,
+    deleted_at   DATETIME(6)     NULL,
+    UNIQUE KEY uk_comments_comment_uuid (comment_uuid),
+    KEY idx_comments_video_created_at (video_uuid, created_at, comment_uuid)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
@@ -0,0 +1,12 @@
+-- 每个用户对每个视频只有一条记录，status 为 0 表示没有点赞或踩
+-- 点赞列表按 (user_uuid, status, updated_at, video_uuid) 做键集分页
+CREATE TABLE IF NOT EXISTS video_favorites (
+    id         BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    user_uuid  VARCHAR(128)    NOT NULL,
+    video_uuid VARCHAR(128)    NOT NULL,
+    status     TINYINT         NOT NULL,
+    created_at DATETIME(6)     NOT NULL,
+    updated_at DATETIME(6)     NOT NULL,
+    UNIQUE KEY uk_video_favorites_user_video (user_uuid, video_uuid),
+    KEY idx_video_favorites_user_status_updated_at (user_uuid, status, updated_at, video_uuid)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
@@ -0,0 +1,7 @@
+-- 消费者在处理事件的事务中写入 event_uuid，用于丢弃重复投递的事件
+CREATE TABLE IF NOT EXISTS processed_events (
+    consumer     VARCHAR(128)    NOT NULL,
+    event_uuid   CHAR(36)        NOT NULL,
+    processed_at DATETIME(6)     NOT NULL,
+    PRIMARY KEY (consumer, event_uuid)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
@@ -0,0 +1,11 @@
+-- 登录凭证与 users 分表存放，password_hash 为 PHC 格式（$argon2id$v=19$m=...,t=...,p=...$salt$hash）
+CREATE TABLE IF NOT EXISTS user_credentials (
+    id            BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    user_uuid     VARCHAR(128)    NOT NULL,
+    username      VARCHAR(32)     NOT NULL,
+    password_hash VARCHAR(255)    NOT NULL,
+    created_at    DATETIME(6)     NOT NULL,
+    updated_at    DATETIME(6)     NOT NULL,
+    UNIQUE KEY uk_user_credentials_user_uuid (user_uuid),
+    UNIQUE KEY uk_user_credentials_username (username)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
@@ -0,0 +1,3 @@
+-- partition_key 相同的事件发布到消息队列的同一个分区，消费者按写入顺序处理，为空时随机分区
+ALTER TABLE outbox_events
+    ADD COLUMN partition_key VARCHAR(128) NOT NULL DEFAULT '' AFTER event_name;
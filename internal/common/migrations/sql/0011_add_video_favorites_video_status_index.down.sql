@@ -0,0 +1,2 @@
+ALTER TABLE video_favorites
+    DROP KEY idx_video_favorites_video_status;
@@ -0,0 +1,3 @@
+-- 视频的点赞数按 (video_uuid, status) 从点赞记录重新统计
+ALTER TABLE video_favorites
+    ADD KEY idx_video_favorites_video_status (video_uuid, status);
@@ -0,0 +1,65 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	commonErrors "newTiktoken/internal/common/errors"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+var ErrInvalidCursor = commonErrors.NewIncorrectInputError("invalid cursor", "invalid-cursor")
+
+// Cursor 是列表查询的 keyset 游标，记录上一页最后一条数据的 (时间, UUID)，对外以不透明的字符串传递
+type Cursor struct {
+	Time time.Time
+	UUID string
+}
+
+func (c Cursor) IsZero() bool {
+	return c.UUID == "" && c.Time.IsZero()
+}
+
+func (c Cursor) Encode() string {
+	if c.IsZero() {
+		return ""
+	}
+	raw := strconv.FormatInt(c.Time.UnixNano(), 10) + "|" + c.UUID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func DecodeCursor(cursor string) (Cursor, error) {
+	if cursor == "" {
+		return Cursor{}, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+	nanos, uuid, found := strings.Cut(string(raw), "|")
+	if !found || uuid == "" {
+		return Cursor{}, ErrInvalidCursor
+	}
+	unixNano, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+	return Cursor{Time: time.Unix(0, unixNano).UTC(), UUID: uuid}, nil
+}
+
+// NormalizePageSize 把非正数的页大小替换为默认值，并限制最大页大小
+func NormalizePageSize(limit int) int {
+	if limit <= 0 {
+		return defaultPageSize
+	}
+	if limit > maxPageSize {
+		return maxPageSize
+	}
+	return limit
+}
@@ -0,0 +1,64 @@
+package pagination_test
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"newTiktoken/internal/common/pagination"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	t.Parallel()
+	cursor := pagination.Cursor{Time: time.Date(2024, 5, 1, 8, 30, 0, 123456789, time.UTC), UUID: "video-1"}
+
+	decoded, err := pagination.DecodeCursor(cursor.Encode())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.Time.Equal(cursor.Time) || decoded.UUID != cursor.UUID {
+		t.Errorf("expected %+v, got %+v", cursor, decoded)
+	}
+}
+
+func TestEmptyCursorIsFirstPage(t *testing.T) {
+	t.Parallel()
+	if encoded := (pagination.Cursor{}).Encode(); encoded != "" {
+		t.Errorf("expected zero cursor to encode as empty string, got %q", encoded)
+	}
+	decoded, err := pagination.DecodeCursor("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.IsZero() {
+		t.Errorf("expected zero cursor, got %+v", decoded)
+	}
+}
+
+func TestDecodeInvalidCursor(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]string{
+		"not_base64":    "%%%",
+		"missing_uuid":  base64.RawURLEncoding.EncodeToString([]byte("1714552200000000000|")),
+		"missing_sep":   base64.RawURLEncoding.EncodeToString([]byte("1714552200000000000")),
+		"invalid_nanos": base64.RawURLEncoding.EncodeToString([]byte("yesterday|video-1")),
+	}
+	for name, cursor := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			if _, err := pagination.DecodeCursor(cursor); !errors.Is(err, pagination.ErrInvalidCursor) {
+				t.Errorf("expected ErrInvalidCursor, got %v", err)
+			}
+		})
+	}
+}
+
+func TestNormalizePageSize(t *testing.T) {
+	t.Parallel()
+	for limit, expected := range map[int]int{-1: 20, 0: 20, 1: 1, 100: 100, 101: 100} {
+		if got := pagination.NormalizePageSize(limit); got != expected {
+			t.Errorf("expected page size %d for %d, got %d", expected, limit, got)
+		}
+	}
+}
@@ -1,16 +1,30 @@
 package server
 
 import (
+	"context"
+	"crypto/ecdsa"
 	"fmt"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 	"net"
+	"newTiktoken/internal/common/auth"
+	"newTiktoken/internal/common/config"
+	"newTiktoken/internal/common/decorator"
+	"newTiktoken/internal/common/keys"
 	"newTiktoken/internal/common/logs"
+	"newTiktoken/internal/common/metrics"
 	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_logrus "github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus"
 	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 )
 
@@ -22,35 +36,235 @@ func init() {
 	grpc_logrus.ReplaceGrpcLogger(logrus.NewEntry(logger))
 }
 
-func RunGRPCServer(registerServer func(server *grpc.Server)) {
+type grpcServerOptions struct {
+	metricsClient decorator.MetricsClient
+	tokenVerifier auth.TokenVerifier
+	publicMethods []string
+
+	healthChecks        []HealthCheck
+	healthCheckInterval time.Duration
+	shutdownDelay       time.Duration
+	shutdownTimeout     time.Duration
+	rateLimiter         *rateLimiter
+	// ctx 结束时和收到退出信号一样优雅退出
+	ctx context.Context
+}
+
+type GRPCServerOption func(options *grpcServerOptions)
+
+// WithMetricsClient 让 gRPC 服务按方法记录耗时和状态码
+func WithMetricsClient(client decorator.MetricsClient) GRPCServerOption {
+	return func(options *grpcServerOptions) {
+		options.metricsClient = client
+	}
+}
+
+// WithTokenVerifier 替换默认根据 MOCK_AUTH 选择的 token 校验方式
+func WithTokenVerifier(verifier auth.TokenVerifier) GRPCServerOption {
+	return func(options *grpcServerOptions) {
+		options.tokenVerifier = verifier
+	}
+}
+
+// WithKeySet 使用 set 中 issuer 的公钥校验 ES256 token，set 为 nil 时不修改校验方式
+func WithKeySet(set keys.Set, issuer string) GRPCServerOption {
+	return func(options *grpcServerOptions) {
+		if set == nil {
+			return
+		}
+		options.tokenVerifier = auth.ES256TokenVerifier{PublicKeys: func() map[string]*ecdsa.PublicKey {
+			return set.PublicKeys(issuer)
+		}}
+	}
+}
+
+// WithPublicMethods 声明除健康检查和反射之外不需要认证的方法，如注册和登录
+func WithPublicMethods(methods ...string) GRPCServerOption {
+	return func(options *grpcServerOptions) {
+		options.publicMethods = append(options.publicMethods, methods...)
+	}
+}
+
+// WithShutdownDelay 设置收到退出信号后以 NOT_SERVING 继续接收请求的时间，让负载均衡在关闭端口前摘除实例
+func WithShutdownDelay(delay time.Duration) GRPCServerOption {
+	return func(options *grpcServerOptions) {
+		options.shutdownDelay = delay
+	}
+}
+
+// WithShutdownTimeout 设置收到退出信号后等待进行中请求完成的最长时间
+func WithShutdownTimeout(timeout time.Duration) GRPCServerOption {
+	return func(options *grpcServerOptions) {
+		options.shutdownTimeout = timeout
+	}
+}
+
+// WithContext 让服务在 ctx 结束时也优雅退出，用于后台任务失败时停止服务并执行调用方的清理逻辑
+func WithContext(ctx context.Context) GRPCServerOption {
+	return func(options *grpcServerOptions) {
+		options.ctx = ctx
+	}
+}
+
+// WithConfig 使用配置中的退出等待时间、健康检查间隔和限流，限流随配置热更新
+// 没有通过 WithTokenVerifier 指定校验方式时，配置了 auth.jwt_secret 则使用该密钥校验自签 token
+func WithConfig(store *config.Store) GRPCServerOption {
+	return func(options *grpcServerOptions) {
+		cfg := store.Get()
+		options.shutdownDelay = cfg.GRPC.ShutdownDelay
+		options.shutdownTimeout = cfg.GRPC.ShutdownTimeout
+		options.healthCheckInterval = cfg.GRPC.HealthCheckInterval
+		if cfg.Auth.JWTSecret != "" && options.tokenVerifier == nil {
+			options.tokenVerifier = auth.JWTTokenVerifier{Secret: []byte(cfg.Auth.JWTSecret)}
+		}
+
+		limiter := newRateLimiter()
+		limiter.set(cfg.RateLimit)
+		store.Subscribe(func(cfg config.Config) {
+			limiter.set(cfg.RateLimit)
+		})
+		options.rateLimiter = limiter
+	}
+}
+
+func RunGRPCServer(registerServer func(server *grpc.Server), opts ...GRPCServerOption) {
 	port := os.Getenv("PORT")
 	if port == "" {
 		port = "8080"
 	}
 	addr := fmt.Sprintf(":%s", port)
-	RunGRPCServerOnAddr(addr, registerServer)
+	RunGRPCServerOnAddr(addr, registerServer, opts...)
 }
 
-func RunGRPCServerOnAddr(addr string, registerServer func(server *grpc.Server)) {
+// RunGRPCServerOnAddr 在收到 SIGINT 或 SIGTERM（或 WithContext 的 ctx 结束）后优雅退出并返回，调用方的 defer 清理逻辑可以正常执行
+func RunGRPCServerOnAddr(addr string, registerServer func(server *grpc.Server), opts ...GRPCServerOption) {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	listen, err := net.Listen("tcp", addr)
+	if err != nil {
+		logrus.Fatal(err)
+	}
+	logrus.WithField("grpcEndpoint", addr).Info("Starting: gRPC Listener")
+	if err := serveGRPC(ctx, listen, registerServer, opts...); err != nil {
+		logrus.Fatal(err)
+	}
+}
+
+// serveGRPC 在 listener 上提供服务直到 ctx 结束
+// 退出时先把健康状态置为 NOT_SERVING 并继续接收请求 shutdownDelay，让探针把实例摘除后再关闭端口，
+// 然后等待进行中的请求完成，超过 shutdownTimeout 后强制关闭
+func serveGRPC(ctx context.Context, listener net.Listener, registerServer func(server *grpc.Server), opts ...GRPCServerOption) error {
+	options := newGRPCServerOptions(opts...)
+	if options.ctx != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(ctx)
+		defer cancel()
+		defer context.AfterFunc(options.ctx, cancel)()
+	}
+	grpcServer := newGRPCServer(options)
+	registerServer(grpcServer)
+	reflection.Register(grpcServer)
+
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	healthServer.SetServingStatus(LivenessService, healthpb.HealthCheckResponse_SERVING)
+
+	checksCtx, stopChecks := context.WithCancel(ctx)
+	checksDone := make(chan struct{})
+	go func() {
+		defer close(checksDone)
+		runHealthChecks(checksCtx, healthServer, grpcServer, options.healthChecks, options.healthCheckInterval)
+	}()
+	defer func() {
+		stopChecks()
+		<-checksDone
+	}()
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- grpcServer.Serve(listener)
+	}()
+
+	select {
+	case err := <-serveErr:
+		return err
+	case <-ctx.Done():
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"delay":   options.shutdownDelay,
+		"timeout": options.shutdownTimeout,
+	}).Info("Shutting down gRPC server")
+	stopChecks()
+	<-checksDone
+	healthServer.Shutdown()
+	if options.shutdownDelay > 0 {
+		time.Sleep(options.shutdownDelay)
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(options.shutdownTimeout):
+		logrus.Warn("gRPC server didn't drain in time, closing remaining connections")
+		grpcServer.Stop()
+		<-stopped
+	}
+	return <-serveErr
+}
+
+func newGRPCServerOptions(opts ...GRPCServerOption) grpcServerOptions {
+	options := grpcServerOptions{
+		metricsClient:       metrics.NoOp{},
+		publicMethods:       append([]string{}, auth.PublicMethods...),
+		healthCheckInterval: 5 * time.Second,
+		shutdownTimeout:     20 * time.Second,
+		rateLimiter:         newRateLimiter(),
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	if options.tokenVerifier == nil {
+		options.tokenVerifier = newTokenVerifier()
+	}
+	return options
+}
+
+func newGRPCServer(options grpcServerOptions) *grpc.Server {
 	logrusEntry := logrus.NewEntry(logrus.StandardLogger())
 
-	grpcServer := grpc.NewServer(
+	return grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
 		grpc_middleware.WithUnaryServerChain(
 			grpc_ctxtags.UnaryServerInterceptor(grpc_ctxtags.WithFieldExtractor(grpc_ctxtags.CodeGenRequestFieldExtractor)),
+			TraceTagsUnaryServerInterceptor(),
 			grpc_logrus.UnaryServerInterceptor(logrusEntry),
+			MetricsUnaryServerInterceptor(options.metricsClient),
+			RateLimitUnaryServerInterceptor(options.rateLimiter),
+			auth.UnaryServerInterceptor(options.tokenVerifier, options.publicMethods...),
+			ErrorTranslationUnaryServerInterceptor(),
 		),
 		grpc_middleware.WithStreamServerChain(
 			grpc_ctxtags.StreamServerInterceptor(grpc_ctxtags.WithFieldExtractor(grpc_ctxtags.CodeGenRequestFieldExtractor)),
+			TraceTagsStreamServerInterceptor(),
 			grpc_logrus.StreamServerInterceptor(logrusEntry),
+			MetricsStreamServerInterceptor(options.metricsClient),
+			RateLimitStreamServerInterceptor(options.rateLimiter),
+			auth.StreamServerInterceptor(options.tokenVerifier, options.publicMethods...),
+			ErrorTranslationStreamServerInterceptor(),
 		),
 	)
-	registerServer(grpcServer)
-	reflection.Register(grpcServer)
+}
 
-	listen, err := net.Listen("tcp", addr)
-	if err != nil {
-		logrus.Fatal(err)
+// newTokenVerifier 与 HTTP 服务一样，MOCK_AUTH 为 true 时使用 mock JWT，否则使用 Firebase
+func newTokenVerifier() auth.TokenVerifier {
+	if mockAuth, _ := strconv.ParseBool(os.Getenv("MOCK_AUTH")); mockAuth {
+		return auth.MockTokenVerifier{}
 	}
-	logrus.WithField("grpcEndpoint", addr).Info("Starting: gRPC Listener")
-	logrus.Fatal(grpcServer.Serve(listen))
+	return auth.FirebaseTokenVerifier{AuthClient: newFirebaseAuthClient()}
 }
@@ -0,0 +1,84 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	commonerrors "newTiktoken/internal/common/errors"
+)
+
+// ErrorInfoDomain 是 ErrorInfo detail 的 domain，Reason 为 SlugError 的 slug
+const ErrorInfoDomain = "newtiktoken"
+
+// ErrorTranslationUnaryServerInterceptor 把 handler 返回的错误转换为 gRPC status，与 httperr.RespondWithSlugError 的映射保持一致
+func ErrorTranslationUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, translateError(ctx, err)
+		}
+		return resp, nil
+	}
+}
+
+func ErrorTranslationStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := handler(srv, ss); err != nil {
+			return translateError(ss.Context(), err)
+		}
+		return nil
+	}
+}
+
+// translateError 只向调用方返回 SlugError 自身的信息，其余错误（如 SQL 错误）只记录日志，返回通用的内部错误
+func translateError(ctx context.Context, err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	var slugError commonerrors.SlugError
+	if !errors.As(err, &slugError) {
+		logrus.WithContext(ctx).WithError(err).Error("Internal error in gRPC handler")
+		return status.Error(codes.Internal, "internal server error")
+	}
+
+	code := codeForErrorType(slugError.ErrorType())
+	if code == codes.Internal {
+		logrus.WithContext(ctx).WithError(err).WithField("error-slug", slugError.Slug()).Error("Internal error in gRPC handler")
+	}
+	st, detailsErr := status.New(code, slugError.Error()).WithDetails(&errdetails.ErrorInfo{
+		Reason:   slugError.Slug(),
+		Domain:   ErrorInfoDomain,
+		Metadata: map[string]string{"type": slugError.ErrorType().String()},
+	})
+	if detailsErr != nil {
+		return status.Error(code, slugError.Error())
+	}
+	return st.Err()
+}
+
+func codeForErrorType(errorType commonerrors.ErrorType) codes.Code {
+	switch errorType {
+	case commonerrors.ErrorTypeAuthentication:
+		return codes.Unauthenticated
+	case commonerrors.ErrorTypeAuthorization:
+		return codes.PermissionDenied
+	case commonerrors.ErrorTypeIncorrectInput:
+		return codes.InvalidArgument
+	case commonerrors.ErrorTypeNotFound:
+		return codes.NotFound
+	case commonerrors.ErrorTypeConflict:
+		return codes.AlreadyExists
+	case commonerrors.ErrorTypePreconditionFailed:
+		return codes.FailedPrecondition
+	case commonerrors.ErrorTypeResourceExhausted:
+		return codes.ResourceExhausted
+	default:
+		return codes.Internal
+	}
+}
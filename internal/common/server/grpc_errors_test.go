@@ -0,0 +1,88 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	commonerrors "newTiktoken/internal/common/errors"
+)
+
+func callWithError(err error) error {
+	interceptor := ErrorTranslationUnaryServerInterceptor()
+	_, translated := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/test/Method"}, func(context.Context, any) (any, error) {
+		return nil, err
+	})
+	return translated
+}
+
+func TestErrorTranslationMapsSlugErrors(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		Name         string
+		Err          error
+		ExpectedCode codes.Code
+	}{
+		{Name: "authorization", Err: commonerrors.NewAuthorizationError("denied", "denied-slug"), ExpectedCode: codes.PermissionDenied},
+		{Name: "incorrect_input", Err: commonerrors.NewIncorrectInputError("invalid age", "invalid-age"), ExpectedCode: codes.InvalidArgument},
+		{Name: "not_found", Err: commonerrors.NewNotFoundError("user not found", "user-not-found"), ExpectedCode: codes.NotFound},
+		{Name: "conflict", Err: commonerrors.NewConflictError("already following", "already-following"), ExpectedCode: codes.AlreadyExists},
+		{Name: "authentication", Err: commonerrors.NewAuthenticationError("invalid credentials", "invalid-credentials"), ExpectedCode: codes.Unauthenticated},
+		{Name: "precondition_failed", Err: commonerrors.NewPreconditionFailedError("not following", "not-following"), ExpectedCode: codes.FailedPrecondition},
+		{Name: "resource_exhausted", Err: commonerrors.NewResourceExhaustedError("password hasher is overloaded", "password-hasher-overloaded"), ExpectedCode: codes.ResourceExhausted},
+		{Name: "unknown", Err: commonerrors.NewSlugError("something broke", "broken"), ExpectedCode: codes.Internal},
+	}
+	for _, c := range testCases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+			slugError := c.Err.(commonerrors.SlugError)
+
+			st := status.Convert(callWithError(errors.Wrap(c.Err, "failed to handle command")))
+			if st.Code() != c.ExpectedCode {
+				t.Errorf("expected code %s, got %s", c.ExpectedCode, st.Code())
+			}
+			if st.Message() != slugError.Error() {
+				t.Errorf("expected message %q without wrapping context, got %q", slugError.Error(), st.Message())
+			}
+			if len(st.Details()) != 1 {
+				t.Fatalf("expected one detail, got %d", len(st.Details()))
+			}
+			info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+			if !ok {
+				t.Fatalf("expected ErrorInfo detail, got %T", st.Details()[0])
+			}
+			if info.GetReason() != slugError.Slug() || info.GetDomain() != ErrorInfoDomain {
+				t.Errorf("unexpected ErrorInfo %+v", info)
+			}
+		})
+	}
+}
+
+func TestErrorTranslationHidesInternalErrors(t *testing.T) {
+	t.Parallel()
+	err := errors.Wrap(sql.ErrConnDone, "failed to query users information")
+
+	st := status.Convert(callWithError(err))
+	if st.Code() != codes.Internal {
+		t.Errorf("expected Internal, got %s", st.Code())
+	}
+	if strings.Contains(st.Message(), "sql") || strings.Contains(st.Message(), "users") {
+		t.Errorf("internal error text leaked to client: %q", st.Message())
+	}
+}
+
+func TestErrorTranslationKeepsStatusErrors(t *testing.T) {
+	t.Parallel()
+	err := status.Error(codes.Unauthenticated, "empty bearer token")
+
+	if translated := callWithError(err); status.Code(translated) != codes.Unauthenticated {
+		t.Errorf("expected Unauthenticated, got %v", translated)
+	}
+}
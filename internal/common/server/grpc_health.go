@@ -0,0 +1,87 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// LivenessService 是只反映进程存活的健康检查服务名，不受依赖检查影响，供 livenessProbe 使用
+// 空服务名和已注册的 gRPC 服务由依赖检查驱动，供 readinessProbe 使用
+const LivenessService = "liveness"
+
+// HealthCheck 检查服务的一个依赖，如数据库连接，返回错误时服务变为 NOT_SERVING
+type HealthCheck struct {
+	Name  string
+	Check func(ctx context.Context) error
+}
+
+// WithHealthChecks 添加驱动 grpc.health.v1 状态的依赖检查
+func WithHealthChecks(checks ...HealthCheck) GRPCServerOption {
+	return func(options *grpcServerOptions) {
+		options.healthChecks = append(options.healthChecks, checks...)
+	}
+}
+
+// WithHealthCheckInterval 设置依赖检查的间隔
+func WithHealthCheckInterval(interval time.Duration) GRPCServerOption {
+	return func(options *grpcServerOptions) {
+		options.healthCheckInterval = interval
+	}
+}
+
+// runHealthChecks 按 interval 执行依赖检查并更新 healthServer，直到 ctx 结束
+func runHealthChecks(
+	ctx context.Context,
+	healthServer *health.Server,
+	grpcServer *grpc.Server,
+	checks []HealthCheck,
+	interval time.Duration,
+) {
+	services := []string{""}
+	for name := range grpcServer.GetServiceInfo() {
+		services = append(services, name)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	lastStatus := healthpb.HealthCheckResponse_UNKNOWN
+	for {
+		status := checkDependencies(ctx, checks, interval)
+		if ctx.Err() != nil {
+			return
+		}
+		if status != lastStatus {
+			logrus.WithField("status", status.String()).Info("gRPC health status changed")
+			lastStatus = status
+		}
+		for _, service := range services {
+			healthServer.SetServingStatus(service, status)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func checkDependencies(ctx context.Context, checks []HealthCheck, timeout time.Duration) healthpb.HealthCheckResponse_ServingStatus {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	status := healthpb.HealthCheckResponse_SERVING
+	for _, check := range checks {
+		if err := check.Check(ctx); err != nil {
+			logrus.WithError(err).WithField("check", check.Name).Warn("Health check failed")
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+	}
+	return status
+}
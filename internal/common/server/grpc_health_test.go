@@ -0,0 +1,231 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"newTiktoken/internal/common/auth"
+)
+
+const slowMethod = "/test.Slow/Wait"
+
+// slowServiceDesc 注册一个阻塞到 release 关闭才返回的方法，用来模拟退出时进行中的请求
+func slowServiceDesc(started chan<- struct{}, release <-chan struct{}) grpc.ServiceDesc {
+	return grpc.ServiceDesc{
+		ServiceName: "test.Slow",
+		HandlerType: (*any)(nil),
+		Methods: []grpc.MethodDesc{{
+			MethodName: "Wait",
+			Handler: func(_ any, ctx context.Context, dec func(any) error, _ grpc.UnaryServerInterceptor) (any, error) {
+				if err := dec(&emptypb.Empty{}); err != nil {
+					return nil, err
+				}
+				close(started)
+				<-release
+				return &emptypb.Empty{}, nil
+			},
+		}},
+	}
+}
+
+type testGRPCServer struct {
+	conn   *grpc.ClientConn
+	cancel context.CancelFunc
+	done   chan error
+}
+
+func startTestGRPCServer(t *testing.T, register func(*grpc.Server), opts ...GRPCServerOption) testGRPCServer {
+	t.Helper()
+	listener := bufconn.Listen(1024 * 1024)
+	ctx, cancel := context.WithCancel(context.Background())
+	opts = append([]GRPCServerOption{
+		WithTokenVerifier(auth.MockTokenVerifier{}),
+		WithPublicMethods(slowMethod),
+		WithHealthCheckInterval(10 * time.Millisecond),
+	}, opts...)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- serveGRPC(ctx, listener, register, opts...)
+	}()
+	t.Cleanup(cancel)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return testGRPCServer{conn: conn, cancel: cancel, done: done}
+}
+
+func waitForStatus(t *testing.T, client healthpb.HealthClient, service string, expected healthpb.HealthCheckResponse_ServingStatus) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		resp, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+		if err == nil && resp.Status == expected {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("service %q: expected %s, got %v (err: %v)", service, expected, resp.GetStatus(), err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestHealthStatusFollowsDependencyChecks(t *testing.T) {
+	var healthy atomic.Bool
+	srv := startTestGRPCServer(t, func(*grpc.Server) {}, WithHealthChecks(HealthCheck{
+		Name: "db",
+		Check: func(ctx context.Context) error {
+			if healthy.Load() {
+				return nil
+			}
+			return errors.New("connection refused")
+		},
+	}))
+	client := healthpb.NewHealthClient(srv.conn)
+
+	waitForStatus(t, client, "", healthpb.HealthCheckResponse_NOT_SERVING)
+	waitForStatus(t, client, LivenessService, healthpb.HealthCheckResponse_SERVING)
+
+	healthy.Store(true)
+	waitForStatus(t, client, "", healthpb.HealthCheckResponse_SERVING)
+	waitForStatus(t, client, healthpb.Health_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+
+	healthy.Store(false)
+	waitForStatus(t, client, "", healthpb.HealthCheckResponse_NOT_SERVING)
+}
+
+func TestShutdownDrainsInFlightRequests(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	desc := slowServiceDesc(started, release)
+	srv := startTestGRPCServer(t, func(s *grpc.Server) {
+		s.RegisterService(&desc, nil)
+	}, WithShutdownTimeout(5*time.Second))
+
+	callErr := make(chan error, 1)
+	go func() {
+		callErr <- srv.conn.Invoke(context.Background(), slowMethod, &emptypb.Empty{}, &emptypb.Empty{})
+	}()
+	<-started
+
+	srv.cancel()
+	select {
+	case err := <-srv.done:
+		t.Fatalf("server stopped before in-flight request finished: %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	if err := <-callErr; err != nil {
+		t.Errorf("in-flight request failed during shutdown: %v", err)
+	}
+	if err := <-srv.done; err != nil {
+		t.Errorf("unexpected serve error: %v", err)
+	}
+}
+
+func TestShutdownReportsNotServingAndForcesStopAfterTimeout(t *testing.T) {
+	srv := startTestGRPCServer(t, func(*grpc.Server) {}, WithShutdownTimeout(100*time.Millisecond))
+	client := healthpb.NewHealthClient(srv.conn)
+	waitForStatus(t, client, "", healthpb.HealthCheckResponse_SERVING)
+
+	// Watch 流在退出时不会自行结束，只能由超时后的强制关闭断开
+	stream, err := client.Watch(context.Background(), &healthpb.HealthCheckRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := stream.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Status != healthpb.HealthCheckResponse_SERVING {
+		t.Fatalf("expected SERVING before shutdown, got %s", resp.Status)
+	}
+
+	srv.cancel()
+	resp, err = stream.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Status != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Errorf("expected NOT_SERVING during shutdown, got %s", resp.Status)
+	}
+
+	select {
+	case err := <-srv.done:
+		if err != nil {
+			t.Errorf("unexpected serve error: %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("server didn't stop after shutdown timeout")
+	}
+}
+
+func TestShutdownWhenContextOptionIsDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	srv := startTestGRPCServer(t, func(*grpc.Server) {}, WithContext(ctx))
+	client := healthpb.NewHealthClient(srv.conn)
+	waitForStatus(t, client, "", healthpb.HealthCheckResponse_SERVING)
+
+	cancel()
+	select {
+	case err := <-srv.done:
+		if err != nil {
+			t.Errorf("expected graceful shutdown, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("server didn't stop after the context was done")
+	}
+}
+
+func TestShutdownDelayKeepsAcceptingRequestsWhileNotServing(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	close(release)
+	desc := slowServiceDesc(started, release)
+	srv := startTestGRPCServer(t, func(s *grpc.Server) {
+		s.RegisterService(&desc, nil)
+	}, WithShutdownDelay(500*time.Millisecond))
+	client := healthpb.NewHealthClient(srv.conn)
+	waitForStatus(t, client, "", healthpb.HealthCheckResponse_SERVING)
+
+	srv.cancel()
+	waitForStatus(t, client, "", healthpb.HealthCheckResponse_NOT_SERVING)
+
+	// 探针看到 NOT_SERVING 后端口仍然打开，摘除实例前到达的请求正常处理
+	if err := srv.conn.Invoke(context.Background(), slowMethod, &emptypb.Empty{}, &emptypb.Empty{}); err != nil {
+		t.Errorf("expected requests to be accepted during the shutdown delay, got %v", err)
+	}
+	select {
+	case err := <-srv.done:
+		t.Fatalf("server stopped before the shutdown delay passed: %v", err)
+	default:
+	}
+
+	select {
+	case err := <-srv.done:
+		if err != nil {
+			t.Errorf("unexpected serve error: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("server didn't stop after the shutdown delay")
+	}
+}
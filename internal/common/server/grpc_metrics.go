@@ -0,0 +1,37 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"newTiktoken/internal/common/decorator"
+)
+
+// MetricsUnaryServerInterceptor 按 gRPC 方法记录耗时和返回的状态码
+func MetricsUnaryServerInterceptor(client decorator.MetricsClient) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		observeGRPCCall(client, info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+func MetricsStreamServerInterceptor(client decorator.MetricsClient) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		observeGRPCCall(client, info.FullMethod, start, err)
+		return err
+	}
+}
+
+func observeGRPCCall(client decorator.MetricsClient, method string, start time.Time, err error) {
+	client.ObserveDuration("grpc_server_handling_seconds", map[string]string{"method": method}, time.Since(start))
+	client.Inc("grpc_server_handled_total", map[string]string{
+		"method": method,
+		"code":   status.Code(err).String(),
+	}, 1)
+}
@@ -0,0 +1,58 @@
+package server
+
+import (
+	"context"
+	"slices"
+	"sync/atomic"
+
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"newTiktoken/internal/common/auth"
+	"newTiktoken/internal/common/config"
+)
+
+// rateLimiter 在配置变化时整体替换内部的 limiter，新的限流从满 burst 开始
+type rateLimiter struct {
+	limiter atomic.Pointer[rate.Limiter]
+}
+
+// newRateLimiter 创建不限流的 rateLimiter，之后由 set 按配置调整
+func newRateLimiter() *rateLimiter {
+	limiter := &rateLimiter{}
+	limiter.set(config.RateLimitConfig{})
+	return limiter
+}
+
+// set 按配置替换 limiter，RequestsPerSecond 为 0 时不限流
+func (l *rateLimiter) set(cfg config.RateLimitConfig) {
+	if cfg.RequestsPerSecond == 0 {
+		l.limiter.Store(rate.NewLimiter(rate.Inf, 0))
+		return
+	}
+	l.limiter.Store(rate.NewLimiter(rate.Limit(cfg.RequestsPerSecond), cfg.Burst))
+}
+
+func (l *rateLimiter) allow(method string) bool {
+	return slices.Contains(auth.PublicMethods, method) || l.limiter.Load().Allow()
+}
+
+// RateLimitUnaryServerInterceptor 超过限流时返回 ResourceExhausted，健康检查和反射不受限流影响
+func RateLimitUnaryServerInterceptor(limiter *rateLimiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !limiter.allow(info.FullMethod) {
+			return nil, status.Error(codes.ResourceExhausted, "rate limit exceeded")
+		}
+		return handler(ctx, req)
+	}
+}
+
+func RateLimitStreamServerInterceptor(limiter *rateLimiter) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !limiter.allow(info.FullMethod) {
+			return status.Error(codes.ResourceExhausted, "rate limit exceeded")
+		}
+		return handler(srv, ss)
+	}
+}
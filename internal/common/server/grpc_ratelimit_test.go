@@ -0,0 +1,48 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"newTiktoken/internal/common/config"
+)
+
+func TestRateLimitUnaryServerInterceptor(t *testing.T) {
+	limiter := newRateLimiter()
+	interceptor := RateLimitUnaryServerInterceptor(limiter)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	call := func(method string) error {
+		_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+	const method = "/user_v1.UserService/GetUserInformation"
+	healthMethod := "/" + healthpb.Health_ServiceDesc.ServiceName + "/Check"
+
+	for i := 0; i < 10; i++ {
+		if err := call(method); err != nil {
+			t.Fatalf("expected no limit by default, got %v", err)
+		}
+	}
+
+	limiter.set(config.RateLimitConfig{RequestsPerSecond: 0.001, Burst: 1})
+	if err := call(method); err != nil {
+		t.Fatalf("expected burst to allow the first call, got %v", err)
+	}
+	if err := call(method); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("expected ResourceExhausted, got %v", err)
+	}
+	if err := call(healthMethod); err != nil {
+		t.Errorf("health checks shouldn't be rate limited, got %v", err)
+	}
+
+	limiter.set(config.RateLimitConfig{})
+	if err := call(method); err != nil {
+		t.Errorf("expected no limit after disabling, got %v", err)
+	}
+}
@@ -0,0 +1,30 @@
+package server
+
+import (
+	"context"
+
+	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
+	"google.golang.org/grpc"
+	"newTiktoken/internal/common/tracing"
+)
+
+// TraceTagsUnaryServerInterceptor 把 otelgrpc 创建的 trace ID 写入 ctxtags，grpc_logrus 会把它带到请求日志里
+func TraceTagsUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		setTraceTag(ctx)
+		return handler(ctx, req)
+	}
+}
+
+func TraceTagsStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		setTraceTag(ss.Context())
+		return handler(srv, ss)
+	}
+}
+
+func setTraceTag(ctx context.Context) {
+	if traceID := tracing.TraceID(ctx); traceID != "" {
+		grpc_ctxtags.Extract(ctx).Set("trace_id", traceID)
+	}
+}
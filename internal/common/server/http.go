@@ -2,49 +2,118 @@ package server
 
 import (
 	"context"
+	"net"
 	"net/http"
 	"os"
+	"os/signal"
 	"strconv"
 	"strings"
+	"syscall"
+	"time"
 
 	firebase "firebase.google.com/go/v4"
+	firebaseAuth "firebase.google.com/go/v4/auth"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/cors"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/api/option"
 	"newTiktoken/internal/common/auth"
 	"newTiktoken/internal/common/logs"
 )
 
-func RunHTTPServer(createHandler func(router chi.Router) http.Handler) {
-	RunHTTPServerOnAddr(":"+os.Getenv("PORT"), createHandler)
+type httpServerOptions struct {
+	defaultAuth     bool
+	shutdownTimeout time.Duration
 }
 
-func RunHTTPServerOnAddr(addr string, createHandler func(router chi.Router) http.Handler) {
+type HTTPServerOption func(*httpServerOptions)
+
+// WithoutDefaultAuth 不使用根据 MOCK_AUTH 选择的认证中间件，由 createHandler 中的路由自行认证
+func WithoutDefaultAuth() HTTPServerOption {
+	return func(options *httpServerOptions) {
+		options.defaultAuth = false
+	}
+}
+
+// WithHTTPShutdownTimeout 设置收到退出信号后等待进行中请求完成的最长时间
+func WithHTTPShutdownTimeout(timeout time.Duration) HTTPServerOption {
+	return func(options *httpServerOptions) {
+		options.shutdownTimeout = timeout
+	}
+}
+
+func RunHTTPServer(createHandler func(router chi.Router) http.Handler, opts ...HTTPServerOption) {
+	RunHTTPServerOnAddr(":"+os.Getenv("PORT"), createHandler, opts...)
+}
+
+// RunHTTPServerOnAddr 在收到 SIGINT 或 SIGTERM 后优雅退出并返回，调用方的 defer 清理逻辑可以正常执行
+func RunHTTPServerOnAddr(addr string, createHandler func(router chi.Router) http.Handler, opts ...HTTPServerOption) {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	options := httpServerOptions{defaultAuth: true, shutdownTimeout: 20 * time.Second}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
 	apiRouter := chi.NewRouter()
-	setMiddlewares(apiRouter)
+	setMiddlewares(apiRouter, options)
 
 	rootRouter := chi.NewRouter()
 	// we are mounting all APIs under /api path
 	rootRouter.Mount("/api", createHandler(apiRouter))
 
-	logrus.Info("Starting HTTP server")
-
-	err := http.ListenAndServe(addr, rootRouter)
+	listener, err := net.Listen("tcp", addr)
 	if err != nil {
 		logrus.WithError(err).Panic("Unable to start HTTP server")
 	}
+	logrus.WithField("httpEndpoint", addr).Info("Starting HTTP server")
+	if err := serveHTTP(ctx, listener, rootRouter, options.shutdownTimeout); err != nil {
+		logrus.WithError(err).Panic("HTTP server stopped unexpectedly")
+	}
 }
 
-func setMiddlewares(router *chi.Mux) {
+// serveHTTP 在 listener 上提供服务直到 ctx 结束
+// 退出时停止接受新连接并等待进行中的请求完成，超过 shutdownTimeout 后强制关闭
+func serveHTTP(ctx context.Context, listener net.Listener, handler http.Handler, shutdownTimeout time.Duration) error {
+	httpServer := &http.Server{Handler: handler}
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- httpServer.Serve(listener)
+	}()
+
+	select {
+	case err := <-serveErr:
+		return err
+	case <-ctx.Done():
+	}
+
+	logrus.WithField("timeout", shutdownTimeout).Info("Shutting down HTTP server")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		logrus.WithError(err).Warn("HTTP server didn't drain in time, closing remaining connections")
+		_ = httpServer.Close()
+	}
+	if err := <-serveErr; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+func setMiddlewares(router *chi.Mux, options httpServerOptions) {
 	router.Use(middleware.RequestID)
 	router.Use(middleware.RealIP)
 	router.Use(logs.NewStructuredLogger(logrus.StandardLogger()))
 	router.Use(middleware.Recoverer)
 
 	addCorsMiddleware(router)
-	addAuthMiddleware(router)
+	if options.defaultAuth {
+		addAuthMiddleware(router)
+	}
 
 	router.Use(
 		middleware.SetHeader("X-Content-Type-Options", "nosniff"),
@@ -59,6 +128,10 @@ func addAuthMiddleware(router *chi.Mux) {
 		return
 	}
 
+	router.Use(auth.FirebaseHttpMiddleware{AuthClient: newFirebaseAuthClient()}.Middleware)
+}
+
+func newFirebaseAuthClient() *firebaseAuth.Client {
 	var opts []option.ClientOption
 	if file := os.Getenv("SERVICE_ACCOUNT_FILE"); file != "" {
 		opts = append(opts, option.WithCredentialsFile(file))
@@ -74,8 +147,7 @@ func addAuthMiddleware(router *chi.Mux) {
 	if err != nil {
 		logrus.WithError(err).Fatal("Unable to create firebase Auth client")
 	}
-
-	router.Use(auth.FirebaseHttpMiddleware{AuthClient: authClient}.Middleware)
+	return authClient
 }
 
 func addCorsMiddleware(router *chi.Mux) {
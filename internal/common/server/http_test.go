@@ -0,0 +1,90 @@
+package server
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// startTestHTTPServer 在随机端口上运行 serveHTTP，返回服务地址、触发退出的 cancel 和 serveHTTP 的返回值
+func startTestHTTPServer(t *testing.T, handler http.Handler, shutdownTimeout time.Duration) (string, context.CancelFunc, <-chan error) {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	done := make(chan error, 1)
+	go func() {
+		done <- serveHTTP(ctx, listener, handler, shutdownTimeout)
+	}()
+	return "http://" + listener.Addr().String(), cancel, done
+}
+
+func TestHTTPShutdownDrainsInFlightRequests(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	addr, cancel, done := startTestHTTPServer(t, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		close(started)
+		<-release
+		_, _ = io.WriteString(w, "ok")
+	}), 5*time.Second)
+
+	body := make(chan string, 1)
+	go func() {
+		resp, err := http.Get(addr)
+		if err != nil {
+			body <- err.Error()
+			return
+		}
+		defer resp.Body.Close()
+		b, _ := io.ReadAll(resp.Body)
+		body <- string(b)
+	}()
+	<-started
+
+	cancel()
+	select {
+	case err := <-done:
+		t.Fatalf("server stopped before in-flight request finished: %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	if b := <-body; b != "ok" {
+		t.Errorf("in-flight request failed during shutdown: %s", b)
+	}
+	if err := <-done; err != nil {
+		t.Errorf("unexpected serve error: %v", err)
+	}
+}
+
+func TestHTTPShutdownForcesCloseAfterTimeout(t *testing.T) {
+	started := make(chan struct{})
+	addr, cancel, done := startTestHTTPServer(t, http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-r.Context().Done()
+	}), 100*time.Millisecond)
+
+	go func() {
+		resp, err := http.Get(addr)
+		if err == nil {
+			_ = resp.Body.Close()
+		}
+	}()
+	<-started
+
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("unexpected serve error: %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("server didn't stop after shutdown timeout")
+	}
+}
@@ -1,6 +1,7 @@
 package httperr
 
 import (
+	stderrors "errors"
 	"net/http"
 	"newTiktoken/internal/common/errors"
 	"newTiktoken/internal/common/logs"
@@ -20,18 +21,46 @@ func BadRequest(slug string, err error, w http.ResponseWriter, r *http.Request)
 	httpRespondWithError(err, slug, w, r, "Bad request", http.StatusBadRequest)
 }
 
+func NotFound(slug string, err error, w http.ResponseWriter, r *http.Request) {
+	httpRespondWithError(err, slug, w, r, "Not found", http.StatusNotFound)
+}
+
+func Conflict(slug string, err error, w http.ResponseWriter, r *http.Request) {
+	httpRespondWithError(err, slug, w, r, "Conflict", http.StatusConflict)
+}
+
+func PreconditionFailed(slug string, err error, w http.ResponseWriter, r *http.Request) {
+	httpRespondWithError(err, slug, w, r, "Precondition failed", http.StatusPreconditionFailed)
+}
+
+func TooManyRequests(slug string, err error, w http.ResponseWriter, r *http.Request) {
+	httpRespondWithError(err, slug, w, r, "Too many requests", http.StatusTooManyRequests)
+}
+
+func ServiceUnavailable(slug string, err error, w http.ResponseWriter, r *http.Request) {
+	httpRespondWithError(err, slug, w, r, "Service unavailable", http.StatusServiceUnavailable)
+}
+
 func RespondWithSlugError(err error, w http.ResponseWriter, r *http.Request) {
-	slugError, ok := err.(errors.SlugError)
-	if !ok {
+	var slugError errors.SlugError
+	if !stderrors.As(err, &slugError) {
 		InternalError("internal-server-error", err, w, r)
 		return
 	}
 
 	switch slugError.ErrorType() {
-	case errors.ErrorTypeAuthorization:
+	case errors.ErrorTypeAuthentication, errors.ErrorTypeAuthorization:
 		Unauthorised(slugError.Slug(), slugError, w, r)
 	case errors.ErrorTypeIncorrectInput:
 		BadRequest(slugError.Slug(), slugError, w, r)
+	case errors.ErrorTypeNotFound:
+		NotFound(slugError.Slug(), slugError, w, r)
+	case errors.ErrorTypeConflict:
+		Conflict(slugError.Slug(), slugError, w, r)
+	case errors.ErrorTypePreconditionFailed:
+		PreconditionFailed(slugError.Slug(), slugError, w, r)
+	case errors.ErrorTypeResourceExhausted:
+		TooManyRequests(slugError.Slug(), slugError, w, r)
 	default:
 		InternalError(slugError.Slug(), slugError, w, r)
 	}
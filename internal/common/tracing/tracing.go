@@ -0,0 +1,97 @@
+package tracing
+
+import (
+	"context"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"newTiktoken/internal/common/logs"
+)
+
+const instrumentationName = "newTiktoken"
+
+// InitTracerProvider 初始化全局 TracerProvider 和 W3C trace context 传播
+// 设置了 OTEL_EXPORTER_OTLP_ENDPOINT 时通过 OTLP gRPC 导出（Jaeger 可以直接接收 OTLP），
+// 其余导出配置（如 OTEL_EXPORTER_OTLP_INSECURE）由 exporter 从环境变量读取；
+// 未设置时仍然生成 trace ID 以便在日志中串联请求，但不导出 span
+func InitTracerProvider(ctx context.Context, serviceName string) (shutdown func(context.Context) error, err error) {
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(
+		attribute.String("service.name", serviceName),
+	))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create tracing resource")
+	}
+
+	opts := []sdktrace.TracerProviderOption{sdktrace.WithResource(res)}
+	if os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" {
+		exporter, err := otlptracegrpc.New(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create OTLP trace exporter")
+		}
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	} else {
+		logrus.Info("OTEL_EXPORTER_OTLP_ENDPOINT is empty, spans won't be exported")
+	}
+
+	provider := sdktrace.NewTracerProvider(opts...)
+	otel.SetTracerProvider(provider)
+	logrus.AddHook(logs.TraceHook{})
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+	return provider.Shutdown, nil
+}
+
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// StartDBSpan 为一次 MySQL 操作创建 client span，operation 一般是 "类型.方法"
+func StartDBSpan(ctx context.Context, operation string) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("db.system", "mysql"),
+			attribute.String("db.operation", operation),
+		),
+	)
+}
+
+// StartRedisSpan 为一次 Redis 操作创建 client span，operation 一般是 "类型.方法"
+func StartRedisSpan(ctx context.Context, operation string) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("db.system", "redis"),
+			attribute.String("db.operation", operation),
+		),
+	)
+}
+
+// EndSpan 在 err 不为空时把错误记录到 span 上，然后结束 span
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// TraceID 返回 ctx 中 span 的 trace ID，没有有效 span 时返回空字符串
+func TraceID(ctx context.Context) string {
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.HasTraceID() {
+		return ""
+	}
+	return spanContext.TraceID().String()
+}
@@ -0,0 +1,43 @@
+package users
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// Service 批量获取用户资料，不存在的用户不出现在返回的 map 中
+type Service interface {
+	GetUsersInformation(ctx context.Context, userUUIDs []string) (map[string]User, error)
+}
+
+// Fill 用一次批量调用补全 items 中 user 返回的用户资料，调用前 user 返回的用户只需要填充 UUID
+// 用户服务中不存在的用户只保留 UUID
+func Fill[T any](ctx context.Context, service Service, items []T, user func(item *T) *User) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	userUUIDs := make([]string, 0, len(items))
+	seen := make(map[string]struct{}, len(items))
+	for i := range items {
+		userUUID := user(&items[i]).UUID
+		if _, ok := seen[userUUID]; ok {
+			continue
+		}
+		seen[userUUID] = struct{}{}
+		userUUIDs = append(userUUIDs, userUUID)
+	}
+	found, err := service.GetUsersInformation(ctx, userUUIDs)
+	if err != nil {
+		return errors.Wrap(err, "failed to get users information")
+	}
+
+	for i := range items {
+		u := user(&items[i])
+		if information, ok := found[u.UUID]; ok {
+			*u = information
+		}
+	}
+	return nil
+}
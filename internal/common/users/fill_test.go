@@ -0,0 +1,72 @@
+package users_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"newTiktoken/internal/common/users"
+)
+
+// recordingService 记录每次批量请求的 UUID，只认识 known 中的用户
+type recordingService struct {
+	known    map[string]users.User
+	requests [][]string
+}
+
+func (s *recordingService) GetUsersInformation(_ context.Context, userUUIDs []string) (map[string]users.User, error) {
+	s.requests = append(s.requests, userUUIDs)
+	found := map[string]users.User{}
+	for _, userUUID := range userUUIDs {
+		if usr, ok := s.known[userUUID]; ok {
+			found[userUUID] = usr
+		}
+	}
+	return found, nil
+}
+
+type comment struct {
+	UUID   string
+	Author users.User
+}
+
+func commentAuthor(c *comment) *users.User {
+	return &c.Author
+}
+
+func TestFillRequestsEachUserOnce(t *testing.T) {
+	t.Parallel()
+	service := &recordingService{known: map[string]users.User{
+		"user-a": {UUID: "user-a", Name: "alice"},
+	}}
+	comments := []comment{
+		{UUID: "comment-1", Author: users.User{UUID: "user-a"}},
+		{UUID: "comment-2", Author: users.User{UUID: "user-missing"}},
+		{UUID: "comment-3", Author: users.User{UUID: "user-a"}},
+	}
+
+	if err := users.Fill(context.Background(), service, comments, commentAuthor); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(service.requests) != 1 || !slices.Equal(service.requests[0], []string{"user-a", "user-missing"}) {
+		t.Errorf("expected one request for user-a and user-missing, got %v", service.requests)
+	}
+	if comments[0].Author.Name != "alice" || comments[2].Author.Name != "alice" {
+		t.Errorf("expected authors to be filled, got %+v", comments)
+	}
+	if comments[1].Author != (users.User{UUID: "user-missing"}) {
+		t.Errorf("expected missing author to keep only its UUID, got %+v", comments[1].Author)
+	}
+}
+
+func TestFillWithoutItems(t *testing.T) {
+	t.Parallel()
+	service := &recordingService{}
+	if err := users.Fill(context.Background(), service, []comment{}, commentAuthor); err != nil {
+		t.Fatal(err)
+	}
+	if len(service.requests) != 0 {
+		t.Errorf("expected no request for empty items, got %v", service.requests)
+	}
+}
@@ -0,0 +1,83 @@
+package users
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	userpb "newTiktoken/internal/common/genproto/user"
+)
+
+// UserGrpc 通过用户服务的 gRPC 接口批量获取用户资料
+type UserGrpc struct {
+	client userpb.UserServiceClient
+}
+
+func NewUserGrpc(client userpb.UserServiceClient) UserGrpc {
+	return UserGrpc{client: client}
+}
+
+// GetUsersInformation 通过一次批量调用获取用户信息，不存在的用户不出现在结果中
+func (s UserGrpc) GetUsersInformation(ctx context.Context, userUUIDs []string) (map[string]User, error) {
+	if len(userUUIDs) == 0 {
+		return map[string]User{}, nil
+	}
+	resp, err := s.client.BatchGetUserInformation(ctx, &userpb.BatchGetUserInformationRequest{Uuids: userUUIDs})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to batch get information of users")
+	}
+	users := make(map[string]User, len(resp.GetUsers()))
+	for _, user := range resp.GetUsers() {
+		users[user.GetUuid()] = FromProto(user)
+	}
+	return users, nil
+}
+
+func FromProto(user *userpb.User) User {
+	return User{
+		UUID:           user.GetUuid(),
+		Name:           user.GetName(),
+		Age:            uint16(user.GetAge()),
+		Gender:         uint16(user.GetGender()),
+		FollowingCount: user.GetFollowingCount(),
+		FollowerCount:  user.GetFollowerCount(),
+		TotalFavorite:  user.GetTotalFavorite(),
+		WorkCount:      user.GetWorkCount(),
+		FavoriteCount:  user.GetFavoriteCount(),
+		CreatedAt:      timestampToTime(user.GetCreatedAt()),
+		UpdatedAt:      timestampToTime(user.GetUpdatedAt()),
+	}
+}
+
+func ToProto(user User) *userpb.User {
+	return &userpb.User{
+		Uuid:           user.UUID,
+		Name:           user.Name,
+		Age:            uint32(user.Age),
+		Gender:         uint32(user.Gender),
+		FollowingCount: user.FollowingCount,
+		FollowerCount:  user.FollowerCount,
+		TotalFavorite:  user.TotalFavorite,
+		WorkCount:      user.WorkCount,
+		FavoriteCount:  user.FavoriteCount,
+		CreatedAt:      timestamppb.New(user.CreatedAt),
+		UpdatedAt:      timestamppb.New(user.UpdatedAt),
+	}
+}
+
+// ToProtoUsers 保持 users 的顺序转换为 protobuf 用户列表
+func ToProtoUsers(users []User) []*userpb.User {
+	pbUsers := make([]*userpb.User, 0, len(users))
+	for _, user := range users {
+		pbUsers = append(pbUsers, ToProto(user))
+	}
+	return pbUsers
+}
+
+func timestampToTime(timestamp *timestamppb.Timestamp) time.Time {
+	if timestamp == nil {
+		return time.Time{}
+	}
+	return timestamp.AsTime()
+}
@@ -0,0 +1,39 @@
+package users_test
+
+import (
+	"testing"
+	"time"
+
+	userpb "newTiktoken/internal/common/genproto/user"
+	"newTiktoken/internal/common/users"
+)
+
+func TestProtoRoundTrip(t *testing.T) {
+	t.Parallel()
+	expected := users.User{
+		UUID:           "user-1",
+		Name:           "alice",
+		Age:            20,
+		Gender:         1,
+		FollowingCount: 2,
+		FollowerCount:  3,
+		TotalFavorite:  4,
+		WorkCount:      5,
+		FavoriteCount:  6,
+		CreatedAt:      time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC),
+		UpdatedAt:      time.Date(2024, 5, 2, 8, 0, 0, 0, time.UTC),
+	}
+
+	if found := users.FromProto(users.ToProto(expected)); found != expected {
+		t.Errorf("expected %+v, got %+v", expected, found)
+	}
+}
+
+// 用户服务没有返回时间时保留零值，而不是 1970 年的时间
+func TestFromProtoWithoutTimestamps(t *testing.T) {
+	t.Parallel()
+	found := users.FromProto(&userpb.User{Uuid: "user-1"})
+	if !found.CreatedAt.IsZero() || !found.UpdatedAt.IsZero() {
+		t.Errorf("expected zero times, got %+v", found)
+	}
+}
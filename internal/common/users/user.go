@@ -0,0 +1,18 @@
+package users
+
+import "time"
+
+// User 是用户服务返回的用户资料，其他服务在列表中展示作者、关注者等信息时使用
+type User struct {
+	UUID           string
+	Name           string
+	Age            uint16
+	Gender         uint16
+	FollowingCount uint64
+	FollowerCount  uint64
+	TotalFavorite  uint64
+	WorkCount      uint64
+	FavoriteCount  uint64
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
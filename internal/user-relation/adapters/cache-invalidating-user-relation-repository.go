@@ -0,0 +1,69 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/cache"
+	userRelationDomain "newTiktoken/internal/user-relation/domain"
+)
+
+// CacheInvalidatingUserRelationRepository 在关系修改提交后删除双方的用户信息缓存，
+// 因为关系仓库在同一事务中修改了 users 表中的关注数和粉丝数
+// 删除失败只记录日志，此时修改已经提交，缓存最多在 TTL 后过期
+type CacheInvalidatingUserRelationRepository struct {
+	userRelationDomain.Repository
+	invalidator cache.UserInformationInvalidator
+	logger      *logrus.Entry
+}
+
+func NewCacheInvalidatingUserRelationRepository(
+	repository userRelationDomain.Repository,
+	invalidator cache.UserInformationInvalidator,
+	logger *logrus.Entry,
+) CacheInvalidatingUserRelationRepository {
+	if repository == nil {
+		panic("nil repository")
+	}
+	if logger == nil {
+		panic("nil logger")
+	}
+	return CacheInvalidatingUserRelationRepository{Repository: repository, invalidator: invalidator, logger: logger}
+}
+
+func (r CacheInvalidatingUserRelationRepository) AddRelation(ctx context.Context, ActivePartyUUID, PassivePartyUUID string) error {
+	if err := r.Repository.AddRelation(ctx, ActivePartyUUID, PassivePartyUUID); err != nil {
+		return err
+	}
+	r.invalidate(ctx, ActivePartyUUID, PassivePartyUUID)
+	return nil
+}
+
+func (r CacheInvalidatingUserRelationRepository) UpdateRelation(ctx context.Context, ActivePartyUUID, PassivePartyUUID string, updateFn func(
+	ctx context.Context,
+	userRelation *userRelationDomain.UserRelation,
+) (*userRelationDomain.UserRelation, error)) error {
+	if err := r.Repository.UpdateRelation(ctx, ActivePartyUUID, PassivePartyUUID, updateFn); err != nil {
+		return err
+	}
+	r.invalidate(ctx, ActivePartyUUID, PassivePartyUUID)
+	return nil
+}
+
+func (r CacheInvalidatingUserRelationRepository) UpdateRelationPair(ctx context.Context, ActivePartyUUID, PassivePartyUUID string, updateFn func(
+	ctx context.Context,
+	userRelation *userRelationDomain.UserRelation,
+	reverseUserRelation *userRelationDomain.UserRelation,
+) error) error {
+	if err := r.Repository.UpdateRelationPair(ctx, ActivePartyUUID, PassivePartyUUID, updateFn); err != nil {
+		return err
+	}
+	r.invalidate(ctx, ActivePartyUUID, PassivePartyUUID)
+	return nil
+}
+
+func (r CacheInvalidatingUserRelationRepository) invalidate(ctx context.Context, userUUIDs ...string) {
+	if err := r.invalidator.InvalidateUserInformation(ctx, userUUIDs...); err != nil {
+		r.logger.WithError(err).WithField("user_uuids", userUUIDs).Error("Unable to invalidate user information cache")
+	}
+}
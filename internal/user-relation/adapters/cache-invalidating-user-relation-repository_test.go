@@ -0,0 +1,95 @@
+package adapters_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/cache"
+	"newTiktoken/internal/user-relation/adapters"
+	userRelationDomain "newTiktoken/internal/user-relation/domain"
+)
+
+func TestCacheInvalidatingUserRelationRepository(t *testing.T) {
+	redisServer := miniredis.RunT(t)
+	redisClient := redis.NewClient(&redis.Options{Addr: redisServer.Addr()})
+	t.Cleanup(func() { _ = redisClient.Close() })
+	repository := adapters.NewCacheInvalidatingUserRelationRepository(
+		adapters.NewMemoryUserRelationRepository(),
+		cache.NewUserInformationInvalidator(redisClient),
+		logrus.NewEntry(logrus.StandardLogger()),
+	)
+	ctx := context.Background()
+	errUpdateFailed := errors.New("update failed")
+
+	follow := func(ctx context.Context, relation, reverse *userRelationDomain.UserRelation) error {
+		return relation.Follow()
+	}
+	testCases := []struct {
+		Name              string
+		Write             func(active, passive string) error
+		ExpectInvalidated bool
+	}{
+		{
+			Name: "add_relation",
+			Write: func(active, passive string) error {
+				return repository.AddRelation(ctx, active, passive)
+			},
+			ExpectInvalidated: true,
+		},
+		{
+			Name: "update_relation",
+			Write: func(active, passive string) error {
+				return repository.UpdateRelation(ctx, active, passive, func(ctx context.Context, relation *userRelationDomain.UserRelation) (*userRelationDomain.UserRelation, error) {
+					return relation, relation.Block()
+				})
+			},
+			ExpectInvalidated: true,
+		},
+		{
+			Name: "update_relation_pair",
+			Write: func(active, passive string) error {
+				return repository.UpdateRelationPair(ctx, active, passive, follow)
+			},
+			ExpectInvalidated: true,
+		},
+		{
+			Name: "failed_update_keeps_cache",
+			Write: func(active, passive string) error {
+				err := repository.UpdateRelationPair(ctx, active, passive, func(ctx context.Context, relation, reverse *userRelationDomain.UserRelation) error {
+					return errUpdateFailed
+				})
+				if !errors.Is(err, errUpdateFailed) {
+					t.Errorf("expected update error, got %v", err)
+				}
+				return nil
+			},
+			ExpectInvalidated: false,
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.Name, func(t *testing.T) {
+			active, passive := uuid.NewString(), uuid.NewString()
+			for _, userUUID := range []string{active, passive} {
+				if err := redisServer.Set(cache.UserInformationKey(userUUID), "{}"); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if err := c.Write(active, passive); err != nil {
+				t.Fatal(err)
+			}
+
+			for _, userUUID := range []string{active, passive} {
+				if cached := redisServer.Exists(cache.UserInformationKey(userUUID)); cached == c.ExpectInvalidated {
+					t.Errorf("user %s: expected invalidated=%v", userUUID, c.ExpectInvalidated)
+				}
+			}
+		})
+	}
+}
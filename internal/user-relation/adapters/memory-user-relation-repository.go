@@ -0,0 +1,111 @@
+package adapters
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	userRelationDomain "newTiktoken/internal/user-relation/domain"
+	"sync"
+)
+
+type relationKey struct {
+	activePartyUUID  string
+	passivePartyUUID string
+}
+
+// MemoryUserRelationRepository 是线程安全的内存关系仓库，用于测试和本地运行
+// 与 MySQL 实现不同，它不维护用户的关注数，也不写入 outbox 事件
+type MemoryUserRelationRepository struct {
+	lock      *sync.RWMutex
+	relations map[relationKey]userRelationDomain.UserRelation
+}
+
+func NewMemoryUserRelationRepository() *MemoryUserRelationRepository {
+	return &MemoryUserRelationRepository{
+		lock:      &sync.RWMutex{},
+		relations: map[relationKey]userRelationDomain.UserRelation{},
+	}
+}
+
+func (m MemoryUserRelationRepository) GetRelation(_ context.Context, ActivePartyUUID, PassivePartyUUID string) (*userRelationDomain.UserRelation, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	relation, ok := m.relations[relationKey{ActivePartyUUID, PassivePartyUUID}]
+	if !ok {
+		return nil, nil
+	}
+	return &relation, nil
+}
+
+func (m MemoryUserRelationRepository) AddRelation(ctx context.Context, ActivePartyUUID, PassivePartyUUID string) error {
+	return m.UpdateRelation(ctx, ActivePartyUUID, PassivePartyUUID, func(
+		ctx context.Context,
+		userRelation *userRelationDomain.UserRelation,
+	) (*userRelationDomain.UserRelation, error) {
+		if err := userRelation.Follow(); err != nil {
+			return nil, err
+		}
+		return userRelation, nil
+	})
+}
+
+func (m MemoryUserRelationRepository) UpdateRelation(
+	ctx context.Context,
+	ActivePartyUUID string,
+	PassivePartyUUID string,
+	updateFn func(ctx context.Context, userRelation *userRelationDomain.UserRelation) (*userRelationDomain.UserRelation, error)) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	relation, err := m.findRelation(ActivePartyUUID, PassivePartyUUID)
+	if err != nil {
+		return err
+	}
+	updatedRelation, err := updateFn(ctx, relation)
+	if err != nil {
+		return errors.Wrap(err, "failed to update user relation")
+	}
+	m.relations[relationKey{ActivePartyUUID, PassivePartyUUID}] = *updatedRelation
+	return nil
+}
+
+// UpdateRelationPair 在同一把写锁内更新两个方向的关系，与 MySQL 实现一样只保存状态发生变化的关系
+func (m MemoryUserRelationRepository) UpdateRelationPair(
+	ctx context.Context,
+	ActivePartyUUID string,
+	PassivePartyUUID string,
+	updateFn func(ctx context.Context, userRelation *userRelationDomain.UserRelation, reverseUserRelation *userRelationDomain.UserRelation) error) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	relation, err := m.findRelation(ActivePartyUUID, PassivePartyUUID)
+	if err != nil {
+		return err
+	}
+	reverse, err := m.findRelation(PassivePartyUUID, ActivePartyUUID)
+	if err != nil {
+		return err
+	}
+	previousRelation, previousReverse := *relation, *reverse
+
+	if err := updateFn(ctx, relation, reverse); err != nil {
+		return errors.Wrap(err, "failed to update user relation")
+	}
+
+	if relation.Status != previousRelation.Status {
+		m.relations[relationKey{ActivePartyUUID, PassivePartyUUID}] = *relation
+	}
+	if reverse.Status != previousReverse.Status {
+		m.relations[relationKey{PassivePartyUUID, ActivePartyUUID}] = *reverse
+	}
+	return nil
+}
+
+// findRelation 返回关系的副本，不存在时返回 Unfollow 状态的新关系，调用方需持有锁
+func (m MemoryUserRelationRepository) findRelation(ActivePartyUUID, PassivePartyUUID string) (*userRelationDomain.UserRelation, error) {
+	relation, ok := m.relations[relationKey{ActivePartyUUID, PassivePartyUUID}]
+	if !ok {
+		return userRelationDomain.NewUserRelation(ActivePartyUUID, PassivePartyUUID, userRelationDomain.Unfollow)
+	}
+	return &relation, nil
+}
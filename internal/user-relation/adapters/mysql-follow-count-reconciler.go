@@ -0,0 +1,97 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"github.com/pkg/errors"
+	"newTiktoken/internal/common/tracing"
+	userRelationDomain "newTiktoken/internal/user-relation/domain"
+)
+
+const reconcileBatchSize = 1000
+
+type MySQLFollowCountReconciler struct {
+	db *sql.DB
+}
+
+func NewMySQLFollowCountReconciler(db *sql.DB) (*MySQLFollowCountReconciler, error) {
+	return &MySQLFollowCountReconciler{
+		db: db,
+	}, nil
+}
+
+// ReconcileFollowCounts 按 user_uuid 分批用 user_relations 重新计算 users 表中的关注数和粉丝数，返回被修正的用户数
+func (m MySQLFollowCountReconciler) ReconcileFollowCounts(ctx context.Context) (_ int64, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "MySQLFollowCountReconciler.ReconcileFollowCounts")
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	var fixed int64
+	lastUserUUID := ""
+	for {
+		firstUserUUID, batchLastUserUUID, err := m.nextBatch(ctx, lastUserUUID)
+		if err != nil {
+			return fixed, err
+		}
+		if batchLastUserUUID == "" {
+			return fixed, nil
+		}
+
+		affected, err := m.reconcileRange(ctx, firstUserUUID, batchLastUserUUID)
+		if err != nil {
+			return fixed, err
+		}
+		fixed += affected
+		lastUserUUID = batchLastUserUUID
+	}
+}
+
+// nextBatch 返回 afterUserUUID 之后一批用户的 UUID 范围，没有更多用户时返回空字符串
+func (m MySQLFollowCountReconciler) nextBatch(ctx context.Context, afterUserUUID string) (string, string, error) {
+	const selectQuery = `
+        SELECT MIN(user_uuid), MAX(user_uuid)
+        FROM (
+            SELECT user_uuid FROM users
+            WHERE user_uuid > ?
+            ORDER BY user_uuid
+            LIMIT ?
+        ) batch`
+	var first, last sql.NullString
+	if err := m.db.QueryRowContext(ctx, selectQuery, afterUserUUID, reconcileBatchSize).Scan(&first, &last); err != nil {
+		return "", "", errors.Wrap(err, "failed to select user batch for reconciliation")
+	}
+	return first.String, last.String, nil
+}
+
+func (m MySQLFollowCountReconciler) reconcileRange(ctx context.Context, firstUserUUID, lastUserUUID string) (int64, error) {
+	const updateQuery = `
+        UPDATE users u
+        LEFT JOIN (
+            SELECT active_party_uuid AS user_uuid, COUNT(*) AS relation_count
+            FROM user_relations
+            WHERE status = ? AND active_party_uuid BETWEEN ? AND ?
+            GROUP BY active_party_uuid
+        ) following ON following.user_uuid = u.user_uuid
+        LEFT JOIN (
+            SELECT passive_party_uuid AS user_uuid, COUNT(*) AS relation_count
+            FROM user_relations
+            WHERE status = ? AND passive_party_uuid BETWEEN ? AND ?
+            GROUP BY passive_party_uuid
+        ) followers ON followers.user_uuid = u.user_uuid
+        SET u.following_count = COALESCE(following.relation_count, 0),
+            u.follower_count = COALESCE(followers.relation_count, 0)
+        WHERE u.user_uuid BETWEEN ? AND ?
+            AND (u.following_count <> COALESCE(following.relation_count, 0)
+                OR u.follower_count <> COALESCE(followers.relation_count, 0))`
+	follow := userRelationDomain.Follow.Int()
+	result, err := m.db.ExecContext(ctx, updateQuery,
+		follow, firstUserUUID, lastUserUUID,
+		follow, firstUserUUID, lastUserUUID,
+		firstUserUUID, lastUserUUID,
+	)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to reconcile follow counts between %s and %s", firstUserUUID, lastUserUUID)
+	}
+	return result.RowsAffected()
+}
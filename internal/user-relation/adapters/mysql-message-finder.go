@@ -0,0 +1,78 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/pkg/errors"
+	"newTiktoken/internal/common/tracing"
+	"newTiktoken/internal/user-relation/app/query"
+)
+
+type MySQLMessageFinder struct {
+	db *sql.DB
+}
+
+func NewMySQLMessageFinder(db *sql.DB) (*MySQLMessageFinder, error) {
+	return &MySQLMessageFinder{
+		db: db,
+	}, nil
+}
+
+// FindLatestMessages 用窗口函数在一次查询中取出 userUUID 与每个好友之间的最新消息
+func (m MySQLMessageFinder) FindLatestMessages(ctx context.Context, userUUID string, peerUUIDs []string) (_ map[string]query.MessagePreview, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "MySQLMessageFinder.FindLatestMessages")
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	messages := make(map[string]query.MessagePreview, len(peerUUIDs))
+	if len(peerUUIDs) == 0 {
+		return messages, nil
+	}
+
+	selectQuery := fmt.Sprintf(`
+        SELECT peer_uuid, from_user_uuid, to_user_uuid, content, created_at
+        FROM (
+            SELECT
+                IF(from_user_uuid = ?, to_user_uuid, from_user_uuid) AS peer_uuid,
+                from_user_uuid, to_user_uuid, content, created_at,
+                ROW_NUMBER() OVER (
+                    PARTITION BY IF(from_user_uuid = ?, to_user_uuid, from_user_uuid)
+                    ORDER BY created_at DESC, id DESC
+                ) AS row_num
+            FROM messages
+            WHERE (from_user_uuid = ? AND to_user_uuid IN (%[1]s))
+               OR (to_user_uuid = ? AND from_user_uuid IN (%[1]s))
+        ) latest
+        WHERE row_num = 1`, placeholders(len(peerUUIDs)))
+
+	args := make([]any, 0, 4+2*len(peerUUIDs))
+	args = append(args, userUUID, userUUID, userUUID)
+	for _, peerUUID := range peerUUIDs {
+		args = append(args, peerUUID)
+	}
+	args = append(args, userUUID)
+	for _, peerUUID := range peerUUIDs {
+		args = append(args, peerUUID)
+	}
+
+	rows, err := m.db.QueryContext(ctx, selectQuery, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query latest messages of %s", userUUID)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var peerUUID string
+		var message query.MessagePreview
+		if err := rows.Scan(&peerUUID, &message.FromUserUUID, &message.ToUserUUID, &message.Content, &message.CreatedAt); err != nil {
+			return nil, errors.Wrap(err, "failed to scan latest message")
+		}
+		messages[peerUUID] = message
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate latest messages")
+	}
+	return messages, nil
+}
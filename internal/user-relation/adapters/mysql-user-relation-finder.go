@@ -0,0 +1,150 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"newTiktoken/internal/common/pagination"
+	"newTiktoken/internal/common/tracing"
+	"newTiktoken/internal/user-relation/app/query"
+	userRelationDomain "newTiktoken/internal/user-relation/domain"
+)
+
+type MySQLRelationFinder struct {
+	db *sql.DB
+}
+
+func NewMySQLRelationFinder(db *sql.DB) (*MySQLRelationFinder, error) {
+	return &MySQLRelationFinder{
+		db: db,
+	}, nil
+}
+
+// FindFollowing 查询 userUUID 关注的用户
+func (m MySQLRelationFinder) FindFollowing(ctx context.Context, userUUID string, after pagination.Cursor, limit int) (_ []query.RelationEntry, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "MySQLRelationFinder.FindFollowing")
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	return m.findRelations(ctx, "active_party_uuid", "passive_party_uuid", userRelationDomain.Follow, true, userUUID, after, limit)
+}
+
+// FindFollowers 查询关注 userUUID 的用户
+func (m MySQLRelationFinder) FindFollowers(ctx context.Context, userUUID string, after pagination.Cursor, limit int) (_ []query.RelationEntry, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "MySQLRelationFinder.FindFollowers")
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	return m.findRelations(ctx, "passive_party_uuid", "active_party_uuid", userRelationDomain.Follow, true, userUUID, after, limit)
+}
+
+// FindBlocked 查询 userUUID 拉黑的用户
+func (m MySQLRelationFinder) FindBlocked(ctx context.Context, userUUID string, after pagination.Cursor, limit int) (_ []query.RelationEntry, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "MySQLRelationFinder.FindBlocked")
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	return m.findRelations(ctx, "active_party_uuid", "passive_party_uuid", userRelationDomain.Block, false, userUUID, after, limit)
+}
+
+// findRelations 使用 (updated_at, 对方uuid) 做键集分页，避免 OFFSET 在大粉丝量下的全表扫描
+// excludeBlocked 为 true 时排除反向关系为拉黑的记录
+func (m MySQLRelationFinder) findRelations(
+	ctx context.Context,
+	ownerColumn string,
+	peerColumn string,
+	status userRelationDomain.RelationActionType,
+	excludeBlocked bool,
+	userUUID string,
+	after pagination.Cursor,
+	limit int,
+) ([]query.RelationEntry, error) {
+	selectQuery := fmt.Sprintf(`
+        SELECT relation.%[2]s, relation.updated_at
+        FROM user_relations relation
+        WHERE relation.%[1]s = ? AND relation.status = ?`, ownerColumn, peerColumn)
+	args := []any{userUUID, status.Int()}
+	if excludeBlocked {
+		selectQuery += `
+            AND NOT EXISTS (
+                SELECT 1 FROM user_relations reverse_relation
+                WHERE reverse_relation.active_party_uuid = relation.passive_party_uuid
+                    AND reverse_relation.passive_party_uuid = relation.active_party_uuid
+                    AND reverse_relation.status = ?
+            )`
+		args = append(args, userRelationDomain.Block.Int())
+	}
+	if !after.IsZero() {
+		selectQuery += fmt.Sprintf(` AND (relation.updated_at < ? OR (relation.updated_at = ? AND relation.%s < ?))`, peerColumn)
+		args = append(args, after.Time, after.Time, after.UUID)
+	}
+	selectQuery += fmt.Sprintf(` ORDER BY relation.updated_at DESC, relation.%s DESC LIMIT ?`, peerColumn)
+	args = append(args, limit)
+
+	rows, err := m.db.QueryContext(ctx, selectQuery, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query relations of %s", userUUID)
+	}
+	return scanRelationEntries(rows)
+}
+
+// FindFriends 查询与 userUUID 互相关注的用户，双向均为 Follow 即意味着双方都没有拉黑对方
+// 以 (active_party_uuid, passive_party_uuid) 唯一索引做范围扫描，再用反向关系做等值连接，按好友 UUID 分页
+func (m MySQLRelationFinder) FindFriends(ctx context.Context, userUUID string, after pagination.Cursor, limit int) (_ []query.RelationEntry, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "MySQLRelationFinder.FindFriends")
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	selectQuery := `
+        SELECT forward.passive_party_uuid, GREATEST(forward.updated_at, backward.updated_at)
+        FROM user_relations forward
+        JOIN user_relations backward
+            ON backward.active_party_uuid = forward.passive_party_uuid
+            AND backward.passive_party_uuid = forward.active_party_uuid
+        WHERE forward.active_party_uuid = ? AND forward.status = ? AND backward.status = ?`
+	args := []any{userUUID, userRelationDomain.Follow.Int(), userRelationDomain.Follow.Int()}
+	if !after.IsZero() {
+		selectQuery += ` AND forward.passive_party_uuid > ?`
+		args = append(args, after.UUID)
+	}
+	selectQuery += ` ORDER BY forward.passive_party_uuid LIMIT ?`
+	args = append(args, limit)
+
+	rows, err := m.db.QueryContext(ctx, selectQuery, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query friends of %s", userUUID)
+	}
+	return scanRelationEntries(rows)
+}
+
+func scanRelationEntries(rows *sql.Rows) ([]query.RelationEntry, error) {
+	defer rows.Close()
+
+	var entries []query.RelationEntry
+	for rows.Next() {
+		var entry query.RelationEntry
+		if err := rows.Scan(&entry.UserUUID, &entry.UpdatedAt); err != nil {
+			return nil, errors.Wrap(err, "failed to scan relation")
+		}
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate relations")
+	}
+	return entries, nil
+}
+
+// placeholders 生成 IN 子句使用的 n 个占位符
+func placeholders(n int) string {
+	if n <= 0 {
+		return ""
+	}
+	return strings.Repeat("?, ", n-1) + "?"
+}
@@ -3,7 +3,10 @@ package adapters
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"github.com/pkg/errors"
+	"newTiktoken/internal/common/events"
+	"newTiktoken/internal/common/tracing"
 	userRelationDomain "newTiktoken/internal/user-relation/domain"
 	"time"
 )
@@ -21,17 +24,88 @@ type MySQLUserRelationRepository struct {
 	db *sql.DB
 }
 
-func NewMySQLUserRepository(db *sql.DB) (userRelationDomain.Repository, error) {
+func NewMySQLUserRelationRepository(db *sql.DB) (userRelationDomain.Repository, error) {
 	return &MySQLUserRelationRepository{
 		db: db,
 	}, nil
 }
 
+// UpdateRelation 更新用户关系，关系不存在时以 Unfollow 状态新建后再交给 updateFn
 func (m MySQLUserRelationRepository) UpdateRelation(
 	ctx context.Context,
 	ActivePartyUUID string,
 	PassivePartyUUID string,
-	updateFn func(ctx context.Context, userRelation *userRelationDomain.UserRelation) (*userRelationDomain.UserRelation, error)) error {
+	updateFn func(ctx context.Context, userRelation *userRelationDomain.UserRelation) (*userRelationDomain.UserRelation, error)) (err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "MySQLUserRelationRepository.UpdateRelation")
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	return m.inTransaction(ctx, func(tx *sql.Tx) error {
+		domainUserRelation, exists, err := m.findAndLockRelation(ctx, tx, ActivePartyUUID, PassivePartyUUID)
+		if err != nil {
+			return err
+		}
+
+		previous := *domainUserRelation
+		updatedRelation, err := updateFn(ctx, domainUserRelation)
+		if err != nil {
+			return errors.Wrap(err, "failed to update user relation")
+		}
+		return m.saveRelation(ctx, tx, previous, updatedRelation, exists)
+	})
+}
+
+// UpdateRelationPair 按 UUID 顺序锁定两个方向的关系以避免交叉加锁导致死锁，只保存状态发生变化的关系
+func (m MySQLUserRelationRepository) UpdateRelationPair(
+	ctx context.Context,
+	ActivePartyUUID string,
+	PassivePartyUUID string,
+	updateFn func(ctx context.Context, userRelation *userRelationDomain.UserRelation, reverseUserRelation *userRelationDomain.UserRelation) error) (err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "MySQLUserRelationRepository.UpdateRelationPair")
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	return m.inTransaction(ctx, func(tx *sql.Tx) error {
+		firstActive, firstPassive := ActivePartyUUID, PassivePartyUUID
+		if firstActive > firstPassive {
+			firstActive, firstPassive = firstPassive, firstActive
+		}
+		first, firstExists, err := m.findAndLockRelation(ctx, tx, firstActive, firstPassive)
+		if err != nil {
+			return err
+		}
+		second, secondExists, err := m.findAndLockRelation(ctx, tx, firstPassive, firstActive)
+		if err != nil {
+			return err
+		}
+
+		relation, relationExists, reverse, reverseExists := first, firstExists, second, secondExists
+		if firstActive != ActivePartyUUID {
+			relation, relationExists, reverse, reverseExists = second, secondExists, first, firstExists
+		}
+		previousRelation, previousReverse := *relation, *reverse
+
+		if err := updateFn(ctx, relation, reverse); err != nil {
+			return errors.Wrap(err, "failed to update user relation")
+		}
+
+		if relation.Status != previousRelation.Status {
+			if err := m.saveRelation(ctx, tx, previousRelation, relation, relationExists); err != nil {
+				return err
+			}
+		}
+		if reverse.Status != previousReverse.Status {
+			if err := m.saveRelation(ctx, tx, previousReverse, reverse, reverseExists); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (m MySQLUserRelationRepository) inTransaction(ctx context.Context, fn func(tx *sql.Tx) error) (err error) {
 	tx, err := m.db.BeginTx(ctx, nil)
 	if err != nil {
 		return errors.Wrap(err, "failed to begin transaction")
@@ -47,6 +121,17 @@ func (m MySQLUserRelationRepository) UpdateRelation(
 			err = tx.Commit()
 		}
 	}()
+
+	return fn(tx)
+}
+
+// findAndLockRelation 加锁读取关系，不存在时返回 Unfollow 状态的新关系且 exists 为 false
+func (m MySQLUserRelationRepository) findAndLockRelation(
+	ctx context.Context,
+	tx *sql.Tx,
+	ActivePartyUUID string,
+	PassivePartyUUID string,
+) (relation *userRelationDomain.UserRelation, exists bool, err error) {
 	const findAndLockQuery = `
         SELECT active_party_uuid, passive_party_uuid, status, created_at, updated_at
         FROM user_relations
@@ -63,21 +148,57 @@ func (m MySQLUserRelationRepository) UpdateRelation(
 		&foundRelation.UpdatedAt,
 	)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return errors.Wrapf(err, "user relation between uuid %s and uuid %s not found for update", ActivePartyUUID, PassivePartyUUID)
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, false, errors.Wrap(err, "failed to scan user relation for update")
 		}
-		return errors.Wrap(err, "failed to scan user relation for update")
+		relation, err = userRelationDomain.NewUserRelation(ActivePartyUUID, PassivePartyUUID, userRelationDomain.Unfollow)
+		return relation, false, err
 	}
-	domainUserRelation, err := m.unmarshalUser(&foundRelation)
-	updatedRelation, err := updateFn(ctx, domainUserRelation)
-	if err != nil {
-		return errors.Wrap(err, "failed to update user relation")
+
+	relation, err = m.unmarshalUser(&foundRelation)
+	return relation, true, err
+}
+
+// saveRelation 保存关系，状态发生变化时在同一事务中写入 RelationChanged 事件
+func (m MySQLUserRelationRepository) saveRelation(
+	ctx context.Context,
+	tx *sql.Tx,
+	previous userRelationDomain.UserRelation,
+	relation *userRelationDomain.UserRelation,
+	exists bool,
+) error {
+	if relation.Status != previous.Status {
+		if err := events.StoreInOutbox(ctx, tx, userRelationDomain.NewRelationChanged(previous, relation)); err != nil {
+			return err
+		}
+	}
+	if err := m.adjustFollowCounts(ctx, tx, previous, relation); err != nil {
+		return err
 	}
-	updateQuery := "UPDATE user_relations SET status = ? WHERE active_party_uuid = ? AND passive_party_uuid = ?"
-	_, err = tx.ExecContext(ctx, updateQuery,
-		updatedRelation.Status,
-		updatedRelation.ActivePartyUUID,
-		updatedRelation.PassivePartyUUID,
+
+	if !exists {
+		const insertQuery = `
+        INSERT INTO user_relations (active_party_uuid, passive_party_uuid, status, created_at, updated_at)
+        VALUES (?, ?, ?, ?, ?)`
+		_, err := tx.ExecContext(ctx, insertQuery,
+			relation.ActivePartyUUID,
+			relation.PassivePartyUUID,
+			relation.Status.Int(),
+			relation.CreatedAt.UTC(),
+			relation.UpdatedAt.UTC(),
+		)
+		if err != nil {
+			return errors.Wrap(err, "failed to insert user relation")
+		}
+		return nil
+	}
+
+	updateQuery := "UPDATE user_relations SET status = ?, updated_at = ? WHERE active_party_uuid = ? AND passive_party_uuid = ?"
+	_, err := tx.ExecContext(ctx, updateQuery,
+		relation.Status.Int(),
+		relation.UpdatedAt.UTC(),
+		relation.ActivePartyUUID,
+		relation.PassivePartyUUID,
 	)
 	if err != nil {
 		return errors.Wrap(err, "failed to update user relation")
@@ -85,7 +206,52 @@ func (m MySQLUserRelationRepository) UpdateRelation(
 	return nil
 }
 
-func (m MySQLUserRelationRepository) GetRelation(ctx context.Context, ActivePartyUUID, PassivePartyUUID string) (*userRelationDomain.UserRelation, error) {
+// adjustFollowCounts 在关系进入或离开 Follow 状态时同步更新双方的关注数和粉丝数
+// 按 UUID 顺序更新两行用户记录，避免与其他关系事务交叉加锁
+func (m MySQLUserRelationRepository) adjustFollowCounts(
+	ctx context.Context,
+	tx *sql.Tx,
+	previous userRelationDomain.UserRelation,
+	relation *userRelationDomain.UserRelation,
+) error {
+	wasFollowing := previous.Status == userRelationDomain.Follow
+	isFollowing := relation.Status == userRelationDomain.Follow
+	if wasFollowing == isFollowing {
+		return nil
+	}
+
+	type countUpdate struct {
+		userUUID string
+		column   string
+	}
+	updates := []countUpdate{
+		{userUUID: relation.ActivePartyUUID, column: "following_count"},
+		{userUUID: relation.PassivePartyUUID, column: "follower_count"},
+	}
+	if updates[0].userUUID > updates[1].userUUID {
+		updates[0], updates[1] = updates[1], updates[0]
+	}
+
+	for _, update := range updates {
+		var updateQuery string
+		if isFollowing {
+			updateQuery = fmt.Sprintf("UPDATE users SET %[1]s = %[1]s + 1 WHERE user_uuid = ?", update.column)
+		} else {
+			updateQuery = fmt.Sprintf("UPDATE users SET %[1]s = %[1]s - 1 WHERE user_uuid = ? AND %[1]s > 0", update.column)
+		}
+		if _, err := tx.ExecContext(ctx, updateQuery, update.userUUID); err != nil {
+			return errors.Wrapf(err, "failed to update %s of user %s", update.column, update.userUUID)
+		}
+	}
+	return nil
+}
+
+func (m MySQLUserRelationRepository) GetRelation(ctx context.Context, ActivePartyUUID, PassivePartyUUID string) (_ *userRelationDomain.UserRelation, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "MySQLUserRelationRepository.GetRelation")
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
 	const query = `
         SELECT id, active_party_uuid, passive_party_uuid, status, created_at, updated_at
         FROM user_relations
@@ -94,7 +260,7 @@ func (m MySQLUserRelationRepository) GetRelation(ctx context.Context, ActivePart
 	row := m.db.QueryRowContext(ctx, query, ActivePartyUUID, PassivePartyUUID)
 
 	var relation mysqlUserRelation
-	err := row.Scan(
+	err = row.Scan(
 		&relation.ID,
 		&relation.ActivePartyUUID,
 		&relation.PassivePartyUUID,
@@ -105,7 +271,7 @@ func (m MySQLUserRelationRepository) GetRelation(ctx context.Context, ActivePart
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, errors.Wrap(err, "relation not found")
+			return nil, nil
 		}
 		return nil, errors.Wrap(err, "failed to scan user relation")
 	}
@@ -113,17 +279,22 @@ func (m MySQLUserRelationRepository) GetRelation(ctx context.Context, ActivePart
 	return m.unmarshalUser(&relation)
 }
 
-func (m MySQLUserRelationRepository) AddRelation(ctx context.Context, ActivePartyUUID, PassivePartyUUID string) error {
-	const defaultFollowStatus = 0
-	const query = `
-        INSERT INTO user_relations (active_party_uuid, passive_party_uuid, status)
-        VALUES (?, ?, ?)`
-	_, err := m.db.ExecContext(ctx, query, ActivePartyUUID, PassivePartyUUID, defaultFollowStatus)
-	if err != nil {
-		return errors.Wrap(err, "failed to add relation")
-	}
+// AddRelation 新建关注关系，与 UpdateRelation 一样会写入 RelationChanged 事件
+func (m MySQLUserRelationRepository) AddRelation(ctx context.Context, ActivePartyUUID, PassivePartyUUID string) (err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "MySQLUserRelationRepository.AddRelation")
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
 
-	return nil
+	return m.UpdateRelation(ctx, ActivePartyUUID, PassivePartyUUID, func(
+		ctx context.Context,
+		userRelation *userRelationDomain.UserRelation,
+	) (*userRelationDomain.UserRelation, error) {
+		if err := userRelation.Follow(); err != nil {
+			return nil, err
+		}
+		return userRelation, nil
+	})
 }
 
 func (m MySQLUserRelationRepository) unmarshalUser(relation *mysqlUserRelation) (*userRelationDomain.UserRelation, error) {
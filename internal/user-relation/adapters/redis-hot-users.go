@@ -0,0 +1,111 @@
+package adapters
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+	"newTiktoken/internal/common/tracing"
+	"newTiktoken/internal/user-relation/app/query"
+	"newTiktoken/internal/user-relation/domain"
+)
+
+// 所有 key 使用同一个 hash tag，Redis Cluster 下脚本和 ZUNIONSTORE 涉及的 key 都在同一个 slot
+const (
+	hotUsersBucketKeyPrefix = "{hot_users}:growth:"
+	hotUsersEventKeyPrefix  = "{hot_users}:event:"
+	hotUsersRankingKey      = "{hot_users}:ranking"
+	hotUsersBucketSize      = time.Hour
+	hotUsersRankingTTL      = time.Minute
+)
+
+// recordFollowerGrowthScript 在事件第一次出现时才累加增长，重复投递的事件直接忽略
+// KEYS[1] 是事件 key，KEYS[2] 是桶 key；ARGV 依次为用户 UUID、增长、事件 key 的过期秒数、桶过期的 unix 时间
+var recordFollowerGrowthScript = redis.NewScript(`
+if redis.call('SET', KEYS[1], 1, 'NX', 'EX', ARGV[3]) then
+	redis.call('ZINCRBY', KEYS[2], ARGV[2], ARGV[1])
+	redis.call('EXPIREAT', KEYS[2], ARGV[4])
+	return 1
+end
+return 0
+`)
+
+// RedisHotUsers 按关注建立的时间把粉丝增长累加到每小时一个的 ZSET 中，查询时合并窗口内的桶
+// 取消关注扣减的是被取消的关注所在的桶，所以桶内的增长不会因为窗口外的关注被取消而变成负数
+// 查询只合并完全落在 24 小时窗口内的桶（当前小时和之前 23 小时），统计范围在 23 到 24 小时之间
+type RedisHotUsers struct {
+	client redis.UniversalClient
+}
+
+func NewRedisHotUsers(client redis.UniversalClient) RedisHotUsers {
+	if client == nil {
+		panic("nil redis client")
+	}
+	return RedisHotUsers{client: client}
+}
+
+func (r RedisHotUsers) RecordFollowerGrowth(ctx context.Context, eventUUID string, growth domain.FollowerGrowth) (err error) {
+	ctx, span := tracing.StartRedisSpan(ctx, "RedisHotUsers.RecordFollowerGrowth")
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	bucketStart := growth.FollowedAt.Truncate(hotUsersBucketSize)
+	// 桶在移出窗口后再保留一个桶的时间，事件 key 至少保留到桶过期，保证桶存在期间不会重复累加
+	bucketExpireAt := bucketStart.Add(domain.HotUsersWindow + hotUsersBucketSize)
+	eventTTL := domain.HotUsersWindow + hotUsersBucketSize
+
+	err = recordFollowerGrowthScript.Run(ctx, r.client,
+		[]string{hotUsersEventKeyPrefix + eventUUID, hotUsersBucketKey(bucketStart)},
+		growth.UserUUID,
+		growth.Delta,
+		int64(eventTTL.Seconds()),
+		bucketExpireAt.Unix(),
+	).Err()
+	return errors.Wrapf(err, "failed to record follower growth of event %s", eventUUID)
+}
+
+func (r RedisHotUsers) FindHotUsers(ctx context.Context, limit int) (_ []query.HotUserScore, err error) {
+	ctx, span := tracing.StartRedisSpan(ctx, "RedisHotUsers.FindHotUsers")
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	currentBucket := time.Now().Truncate(hotUsersBucketSize)
+	bucketCount := int(domain.HotUsersWindow / hotUsersBucketSize)
+	keys := make([]string, 0, bucketCount)
+	for i := 0; i < bucketCount; i++ {
+		keys = append(keys, hotUsersBucketKey(currentBucket.Add(-time.Duration(i)*hotUsersBucketSize)))
+	}
+
+	var ranking *redis.ZSliceCmd
+	_, err = r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZUnionStore(ctx, hotUsersRankingKey, &redis.ZStore{Keys: keys})
+		pipe.Expire(ctx, hotUsersRankingKey, hotUsersRankingTTL)
+		ranking = pipe.ZRevRangeByScoreWithScores(ctx, hotUsersRankingKey, &redis.ZRangeBy{
+			Min:   "(0",
+			Max:   "+inf",
+			Count: int64(limit),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query hot users")
+	}
+
+	scores := make([]query.HotUserScore, 0, len(ranking.Val()))
+	for _, z := range ranking.Val() {
+		userUUID, ok := z.Member.(string)
+		if !ok {
+			continue
+		}
+		scores = append(scores, query.HotUserScore{UserUUID: userUUID, FollowerGrowth: int64(z.Score)})
+	}
+	return scores, nil
+}
+
+func hotUsersBucketKey(bucketStart time.Time) string {
+	return hotUsersBucketKeyPrefix + strconv.FormatInt(bucketStart.Unix(), 10)
+}
@@ -0,0 +1,98 @@
+package adapters_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"newTiktoken/internal/user-relation/adapters"
+	"newTiktoken/internal/user-relation/app/query"
+	"newTiktoken/internal/user-relation/domain"
+)
+
+func newRedisHotUsers(t *testing.T) adapters.RedisHotUsers {
+	t.Helper()
+	redisServer := miniredis.RunT(t)
+	redisClient := redis.NewClient(&redis.Options{Addr: redisServer.Addr(), MaxRetries: -1})
+	t.Cleanup(func() { _ = redisClient.Close() })
+	return adapters.NewRedisHotUsers(redisClient)
+}
+
+func TestRedisHotUsers(t *testing.T) {
+	ctx := context.Background()
+	hotUsers := newRedisHotUsers(t)
+	now := time.Now()
+
+	record := func(eventUUID, userUUID string, delta int64, followedAt time.Time) {
+		t.Helper()
+		err := hotUsers.RecordFollowerGrowth(ctx, eventUUID, domain.FollowerGrowth{
+			UserUUID:   userUUID,
+			Delta:      delta,
+			FollowedAt: followedAt,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	find := func(limit int) []query.HotUserScore {
+		t.Helper()
+		scores, err := hotUsers.FindHotUsers(ctx, limit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return scores
+	}
+
+	record("event-1", "user-a", 1, now.Add(-time.Minute))
+	record("event-2", "user-a", 1, now.Add(-5*time.Hour))
+	record("event-3", "user-b", 1, now.Add(-2*time.Hour))
+	record("event-4", "user-c", 1, now.Add(-3*time.Hour))
+
+	want := []query.HotUserScore{
+		{UserUUID: "user-a", FollowerGrowth: 2},
+		{UserUUID: "user-c", FollowerGrowth: 1},
+		{UserUUID: "user-b", FollowerGrowth: 1},
+	}
+	if got := find(10); !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %+v, got %+v", want, got)
+	}
+
+	t.Run("duplicate_event", func(t *testing.T) {
+		record("event-1", "user-a", 1, now.Add(-time.Minute))
+		if got := find(1); got[0].FollowerGrowth != 2 {
+			t.Errorf("expected duplicate event to be ignored, got %+v", got)
+		}
+	})
+
+	t.Run("unfollow_removes_growth", func(t *testing.T) {
+		record("event-5", "user-b", -1, now.Add(-2*time.Hour))
+		want := []query.HotUserScore{
+			{UserUUID: "user-a", FollowerGrowth: 2},
+			{UserUUID: "user-c", FollowerGrowth: 1},
+		}
+		if got := find(10); !reflect.DeepEqual(got, want) {
+			t.Errorf("expected %+v, got %+v", want, got)
+		}
+	})
+
+	t.Run("growth_outside_window", func(t *testing.T) {
+		for _, eventUUID := range []string{"event-6", "event-7", "event-8"} {
+			record(eventUUID, "user-d", 1, now.Add(-domain.HotUsersWindow))
+		}
+		for _, score := range find(10) {
+			if score.UserUUID == "user-d" {
+				t.Errorf("expected growth outside the window to be ignored, got %+v", score)
+			}
+		}
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		want := []query.HotUserScore{{UserUUID: "user-a", FollowerGrowth: 2}}
+		if got := find(1); !reflect.DeepEqual(got, want) {
+			t.Errorf("expected %+v, got %+v", want, got)
+		}
+	})
+}
@@ -0,0 +1,280 @@
+package adapters_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+	"newTiktoken/internal/user-relation/adapters"
+	userRelationDomain "newTiktoken/internal/user-relation/domain"
+	"os"
+	"sync"
+	"testing"
+)
+
+type repository struct {
+	Name       string
+	Repository userRelationDomain.Repository
+}
+
+func createRepositories(t *testing.T) []repository {
+	t.Helper()
+	repos := []repository{
+		{Name: "memory", Repository: adapters.NewMemoryUserRelationRepository()},
+	}
+
+	// MySQL 实现只有在提供 MYSQL_DSN 时才参与测试，数据库需要先执行 cmd/migrate up
+	if dsn := os.Getenv("MYSQL_DSN"); dsn != "" {
+		db, err := sql.Open("mysql", dsn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { _ = db.Close() })
+		mysqlRepository, err := adapters.NewMySQLUserRelationRepository(db)
+		if err != nil {
+			t.Fatal(err)
+		}
+		repos = append(repos, repository{Name: "mysql", Repository: mysqlRepository})
+	}
+	return repos
+}
+
+func TestRepository(t *testing.T) {
+	t.Parallel()
+	for _, r := range createRepositories(t) {
+		r := r
+		t.Run(r.Name, func(t *testing.T) {
+			t.Parallel()
+
+			t.Run("testGetMissingRelation", func(t *testing.T) {
+				t.Parallel()
+				testGetMissingRelation(t, r.Repository)
+			})
+			t.Run("testAddRelation", func(t *testing.T) {
+				t.Parallel()
+				testAddRelation(t, r.Repository)
+			})
+			t.Run("testAddExistingRelation", func(t *testing.T) {
+				t.Parallel()
+				testAddExistingRelation(t, r.Repository)
+			})
+			t.Run("testUpdateMissingRelation", func(t *testing.T) {
+				t.Parallel()
+				testUpdateMissingRelation(t, r.Repository)
+			})
+			t.Run("testUpdateRelationRollback", func(t *testing.T) {
+				t.Parallel()
+				testUpdateRelationRollback(t, r.Repository)
+			})
+			t.Run("testUpdateRelationPair", func(t *testing.T) {
+				t.Parallel()
+				testUpdateRelationPair(t, r.Repository)
+			})
+			t.Run("testUpdateRelationPairRollback", func(t *testing.T) {
+				t.Parallel()
+				testUpdateRelationPairRollback(t, r.Repository)
+			})
+			t.Run("testUpdateRelationPairInParallel", func(t *testing.T) {
+				t.Parallel()
+				testUpdateRelationPairInParallel(t, r.Repository)
+			})
+		})
+	}
+}
+
+func testGetMissingRelation(t *testing.T, repository userRelationDomain.Repository) {
+	relation, err := repository.GetRelation(context.Background(), uuid.NewString(), uuid.NewString())
+	if err != nil {
+		t.Fatalf("expected nil error for missing relation, got %v", err)
+	}
+	if relation != nil {
+		t.Fatalf("expected nil relation for missing relation, got %+v", relation)
+	}
+}
+
+func testAddRelation(t *testing.T, repository userRelationDomain.Repository) {
+	active, passive := uuid.NewString(), uuid.NewString()
+
+	if err := repository.AddRelation(context.Background(), active, passive); err != nil {
+		t.Fatal(err)
+	}
+	assertRelationStatus(t, repository, active, passive, userRelationDomain.Follow)
+}
+
+func testAddExistingRelation(t *testing.T, repository userRelationDomain.Repository) {
+	ctx := context.Background()
+	active, passive := uuid.NewString(), uuid.NewString()
+	if err := repository.AddRelation(ctx, active, passive); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := repository.AddRelation(ctx, active, passive); !errors.Is(err, userRelationDomain.ErrAlreadyFollowing) {
+		t.Fatalf("expected ErrAlreadyFollowing, got %v", err)
+	}
+}
+
+func testUpdateMissingRelation(t *testing.T, repository userRelationDomain.Repository) {
+	active, passive := uuid.NewString(), uuid.NewString()
+
+	err := repository.UpdateRelation(context.Background(), active, passive, func(
+		_ context.Context,
+		relation *userRelationDomain.UserRelation,
+	) (*userRelationDomain.UserRelation, error) {
+		if relation.ActivePartyUUID != active || relation.PassivePartyUUID != passive {
+			t.Errorf("unexpected relation %s -> %s", relation.ActivePartyUUID, relation.PassivePartyUUID)
+		}
+		if relation.Status != userRelationDomain.Unfollow {
+			t.Errorf("expected new relation in Unfollow status, got %d", relation.Status.Int())
+		}
+		if err := relation.Block(); err != nil {
+			return nil, err
+		}
+		return relation, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertRelationStatus(t, repository, active, passive, userRelationDomain.Block)
+}
+
+func testUpdateRelationRollback(t *testing.T, repository userRelationDomain.Repository) {
+	ctx := context.Background()
+	active, passive := uuid.NewString(), uuid.NewString()
+	if err := repository.AddRelation(ctx, active, passive); err != nil {
+		t.Fatal(err)
+	}
+	errUpdate := errors.New("update failed")
+
+	err := repository.UpdateRelation(ctx, active, passive, func(
+		_ context.Context,
+		relation *userRelationDomain.UserRelation,
+	) (*userRelationDomain.UserRelation, error) {
+		if err := relation.Unfollow(); err != nil {
+			return nil, err
+		}
+		return nil, errUpdate
+	})
+	if !errors.Is(err, errUpdate) {
+		t.Fatalf("expected updateFn error, got %v", err)
+	}
+	assertRelationStatus(t, repository, active, passive, userRelationDomain.Follow)
+}
+
+func testUpdateRelationPair(t *testing.T, repository userRelationDomain.Repository) {
+	ctx := context.Background()
+	active, passive := uuid.NewString(), uuid.NewString()
+	if err := repository.AddRelation(ctx, passive, active); err != nil {
+		t.Fatal(err)
+	}
+
+	err := repository.UpdateRelationPair(ctx, active, passive, func(
+		_ context.Context,
+		relation *userRelationDomain.UserRelation,
+		reverseRelation *userRelationDomain.UserRelation,
+	) error {
+		if relation.ActivePartyUUID != active || reverseRelation.ActivePartyUUID != passive {
+			t.Errorf("relations passed in wrong order: %s, %s", relation.ActivePartyUUID, reverseRelation.ActivePartyUUID)
+		}
+		return userRelationDomain.BlockUser(relation, reverseRelation)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertRelationStatus(t, repository, active, passive, userRelationDomain.Block)
+	assertRelationStatus(t, repository, passive, active, userRelationDomain.Unfollow)
+}
+
+func testUpdateRelationPairRollback(t *testing.T, repository userRelationDomain.Repository) {
+	ctx := context.Background()
+	active, passive := uuid.NewString(), uuid.NewString()
+	if err := repository.AddRelation(ctx, passive, active); err != nil {
+		t.Fatal(err)
+	}
+	errUpdate := errors.New("update failed")
+
+	err := repository.UpdateRelationPair(ctx, active, passive, func(
+		_ context.Context,
+		relation *userRelationDomain.UserRelation,
+		reverseRelation *userRelationDomain.UserRelation,
+	) error {
+		if err := userRelationDomain.BlockUser(relation, reverseRelation); err != nil {
+			return err
+		}
+		return errUpdate
+	})
+	if !errors.Is(err, errUpdate) {
+		t.Fatalf("expected updateFn error, got %v", err)
+	}
+	assertMissingRelation(t, repository, active, passive)
+	assertRelationStatus(t, repository, passive, active, userRelationDomain.Follow)
+}
+
+func testUpdateRelationPairInParallel(t *testing.T, repository userRelationDomain.Repository) {
+	ctx := context.Background()
+	active, passive := uuid.NewString(), uuid.NewString()
+
+	const workers = 10
+	wg := sync.WaitGroup{}
+	errs := make(chan error, workers)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- repository.UpdateRelationPair(ctx, active, passive, func(
+				_ context.Context,
+				relation *userRelationDomain.UserRelation,
+				reverseRelation *userRelationDomain.UserRelation,
+			) error {
+				return userRelationDomain.FollowUser(relation, reverseRelation)
+			})
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	succeeded := 0
+	for err := range errs {
+		if err == nil {
+			succeeded++
+			continue
+		}
+		if !errors.Is(err, userRelationDomain.ErrAlreadyFollowing) {
+			t.Fatalf("expected ErrAlreadyFollowing, got %v", err)
+		}
+	}
+	if succeeded != 1 {
+		t.Fatalf("expected exactly one successful follow, got %d", succeeded)
+	}
+	assertRelationStatus(t, repository, active, passive, userRelationDomain.Follow)
+}
+
+func assertRelationStatus(
+	t *testing.T,
+	repository userRelationDomain.Repository,
+	active, passive string,
+	expected userRelationDomain.RelationActionType,
+) {
+	t.Helper()
+	relation, err := repository.GetRelation(context.Background(), active, passive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if relation == nil {
+		t.Fatalf("relation %s -> %s not persisted", active, passive)
+	}
+	if relation.Status != expected {
+		t.Errorf("expected relation %s -> %s in status %d, got %d", active, passive, expected.Int(), relation.Status.Int())
+	}
+}
+
+func assertMissingRelation(t *testing.T, repository userRelationDomain.Repository, active, passive string) {
+	t.Helper()
+	relation, err := repository.GetRelation(context.Background(), active, passive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if relation != nil {
+		t.Errorf("expected relation %s -> %s not to be persisted, got status %d", active, passive, relation.Status.Int())
+	}
+}
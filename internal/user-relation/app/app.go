@@ -0,0 +1,29 @@
+package app
+
+import (
+	"newTiktoken/internal/user-relation/app/command"
+	"newTiktoken/internal/user-relation/app/query"
+)
+
+type Application struct {
+	Commands Commands
+	Queries  Queries
+}
+
+type Commands struct {
+	FollowUser   command.FollowUserHandler
+	UnfollowUser command.UnfollowUserHandler
+	BlockUser    command.BlockUserHandler
+	UnblockUser  command.UnblockUserHandler
+
+	ReconcileFollowCounts command.ReconcileFollowCountsHandler
+	RecordFollowerGrowth  command.RecordFollowerGrowthHandler
+}
+
+type Queries struct {
+	FollowList   query.FollowListHandler
+	FollowerList query.FollowerListHandler
+	FriendList   query.FriendListHandler
+	BlockedList  query.BlockedListHandler
+	HotUsers     query.HotUsersHandler
+}
@@ -0,0 +1,52 @@
+package command
+
+import (
+	"context"
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/auth"
+	"newTiktoken/internal/common/decorator"
+	"newTiktoken/internal/common/logs"
+	"newTiktoken/internal/user-relation/domain"
+)
+
+type BlockUser struct {
+	User auth.User
+
+	ActivePartyUUID  string
+	PassivePartyUUID string
+}
+
+type BlockUserHandler decorator.CommandHandler[BlockUser]
+
+type blockUserHandler struct {
+	repo domain.Repository
+}
+
+func (h blockUserHandler) Handle(ctx context.Context, cmd BlockUser) (err error) {
+	defer func() {
+		logs.LogCommandExecution("BlockUser", cmd, err)
+	}()
+	if err := BlockUserPolicy.Authorize(cmd.User, cmd); err != nil {
+		return err
+	}
+	return h.repo.UpdateRelationPair(ctx, cmd.ActivePartyUUID, cmd.PassivePartyUUID, func(
+		ctx context.Context,
+		relation *domain.UserRelation,
+		reverseRelation *domain.UserRelation,
+	) error {
+		return domain.BlockUser(relation, reverseRelation)
+	})
+}
+
+func NewBlockUserHandler(repo domain.Repository,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient) BlockUserHandler {
+	if repo == nil {
+		panic("nil repo")
+	}
+	return decorator.ApplyCommandDecorators[BlockUser](
+		blockUserHandler{repo: repo},
+		logger,
+		metricsClient,
+	)
+}
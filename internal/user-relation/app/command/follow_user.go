@@ -0,0 +1,52 @@
+package command
+
+import (
+	"context"
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/auth"
+	"newTiktoken/internal/common/decorator"
+	"newTiktoken/internal/common/logs"
+	"newTiktoken/internal/user-relation/domain"
+)
+
+type FollowUser struct {
+	User auth.User
+
+	ActivePartyUUID  string
+	PassivePartyUUID string
+}
+
+type FollowUserHandler decorator.CommandHandler[FollowUser]
+
+type followUserHandler struct {
+	repo domain.Repository
+}
+
+func (h followUserHandler) Handle(ctx context.Context, cmd FollowUser) (err error) {
+	defer func() {
+		logs.LogCommandExecution("FollowUser", cmd, err)
+	}()
+	if err := FollowUserPolicy.Authorize(cmd.User, cmd); err != nil {
+		return err
+	}
+	return h.repo.UpdateRelationPair(ctx, cmd.ActivePartyUUID, cmd.PassivePartyUUID, func(
+		ctx context.Context,
+		relation *domain.UserRelation,
+		reverseRelation *domain.UserRelation,
+	) error {
+		return domain.FollowUser(relation, reverseRelation)
+	})
+}
+
+func NewFollowUserHandler(repo domain.Repository,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient) FollowUserHandler {
+	if repo == nil {
+		panic("nil repo")
+	}
+	return decorator.ApplyCommandDecorators[FollowUser](
+		followUserHandler{repo: repo},
+		logger,
+		metricsClient,
+	)
+}
@@ -0,0 +1,13 @@
+package command
+
+import "newTiktoken/internal/common/auth"
+
+// 关系只能由主动方修改，管理员也不能代替用户关注或拉黑
+
+var FollowUserPolicy = auth.Owner(func(cmd FollowUser) string { return cmd.ActivePartyUUID })
+
+var UnfollowUserPolicy = auth.Owner(func(cmd UnfollowUser) string { return cmd.ActivePartyUUID })
+
+var BlockUserPolicy = auth.Owner(func(cmd BlockUser) string { return cmd.ActivePartyUUID })
+
+var UnblockUserPolicy = auth.Owner(func(cmd UnblockUser) string { return cmd.ActivePartyUUID })
@@ -0,0 +1,41 @@
+package command_test
+
+import (
+	"testing"
+
+	"newTiktoken/internal/common/auth"
+	"newTiktoken/internal/user-relation/app/command"
+)
+
+func TestRelationPoliciesOnlyAllowActiveParty(t *testing.T) {
+	t.Parallel()
+	activeParty := auth.User{UUID: "user-a", Role: "user"}
+	passiveParty := auth.User{UUID: "user-b", Role: "user"}
+	admin := auth.User{UUID: "admin", Role: auth.RoleAdmin}
+
+	authorize := map[string]func(user auth.User) error{
+		"FollowUser": func(user auth.User) error {
+			return command.FollowUserPolicy.Authorize(user, command.FollowUser{ActivePartyUUID: "user-a", PassivePartyUUID: "user-b"})
+		},
+		"UnfollowUser": func(user auth.User) error {
+			return command.UnfollowUserPolicy.Authorize(user, command.UnfollowUser{ActivePartyUUID: "user-a", PassivePartyUUID: "user-b"})
+		},
+		"BlockUser": func(user auth.User) error {
+			return command.BlockUserPolicy.Authorize(user, command.BlockUser{ActivePartyUUID: "user-a", PassivePartyUUID: "user-b"})
+		},
+		"UnblockUser": func(user auth.User) error {
+			return command.UnblockUserPolicy.Authorize(user, command.UnblockUser{ActivePartyUUID: "user-a", PassivePartyUUID: "user-b"})
+		},
+	}
+	for name, fn := range authorize {
+		if err := fn(activeParty); err != nil {
+			t.Errorf("%s: active party should be allowed, got %v", name, err)
+		}
+		if err := fn(passiveParty); err == nil {
+			t.Errorf("%s: passive party should be denied", name)
+		}
+		if err := fn(admin); err == nil {
+			t.Errorf("%s: admin should be denied", name)
+		}
+	}
+}
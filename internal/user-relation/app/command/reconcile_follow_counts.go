@@ -0,0 +1,45 @@
+package command
+
+import (
+	"context"
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/decorator"
+)
+
+// ReconcileFollowCounts 用关系表重新计算所有用户的关注数和粉丝数，修正增量更新产生的偏差
+type ReconcileFollowCounts struct{}
+
+type ReconcileFollowCountsHandler decorator.CommandHandler[ReconcileFollowCounts]
+
+type FollowCountReconciler interface {
+	ReconcileFollowCounts(ctx context.Context) (int64, error)
+}
+
+type reconcileFollowCountsHandler struct {
+	reconciler FollowCountReconciler
+	logger     *logrus.Entry
+}
+
+func (h reconcileFollowCountsHandler) Handle(ctx context.Context, _ ReconcileFollowCounts) error {
+	fixed, err := h.reconciler.ReconcileFollowCounts(ctx)
+	if err != nil {
+		return err
+	}
+	if fixed > 0 {
+		h.logger.WithField("fixed_users", fixed).Warn("Follow counts drifted and were reconciled")
+	}
+	return nil
+}
+
+func NewReconcileFollowCountsHandler(reconciler FollowCountReconciler,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient) ReconcileFollowCountsHandler {
+	if reconciler == nil {
+		panic("nil reconciler")
+	}
+	return decorator.ApplyCommandDecorators[ReconcileFollowCounts](
+		reconcileFollowCountsHandler{reconciler: reconciler, logger: logger},
+		logger,
+		metricsClient,
+	)
+}
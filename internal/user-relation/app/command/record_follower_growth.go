@@ -0,0 +1,48 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/decorator"
+	"newTiktoken/internal/user-relation/domain"
+)
+
+// RecordFollowerGrowth 把一次关系变化计入热门用户榜，EventUUID 用于消息重复投递时去重
+type RecordFollowerGrowth struct {
+	EventUUID string
+	Event     domain.RelationChanged
+}
+
+type RecordFollowerGrowthHandler decorator.CommandHandler[RecordFollowerGrowth]
+
+// FollowerGrowthRepository 记录粉丝增长，同一个 eventUUID 只能生效一次
+type FollowerGrowthRepository interface {
+	RecordFollowerGrowth(ctx context.Context, eventUUID string, growth domain.FollowerGrowth) error
+}
+
+type recordFollowerGrowthHandler struct {
+	repository FollowerGrowthRepository
+}
+
+func (h recordFollowerGrowthHandler) Handle(ctx context.Context, cmd RecordFollowerGrowth) error {
+	growth, ok := cmd.Event.FollowerGrowth(time.Now())
+	if !ok {
+		return nil
+	}
+	return h.repository.RecordFollowerGrowth(ctx, cmd.EventUUID, growth)
+}
+
+func NewRecordFollowerGrowthHandler(repository FollowerGrowthRepository,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient) RecordFollowerGrowthHandler {
+	if repository == nil {
+		panic("nil repository")
+	}
+	return decorator.ApplyCommandDecorators[RecordFollowerGrowth](
+		recordFollowerGrowthHandler{repository: repository},
+		logger,
+		metricsClient,
+	)
+}
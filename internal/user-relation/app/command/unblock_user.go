@@ -0,0 +1,52 @@
+package command
+
+import (
+	"context"
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/auth"
+	"newTiktoken/internal/common/decorator"
+	"newTiktoken/internal/common/logs"
+	"newTiktoken/internal/user-relation/domain"
+)
+
+type UnblockUser struct {
+	User auth.User
+
+	ActivePartyUUID  string
+	PassivePartyUUID string
+}
+
+type UnblockUserHandler decorator.CommandHandler[UnblockUser]
+
+type unblockUserHandler struct {
+	repo domain.Repository
+}
+
+func (h unblockUserHandler) Handle(ctx context.Context, cmd UnblockUser) (err error) {
+	defer func() {
+		logs.LogCommandExecution("UnblockUser", cmd, err)
+	}()
+	if err := UnblockUserPolicy.Authorize(cmd.User, cmd); err != nil {
+		return err
+	}
+	return h.repo.UpdateRelationPair(ctx, cmd.ActivePartyUUID, cmd.PassivePartyUUID, func(
+		ctx context.Context,
+		relation *domain.UserRelation,
+		reverseRelation *domain.UserRelation,
+	) error {
+		return domain.UnblockUser(relation, reverseRelation)
+	})
+}
+
+func NewUnblockUserHandler(repo domain.Repository,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient) UnblockUserHandler {
+	if repo == nil {
+		panic("nil repo")
+	}
+	return decorator.ApplyCommandDecorators[UnblockUser](
+		unblockUserHandler{repo: repo},
+		logger,
+		metricsClient,
+	)
+}
@@ -0,0 +1,52 @@
+package command
+
+import (
+	"context"
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/auth"
+	"newTiktoken/internal/common/decorator"
+	"newTiktoken/internal/common/logs"
+	"newTiktoken/internal/user-relation/domain"
+)
+
+type UnfollowUser struct {
+	User auth.User
+
+	ActivePartyUUID  string
+	PassivePartyUUID string
+}
+
+type UnfollowUserHandler decorator.CommandHandler[UnfollowUser]
+
+type unfollowUserHandler struct {
+	repo domain.Repository
+}
+
+func (h unfollowUserHandler) Handle(ctx context.Context, cmd UnfollowUser) (err error) {
+	defer func() {
+		logs.LogCommandExecution("UnfollowUser", cmd, err)
+	}()
+	if err := UnfollowUserPolicy.Authorize(cmd.User, cmd); err != nil {
+		return err
+	}
+	return h.repo.UpdateRelationPair(ctx, cmd.ActivePartyUUID, cmd.PassivePartyUUID, func(
+		ctx context.Context,
+		relation *domain.UserRelation,
+		reverseRelation *domain.UserRelation,
+	) error {
+		return domain.UnfollowUser(relation, reverseRelation)
+	})
+}
+
+func NewUnfollowUserHandler(repo domain.Repository,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient) UnfollowUserHandler {
+	if repo == nil {
+		panic("nil repo")
+	}
+	return decorator.ApplyCommandDecorators[UnfollowUser](
+		unfollowUserHandler{repo: repo},
+		logger,
+		metricsClient,
+	)
+}
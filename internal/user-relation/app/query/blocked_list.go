@@ -0,0 +1,44 @@
+package query
+
+import (
+	"context"
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/decorator"
+)
+
+// BlockedList 查询 UserUUID 拉黑的用户，按拉黑时间倒序分页
+type BlockedList struct {
+	UserUUID string
+	Cursor   string
+	Limit    int
+}
+
+type BlockedListHandler decorator.QueryHandler[BlockedList, RelationUserPage]
+
+type blockedListHandler struct {
+	readModel   RelationListReadModel
+	userService UserService
+}
+
+func (h blockedListHandler) Handle(ctx context.Context, query BlockedList) (RelationUserPage, error) {
+	return findRelationUserPage(ctx, h.readModel.FindBlocked, h.userService, query.UserUUID, query.Cursor, query.Limit)
+}
+
+func NewBlockedListHandler(
+	readModel RelationListReadModel,
+	userService UserService,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) BlockedListHandler {
+	if readModel == nil {
+		panic("nil readModel")
+	}
+	if userService == nil {
+		panic("nil userService")
+	}
+	return decorator.ApplyQueryDecorators[BlockedList, RelationUserPage](
+		blockedListHandler{readModel: readModel, userService: userService},
+		logger,
+		metricsClient,
+	)
+}
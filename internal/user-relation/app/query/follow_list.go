@@ -0,0 +1,44 @@
+package query
+
+import (
+	"context"
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/decorator"
+)
+
+// FollowList 查询 UserUUID 的关注列表，按关注时间倒序分页
+type FollowList struct {
+	UserUUID string
+	Cursor   string
+	Limit    int
+}
+
+type FollowListHandler decorator.QueryHandler[FollowList, RelationUserPage]
+
+type followListHandler struct {
+	readModel   RelationListReadModel
+	userService UserService
+}
+
+func (h followListHandler) Handle(ctx context.Context, query FollowList) (RelationUserPage, error) {
+	return findRelationUserPage(ctx, h.readModel.FindFollowing, h.userService, query.UserUUID, query.Cursor, query.Limit)
+}
+
+func NewFollowListHandler(
+	readModel RelationListReadModel,
+	userService UserService,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) FollowListHandler {
+	if readModel == nil {
+		panic("nil readModel")
+	}
+	if userService == nil {
+		panic("nil userService")
+	}
+	return decorator.ApplyQueryDecorators[FollowList, RelationUserPage](
+		followListHandler{readModel: readModel, userService: userService},
+		logger,
+		metricsClient,
+	)
+}
@@ -0,0 +1,44 @@
+package query
+
+import (
+	"context"
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/decorator"
+)
+
+// FollowerList 查询 UserUUID 的粉丝列表，按关注时间倒序分页
+type FollowerList struct {
+	UserUUID string
+	Cursor   string
+	Limit    int
+}
+
+type FollowerListHandler decorator.QueryHandler[FollowerList, RelationUserPage]
+
+type followerListHandler struct {
+	readModel   RelationListReadModel
+	userService UserService
+}
+
+func (h followerListHandler) Handle(ctx context.Context, query FollowerList) (RelationUserPage, error) {
+	return findRelationUserPage(ctx, h.readModel.FindFollowers, h.userService, query.UserUUID, query.Cursor, query.Limit)
+}
+
+func NewFollowerListHandler(
+	readModel RelationListReadModel,
+	userService UserService,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) FollowerListHandler {
+	if readModel == nil {
+		panic("nil readModel")
+	}
+	if userService == nil {
+		panic("nil userService")
+	}
+	return decorator.ApplyQueryDecorators[FollowerList, RelationUserPage](
+		followerListHandler{readModel: readModel, userService: userService},
+		logger,
+		metricsClient,
+	)
+}
@@ -0,0 +1,92 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/decorator"
+	"newTiktoken/internal/common/pagination"
+)
+
+// FriendList 查询与 UserUUID 互相关注的用户，并附带双方最新的一条消息
+type FriendList struct {
+	UserUUID string
+	Cursor   string
+	Limit    int
+}
+
+type FriendListHandler decorator.QueryHandler[FriendList, FriendPage]
+
+// FriendListReadModel 返回双向关系均为 Follow 的用户，按好友 UUID 升序分页
+type FriendListReadModel interface {
+	FindFriends(ctx context.Context, userUUID string, after pagination.Cursor, limit int) ([]RelationEntry, error)
+}
+
+// LatestMessageReadModel 返回 userUUID 与每个 peerUUIDs 之间的最新消息，没有消息的好友不出现在结果中
+type LatestMessageReadModel interface {
+	FindLatestMessages(ctx context.Context, userUUID string, peerUUIDs []string) (map[string]MessagePreview, error)
+}
+
+type friendListHandler struct {
+	readModel        FriendListReadModel
+	messageReadModel LatestMessageReadModel
+	userService      UserService
+}
+
+func (h friendListHandler) Handle(ctx context.Context, query FriendList) (FriendPage, error) {
+	entries, nextCursor, err := findRelationEntryPage(ctx, h.readModel.FindFriends, query.UserUUID, query.Cursor, query.Limit)
+	if err != nil {
+		return FriendPage{}, err
+	}
+	if len(entries) == 0 {
+		return FriendPage{NextCursor: nextCursor}, nil
+	}
+
+	users, err := findUsersOfEntries(ctx, h.userService, entries)
+	if err != nil {
+		return FriendPage{}, err
+	}
+
+	friendUUIDs := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		friendUUIDs = append(friendUUIDs, entry.UserUUID)
+	}
+	messages, err := h.messageReadModel.FindLatestMessages(ctx, query.UserUUID, friendUUIDs)
+	if err != nil {
+		return FriendPage{}, errors.Wrap(err, "failed to find latest messages")
+	}
+
+	friends := make([]Friend, 0, len(users))
+	for _, usr := range users {
+		friend := Friend{User: usr}
+		if message, ok := messages[usr.UUID]; ok {
+			friend.LatestMessage = &message
+		}
+		friends = append(friends, friend)
+	}
+	return FriendPage{Friends: friends, NextCursor: nextCursor}, nil
+}
+
+func NewFriendListHandler(
+	readModel FriendListReadModel,
+	messageReadModel LatestMessageReadModel,
+	userService UserService,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) FriendListHandler {
+	if readModel == nil {
+		panic("nil readModel")
+	}
+	if messageReadModel == nil {
+		panic("nil messageReadModel")
+	}
+	if userService == nil {
+		panic("nil userService")
+	}
+	return decorator.ApplyQueryDecorators[FriendList, FriendPage](
+		friendListHandler{readModel: readModel, messageReadModel: messageReadModel, userService: userService},
+		logger,
+		metricsClient,
+	)
+}
@@ -0,0 +1,76 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/decorator"
+	"newTiktoken/internal/common/pagination"
+)
+
+// HotUsers 查询最近 24 小时粉丝净增长最多的 Limit 个用户，Limit 的默认值和上限与关系列表的每页条数相同
+type HotUsers struct {
+	Limit int
+}
+
+type HotUsersHandler decorator.QueryHandler[HotUsers, []HotUser]
+
+// HotUsersReadModel 按粉丝增长倒序返回至多 limit 个增长为正的用户
+type HotUsersReadModel interface {
+	FindHotUsers(ctx context.Context, limit int) ([]HotUserScore, error)
+}
+
+type hotUsersHandler struct {
+	readModel   HotUsersReadModel
+	userService UserService
+}
+
+func (h hotUsersHandler) Handle(ctx context.Context, query HotUsers) ([]HotUser, error) {
+	scores, err := h.readModel.FindHotUsers(ctx, pagination.NormalizePageSize(query.Limit))
+	if err != nil {
+		return nil, err
+	}
+	if len(scores) == 0 {
+		return nil, nil
+	}
+
+	userUUIDs := make([]string, 0, len(scores))
+	for _, score := range scores {
+		userUUIDs = append(userUUIDs, score.UserUUID)
+	}
+	users, err := h.userService.GetUsersInformation(ctx, userUUIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get users information")
+	}
+
+	// 已注销的用户不再上榜
+	hotUsers := make([]HotUser, 0, len(scores))
+	for _, score := range scores {
+		usr, ok := users[score.UserUUID]
+		if !ok {
+			continue
+		}
+		hotUsers = append(hotUsers, HotUser{User: usr, FollowerGrowth: score.FollowerGrowth})
+	}
+	return hotUsers, nil
+}
+
+func NewHotUsersHandler(
+	readModel HotUsersReadModel,
+	userService UserService,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) HotUsersHandler {
+	if readModel == nil {
+		panic("nil readModel")
+	}
+	if userService == nil {
+		panic("nil userService")
+	}
+	return decorator.ApplyQueryDecorators[HotUsers, []HotUser](
+		hotUsersHandler{readModel: readModel, userService: userService},
+		logger,
+		metricsClient,
+	)
+}
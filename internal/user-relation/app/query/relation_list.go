@@ -0,0 +1,93 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"newTiktoken/internal/common/pagination"
+	"newTiktoken/internal/common/users"
+)
+
+// RelationListReadModel 按关系时间倒序返回 after 之后的至多 limit 条关系记录
+// FindFollowing 和 FindFollowers 不返回任意一方拉黑了另一方的关系
+type RelationListReadModel interface {
+	FindFollowing(ctx context.Context, userUUID string, after pagination.Cursor, limit int) ([]RelationEntry, error)
+	FindFollowers(ctx context.Context, userUUID string, after pagination.Cursor, limit int) ([]RelationEntry, error)
+	FindBlocked(ctx context.Context, userUUID string, after pagination.Cursor, limit int) ([]RelationEntry, error)
+}
+
+type findRelationsFn func(ctx context.Context, userUUID string, after pagination.Cursor, limit int) ([]RelationEntry, error)
+
+// findRelationUserPage 查询一页关系记录并批量补全用户资料
+func findRelationUserPage(
+	ctx context.Context,
+	find findRelationsFn,
+	userService UserService,
+	userUUID string,
+	cursor string,
+	limit int,
+) (RelationUserPage, error) {
+	entries, nextCursor, err := findRelationEntryPage(ctx, find, userUUID, cursor, limit)
+	if err != nil {
+		return RelationUserPage{}, err
+	}
+	usrs, err := findUsersOfEntries(ctx, userService, entries)
+	if err != nil {
+		return RelationUserPage{}, err
+	}
+	return RelationUserPage{Users: usrs, NextCursor: nextCursor}, nil
+}
+
+// findRelationEntryPage 多取一条记录用于判断是否存在下一页
+func findRelationEntryPage(
+	ctx context.Context,
+	find findRelationsFn,
+	userUUID string,
+	cursor string,
+	limit int,
+) ([]RelationEntry, string, error) {
+	after, err := pagination.DecodeCursor(cursor)
+	if err != nil {
+		return nil, "", err
+	}
+	limit = pagination.NormalizePageSize(limit)
+
+	entries, err := find(ctx, userUUID, after, limit+1)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var nextCursor string
+	if len(entries) > limit {
+		entries = entries[:limit]
+		last := entries[len(entries)-1]
+		nextCursor = pagination.Cursor{Time: last.UpdatedAt, UUID: last.UserUUID}.Encode()
+	}
+	return entries, nextCursor, nil
+}
+
+// findUsersOfEntries 按 entries 的顺序返回用户资料，用户服务中不存在的用户只保留 UUID
+func findUsersOfEntries(ctx context.Context, userService UserService, entries []RelationEntry) ([]users.User, error) {
+	if len(entries) == 0 {
+		return nil, nil
+	}
+
+	userUUIDs := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		userUUIDs = append(userUUIDs, entry.UserUUID)
+	}
+	found, err := userService.GetUsersInformation(ctx, userUUIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get users information")
+	}
+
+	result := make([]users.User, 0, len(entries))
+	for _, entry := range entries {
+		usr, ok := found[entry.UserUUID]
+		if !ok {
+			usr = users.User{UUID: entry.UserUUID}
+		}
+		result = append(result, usr)
+	}
+	return result, nil
+}
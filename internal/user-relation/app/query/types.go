@@ -0,0 +1,51 @@
+package query
+
+import (
+	"time"
+
+	"newTiktoken/internal/common/users"
+)
+
+// RelationEntry 是关系列表中的一条记录，UpdatedAt 为关系进入当前状态（关注或拉黑）的时间
+type RelationEntry struct {
+	UserUUID  string
+	UpdatedAt time.Time
+}
+
+// RelationUserPage 是关系列表的一页数据，NextCursor 为空表示没有下一页
+type RelationUserPage struct {
+	Users      []users.User
+	NextCursor string
+}
+
+// MessagePreview 是与好友之间的最新一条消息
+type MessagePreview struct {
+	FromUserUUID string
+	ToUserUUID   string
+	Content      string
+	CreatedAt    time.Time
+}
+
+// Friend 是互相关注的用户，LatestMessage 为 nil 表示两人之间还没有消息
+type Friend struct {
+	User          users.User
+	LatestMessage *MessagePreview
+}
+
+// FriendPage 是好友列表的一页数据，NextCursor 为空表示没有下一页
+type FriendPage struct {
+	Friends    []Friend
+	NextCursor string
+}
+
+// HotUserScore 是热门用户榜中的一个用户及其最近 24 小时的粉丝净增长
+type HotUserScore struct {
+	UserUUID       string
+	FollowerGrowth int64
+}
+
+// HotUser 是补全了用户资料的热门用户
+type HotUser struct {
+	User           users.User
+	FollowerGrowth int64
+}
@@ -0,0 +1,29 @@
+package domain
+
+import "time"
+
+// RelationChanged 在关系状态发生变化时产生，PreviousChangedAt 为上一个状态的开始时间，
+// 消费者可以据此知道被取消的关注是在什么时候建立的
+type RelationChanged struct {
+	ActivePartyUUID   string    `json:"active_party_uuid"`
+	PassivePartyUUID  string    `json:"passive_party_uuid"`
+	PreviousStatus    int       `json:"previous_status"`
+	Status            int       `json:"status"`
+	PreviousChangedAt time.Time `json:"previous_changed_at"`
+	ChangedAt         time.Time `json:"changed_at"`
+}
+
+func (RelationChanged) EventName() string {
+	return "RelationChanged"
+}
+
+func NewRelationChanged(previous UserRelation, current *UserRelation) RelationChanged {
+	return RelationChanged{
+		ActivePartyUUID:   current.ActivePartyUUID,
+		PassivePartyUUID:  current.PassivePartyUUID,
+		PreviousStatus:    previous.Status.Int(),
+		Status:            current.Status.Int(),
+		PreviousChangedAt: previous.UpdatedAt.UTC(),
+		ChangedAt:         current.UpdatedAt.UTC(),
+	}
+}
@@ -0,0 +1,32 @@
+package domain
+
+import "time"
+
+// HotUsersWindow 是热门用户榜统计粉丝增长的时间窗口
+const HotUsersWindow = 24 * time.Hour
+
+// FollowerGrowth 是一次关系变化对被关注者粉丝增长的影响
+// FollowedAt 是这次关注建立的时间，取消关注时同样是被取消的关注的建立时间，
+// 因此关注与取消关注总是计入同一时间，关注移出窗口后取消关注也不会再影响增长
+type FollowerGrowth struct {
+	UserUUID   string
+	Delta      int64
+	FollowedAt time.Time
+}
+
+// FollowerGrowth 返回关系变化造成的粉丝增长，不影响 now 之前 HotUsersWindow 内增长的变化返回 false
+// 取消关注（包括关注后直接拉黑）只在被取消的关注建立于窗口内时才扣减，
+// 否则窗口外的旧关注被取消时会把窗口内的增长扣成负数
+func (e RelationChanged) FollowerGrowth(now time.Time) (FollowerGrowth, bool) {
+	windowStart := now.Add(-HotUsersWindow)
+	following := e.Status == Follow.Int()
+	wasFollowing := e.PreviousStatus == Follow.Int()
+
+	switch {
+	case following && !wasFollowing && e.ChangedAt.After(windowStart):
+		return FollowerGrowth{UserUUID: e.PassivePartyUUID, Delta: 1, FollowedAt: e.ChangedAt}, true
+	case wasFollowing && !following && e.PreviousChangedAt.After(windowStart):
+		return FollowerGrowth{UserUUID: e.PassivePartyUUID, Delta: -1, FollowedAt: e.PreviousChangedAt}, true
+	}
+	return FollowerGrowth{}, false
+}
@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRelationChangedFollowerGrowth(t *testing.T) {
+	now := time.Date(2024, 5, 2, 12, 0, 0, 0, time.UTC)
+	recent := now.Add(-time.Hour)
+	old := now.Add(-HotUsersWindow - time.Hour)
+
+	testCases := []struct {
+		name   string
+		event  RelationChanged
+		want   FollowerGrowth
+		wantOk bool
+	}{
+		{
+			name:   "new_follow",
+			event:  RelationChanged{PreviousStatus: 0, Status: Follow.Int(), ChangedAt: recent},
+			want:   FollowerGrowth{UserUUID: "user-b", Delta: 1, FollowedAt: recent},
+			wantOk: true,
+		},
+		{
+			name:   "follow_again",
+			event:  RelationChanged{PreviousStatus: Unfollow.Int(), PreviousChangedAt: old, Status: Follow.Int(), ChangedAt: recent},
+			want:   FollowerGrowth{UserUUID: "user-b", Delta: 1, FollowedAt: recent},
+			wantOk: true,
+		},
+		{
+			name:   "follow_outside_window",
+			event:  RelationChanged{PreviousStatus: Unfollow.Int(), Status: Follow.Int(), ChangedAt: old},
+			wantOk: false,
+		},
+		{
+			name:   "unfollow_recent_follow",
+			event:  RelationChanged{PreviousStatus: Follow.Int(), PreviousChangedAt: recent, Status: Unfollow.Int(), ChangedAt: now},
+			want:   FollowerGrowth{UserUUID: "user-b", Delta: -1, FollowedAt: recent},
+			wantOk: true,
+		},
+		{
+			name:   "unfollow_old_follow",
+			event:  RelationChanged{PreviousStatus: Follow.Int(), PreviousChangedAt: old, Status: Unfollow.Int(), ChangedAt: now},
+			wantOk: false,
+		},
+		{
+			name:   "block_recent_follow",
+			event:  RelationChanged{PreviousStatus: Follow.Int(), PreviousChangedAt: recent, Status: Block.Int(), ChangedAt: now},
+			want:   FollowerGrowth{UserUUID: "user-b", Delta: -1, FollowedAt: recent},
+			wantOk: true,
+		},
+		{
+			name:   "unblock",
+			event:  RelationChanged{PreviousStatus: Block.Int(), PreviousChangedAt: recent, Status: Unfollow.Int(), ChangedAt: now},
+			wantOk: false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.event.ActivePartyUUID = "user-a"
+			tc.event.PassivePartyUUID = "user-b"
+
+			got, ok := tc.event.FollowerGrowth(now)
+			if ok != tc.wantOk {
+				t.Fatalf("expected ok=%v, got %v", tc.wantOk, ok)
+			}
+			if got != tc.want {
+				t.Errorf("expected %+v, got %+v", tc.want, got)
+			}
+		})
+	}
+}
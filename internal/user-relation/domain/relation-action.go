@@ -26,7 +26,12 @@ func NewRelationTypeFromInt(relationActionType int) (RelationActionType, error)
 	}
 
 	return RelationActionType{}, commonError.NewIncorrectInputError(
-		fmt.Sprintf("invalid '%s' role", relationActionType),
-		"invalid-role",
+		fmt.Sprintf("invalid '%d' relation action type", relationActionType),
+		"invalid-relation-action-type",
 	)
 }
+
+// Int 返回关系状态在数据库中的存储值
+func (r RelationActionType) Int() int {
+	return r.action
+}
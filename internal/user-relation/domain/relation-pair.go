@@ -0,0 +1,33 @@
+package domain
+
+// relation 是 ActiveParty -> PassiveParty 的关系，reverseRelation 是 PassiveParty -> ActiveParty 的关系
+// 拉黑需要同时检查和修改两个方向，因此这些操作总是成对地加载和保存关系
+
+// FollowUser 关注对方，对方拉黑了自己时返回 ErrBlockedByUser
+func FollowUser(relation *UserRelation, reverseRelation *UserRelation) error {
+	if reverseRelation.Status == Block {
+		return ErrBlockedByUser
+	}
+	return relation.Follow()
+}
+
+// UnfollowUser 取消关注对方
+func UnfollowUser(relation *UserRelation, _ *UserRelation) error {
+	return relation.Unfollow()
+}
+
+// BlockUser 拉黑对方，同时移除双方之间已有的关注
+func BlockUser(relation *UserRelation, reverseRelation *UserRelation) error {
+	if err := relation.Block(); err != nil {
+		return err
+	}
+	if reverseRelation.Status == Follow {
+		return reverseRelation.Unfollow()
+	}
+	return nil
+}
+
+// UnblockUser 取消拉黑对方，取消后双方都处于未关注状态
+func UnblockUser(relation *UserRelation, _ *UserRelation) error {
+	return relation.Unblock()
+}
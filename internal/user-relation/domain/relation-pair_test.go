@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func newTestRelationPair(t *testing.T, status, reverseStatus RelationActionType) (*UserRelation, *UserRelation) {
+	t.Helper()
+	relation, err := NewUserRelation("user-a", "user-b", status)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reverseRelation, err := NewUserRelation("user-b", "user-a", reverseStatus)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return relation, reverseRelation
+}
+
+func TestFollowUserBlockedByPassiveParty(t *testing.T) {
+	relation, reverseRelation := newTestRelationPair(t, Unfollow, Block)
+
+	err := FollowUser(relation, reverseRelation)
+	if !errors.Is(err, ErrBlockedByUser) {
+		t.Fatalf("expected ErrBlockedByUser, got %v", err)
+	}
+	if relation.Status != Unfollow {
+		t.Errorf("relation status changed to %d", relation.Status.Int())
+	}
+}
+
+func TestFollowUserBlockingPassiveParty(t *testing.T) {
+	relation, reverseRelation := newTestRelationPair(t, Block, Unfollow)
+
+	if err := FollowUser(relation, reverseRelation); !errors.Is(err, ErrBlockingUser) {
+		t.Fatalf("expected ErrBlockingUser, got %v", err)
+	}
+}
+
+func TestBlockUserRemovesFollowsInBothDirections(t *testing.T) {
+	relation, reverseRelation := newTestRelationPair(t, Follow, Follow)
+
+	if err := BlockUser(relation, reverseRelation); err != nil {
+		t.Fatal(err)
+	}
+	if relation.Status != Block {
+		t.Errorf("expected relation to be blocked, got %d", relation.Status.Int())
+	}
+	if reverseRelation.Status != Unfollow {
+		t.Errorf("expected reverse relation to be unfollowed, got %d", reverseRelation.Status.Int())
+	}
+}
+
+func TestUnblockUser(t *testing.T) {
+	relation, reverseRelation := newTestRelationPair(t, Block, Unfollow)
+
+	if err := UnblockUser(relation, reverseRelation); err != nil {
+		t.Fatal(err)
+	}
+	if relation.Status != Unfollow {
+		t.Errorf("expected relation to be unfollowed, got %d", relation.Status.Int())
+	}
+	if err := UnblockUser(relation, reverseRelation); !errors.Is(err, ErrNotBlocked) {
+		t.Fatalf("expected ErrNotBlocked, got %v", err)
+	}
+}
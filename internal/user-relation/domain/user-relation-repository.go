@@ -2,11 +2,21 @@ package domain
 
 import "context"
 
+// Repository 是user relation domain repository的接口
+// 关系不存在时会以 Unfollow 状态新建关系并交给 updateFn 处理
+// 所有实现都需要通过 adapters 中的 repository contract 测试
 type Repository interface {
+	// GetRelation 在关系不存在时返回 nil, nil
 	GetRelation(ctx context.Context, ActivePartyUUID, PassivePartyUUID string) (*UserRelation, error)
 	AddRelation(ctx context.Context, ActivePartyUUID, PassivePartyUUID string) error
 	UpdateRelation(ctx context.Context, ActivePartyUUID, PassivePartyUUID string, updateFn func(
 		ctx context.Context,
 		userRelation *UserRelation,
 	) (*UserRelation, error)) error
+	// UpdateRelationPair 在同一个事务中锁定并更新两个方向的关系，updateFn 直接修改传入的关系
+	UpdateRelationPair(ctx context.Context, ActivePartyUUID, PassivePartyUUID string, updateFn func(
+		ctx context.Context,
+		userRelation *UserRelation,
+		reverseUserRelation *UserRelation,
+	) error) error
 }
@@ -2,10 +2,21 @@ package domain
 
 import (
 	"github.com/pkg/errors"
+	commonError "newTiktoken/internal/common/errors"
 	"strings"
 	"time"
 )
 
+var (
+	ErrFollowYourself   = commonError.NewIncorrectInputError("users can't follow themselves", "follow-yourself")
+	ErrAlreadyFollowing = commonError.NewConflictError("user is already followed", "already-following")
+	ErrNotFollowing     = commonError.NewPreconditionFailedError("user is not followed", "not-following")
+	ErrAlreadyBlocked   = commonError.NewConflictError("user is already blocked", "already-blocked")
+	ErrNotBlocked       = commonError.NewPreconditionFailedError("user is not blocked", "not-blocked")
+	ErrBlockingUser     = commonError.NewPreconditionFailedError("user is blocked, unblock before following", "blocking-user")
+	ErrBlockedByUser    = commonError.NewAuthorizationError("user has blocked you", "blocked-by-user")
+)
+
 // UserRelation 是用户关系领域的核心实体
 type UserRelation struct {
 	ActivePartyUUID  string
@@ -24,7 +35,7 @@ func NewUserRelation(followerID string, followedID string, actionType RelationAc
 		return nil, errors.New("followedID不能为空")
 	}
 	if strings.Compare(followerID, followedID) == 0 {
-		return nil, errors.New("关注者和被关注者不能是同一个人")
+		return nil, ErrFollowYourself
 	}
 
 	now := time.Now()
@@ -37,19 +48,43 @@ func NewUserRelation(followerID string, followedID string, actionType RelationAc
 	}, nil
 }
 
-func (r *UserRelation) Follow() {
+func (r *UserRelation) Follow() error {
+	if r.Status == Follow {
+		return ErrAlreadyFollowing
+	}
+	if r.Status == Block {
+		return ErrBlockingUser
+	}
 	r.Status = Follow
 	r.UpdatedAt = time.Now()
+	return nil
 }
 
-func (r *UserRelation) Unfollow() {
+func (r *UserRelation) Unfollow() error {
+	if r.Status != Follow {
+		return ErrNotFollowing
+	}
 	r.Status = Unfollow
 	r.UpdatedAt = time.Now()
+	return nil
 }
 
-func (r *UserRelation) Block() {
+func (r *UserRelation) Block() error {
+	if r.Status == Block {
+		return ErrAlreadyBlocked
+	}
 	r.Status = Block
 	r.UpdatedAt = time.Now()
+	return nil
+}
+
+func (r *UserRelation) Unblock() error {
+	if r.Status != Block {
+		return ErrNotBlocked
+	}
+	r.Status = Unfollow
+	r.UpdatedAt = time.Now()
+	return nil
 }
 
 func UnmarshalUserRelationFromDatabase(
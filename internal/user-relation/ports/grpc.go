@@ -0,0 +1,169 @@
+package ports
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"newTiktoken/internal/common/auth"
+	relationPb "newTiktoken/internal/common/genproto/user_relation"
+	"newTiktoken/internal/common/users"
+	"newTiktoken/internal/user-relation/app"
+	"newTiktoken/internal/user-relation/app/command"
+	"newTiktoken/internal/user-relation/app/query"
+)
+
+type GrpcServer struct {
+	relationPb.UnimplementedRelationServiceServer
+	app app.Application
+}
+
+func NewGrpcServer(application app.Application) *GrpcServer {
+	return &GrpcServer{app: application}
+}
+
+// RelationAction 以当前认证用户作为关系的主动方，忽略请求中的 token_user_uuid
+func (g *GrpcServer) RelationAction(ctx context.Context, req *relationPb.RelationActionRequest) (*relationPb.RelationActionResponse, error) {
+	user, err := auth.GRPCUserFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch req.GetActionType() {
+	case relationPb.RelationActionType_FOLLOW:
+		err = g.app.Commands.FollowUser.Handle(ctx, command.FollowUser{
+			User:             user,
+			ActivePartyUUID:  user.UUID,
+			PassivePartyUUID: req.GetToUserUuid(),
+		})
+	case relationPb.RelationActionType_UN_FOLLOW:
+		err = g.app.Commands.UnfollowUser.Handle(ctx, command.UnfollowUser{
+			User:             user,
+			ActivePartyUUID:  user.UUID,
+			PassivePartyUUID: req.GetToUserUuid(),
+		})
+	case relationPb.RelationActionType_BLOCK:
+		err = g.app.Commands.BlockUser.Handle(ctx, command.BlockUser{
+			User:             user,
+			ActivePartyUUID:  user.UUID,
+			PassivePartyUUID: req.GetToUserUuid(),
+		})
+	case relationPb.RelationActionType_UN_BLOCK:
+		err = g.app.Commands.UnblockUser.Handle(ctx, command.UnblockUser{
+			User:             user,
+			ActivePartyUUID:  user.UUID,
+			PassivePartyUUID: req.GetToUserUuid(),
+		})
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid relation action type %s", req.GetActionType())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &relationPb.RelationActionResponse{StatusMsg: "success"}, nil
+}
+
+func (g *GrpcServer) RelationFollowList(ctx context.Context, req *relationPb.RelationFollowListRequest) (*relationPb.RelationFollowListResponse, error) {
+	page, err := g.app.Queries.FollowList.Handle(ctx, query.FollowList{
+		UserUUID: req.GetUserUuid(),
+		Cursor:   req.GetCursor(),
+		Limit:    int(req.GetLimit()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &relationPb.RelationFollowListResponse{
+		StatusMsg:  "success",
+		UserList:   users.ToProtoUsers(page.Users),
+		NextCursor: page.NextCursor,
+	}, nil
+}
+
+func (g *GrpcServer) RelationFollowerList(ctx context.Context, req *relationPb.RelationFollowerListRequest) (*relationPb.RelationFollowerListResponse, error) {
+	page, err := g.app.Queries.FollowerList.Handle(ctx, query.FollowerList{
+		UserUUID: req.GetUserUuid(),
+		Cursor:   req.GetCursor(),
+		Limit:    int(req.GetLimit()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &relationPb.RelationFollowerListResponse{
+		StatusMsg:  "success",
+		UserList:   users.ToProtoUsers(page.Users),
+		NextCursor: page.NextCursor,
+	}, nil
+}
+
+func (g *GrpcServer) RelationFriendList(ctx context.Context, req *relationPb.RelationFriendListRequest) (*relationPb.RelationFriendListResponse, error) {
+	page, err := g.app.Queries.FriendList.Handle(ctx, query.FriendList{
+		UserUUID: req.GetUserUuid(),
+		Cursor:   req.GetCursor(),
+		Limit:    int(req.GetLimit()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	friends := make([]*relationPb.FriendUser, 0, len(page.Friends))
+	for _, friend := range page.Friends {
+		friends = append(friends, queryFriendToProtoFriend(req.GetUserUuid(), friend))
+	}
+	return &relationPb.RelationFriendListResponse{
+		StatusMsg:  "success",
+		UserList:   friends,
+		NextCursor: page.NextCursor,
+	}, nil
+}
+
+// RelationBlockList 只返回当前认证用户的黑名单
+func (g *GrpcServer) RelationBlockList(ctx context.Context, req *relationPb.RelationBlockListRequest) (*relationPb.RelationBlockListResponse, error) {
+	user, err := auth.GRPCUserFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page, err := g.app.Queries.BlockedList.Handle(ctx, query.BlockedList{
+		UserUUID: user.UUID,
+		Cursor:   req.GetCursor(),
+		Limit:    int(req.GetLimit()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &relationPb.RelationBlockListResponse{
+		StatusMsg:  "success",
+		UserList:   users.ToProtoUsers(page.Users),
+		NextCursor: page.NextCursor,
+	}, nil
+}
+
+// GetHotUsers 返回最近 24 小时粉丝净增长最多的用户
+func (g *GrpcServer) GetHotUsers(ctx context.Context, req *relationPb.GetHotUsersRequest) (*relationPb.GetHotUsersResponse, error) {
+	hotUsers, err := g.app.Queries.HotUsers.Handle(ctx, query.HotUsers{Limit: int(req.GetLimit())})
+	if err != nil {
+		return nil, err
+	}
+	pbHotUsers := make([]*relationPb.HotUser, 0, len(hotUsers))
+	for _, hotUser := range hotUsers {
+		pbHotUsers = append(pbHotUsers, &relationPb.HotUser{
+			User:           users.ToProto(hotUser.User),
+			FollowerGrowth: hotUser.FollowerGrowth,
+		})
+	}
+	return &relationPb.GetHotUsersResponse{
+		StatusMsg: "success",
+		UserList:  pbHotUsers,
+	}, nil
+}
+
+func queryFriendToProtoFriend(userUUID string, friend query.Friend) *relationPb.FriendUser {
+	pbFriend := &relationPb.FriendUser{User: users.ToProto(friend.User)}
+	if friend.LatestMessage == nil {
+		return pbFriend
+	}
+	pbFriend.Message = friend.LatestMessage.Content
+	if friend.LatestMessage.FromUserUUID == userUUID {
+		pbFriend.MsgType = relationPb.MessageType_SEND
+	} else {
+		pbFriend.MsgType = relationPb.MessageType_RECEIVE
+	}
+	return pbFriend
+}
@@ -0,0 +1,24 @@
+package ports
+
+import (
+	"context"
+
+	"newTiktoken/internal/common/events"
+	"newTiktoken/internal/user-relation/app"
+	"newTiktoken/internal/user-relation/app/command"
+	"newTiktoken/internal/user-relation/domain"
+)
+
+// NewEventHandlers 返回服务消费的事件：RelationChanged 用于更新热门用户榜
+func NewEventHandlers(application app.Application) map[string]events.Handler {
+	return map[string]events.Handler{
+		domain.RelationChanged{}.EventName(): events.JSONHandler(
+			func(ctx context.Context, eventUUID string, event domain.RelationChanged) error {
+				return application.Commands.RecordFollowerGrowth.Handle(ctx, command.RecordFollowerGrowth{
+					EventUUID: eventUUID,
+					Event:     event,
+				})
+			},
+		),
+	}
+}
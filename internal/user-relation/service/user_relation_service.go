@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/cache"
+	"newTiktoken/internal/common/client"
+	"newTiktoken/internal/common/config"
+	"newTiktoken/internal/common/decorator"
+	"newTiktoken/internal/common/server"
+	"newTiktoken/internal/common/users"
+	"newTiktoken/internal/user-relation/adapters"
+	"newTiktoken/internal/user-relation/app"
+	"newTiktoken/internal/user-relation/app/command"
+	"newTiktoken/internal/user-relation/app/query"
+	userRelationDomain "newTiktoken/internal/user-relation/domain"
+)
+
+// NewApplication 返回应用、驱动 gRPC 健康状态的依赖检查以及退出时的清理函数
+func NewApplication(ctx context.Context, cfg config.Config, metricsClient decorator.MetricsClient) (app.Application, []server.HealthCheck, func()) {
+	db, err := cfg.MySQL.Open()
+	if err != nil {
+		panic(err)
+	}
+	mysqlRelationRepository, err := adapters.NewMySQLUserRelationRepository(db)
+	if err != nil {
+		panic(err)
+	}
+	relationFinder, err := adapters.NewMySQLRelationFinder(db)
+	if err != nil {
+		panic(err)
+	}
+	followCountReconciler, err := adapters.NewMySQLFollowCountReconciler(db)
+	if err != nil {
+		panic(err)
+	}
+	messageFinder, err := adapters.NewMySQLMessageFinder(db)
+	if err != nil {
+		panic(err)
+	}
+	userClient, closeUserClient, err := client.NewUserClient()
+	if err != nil {
+		panic(err)
+	}
+	userService := users.NewUserGrpc(userClient)
+	logger := logrus.NewEntry(logrus.StandardLogger())
+	redisClient := cache.NewRedisClient(cfg.Redis)
+	hotUsers := adapters.NewRedisHotUsers(redisClient)
+
+	// 关系修改会更新 users 表中的关注数，开启缓存时需要删除 user 服务缓存的用户信息
+	var relationRepository userRelationDomain.Repository = mysqlRelationRepository
+	if cfg.Cache.Enabled {
+		relationRepository = adapters.NewCacheInvalidatingUserRelationRepository(
+			relationRepository,
+			cache.NewUserInformationInvalidator(redisClient),
+			logger,
+		)
+	}
+
+	return app.Application{
+		Commands: app.Commands{
+			FollowUser:   command.NewFollowUserHandler(relationRepository, logger, metricsClient),
+			UnfollowUser: command.NewUnfollowUserHandler(relationRepository, logger, metricsClient),
+			BlockUser:    command.NewBlockUserHandler(relationRepository, logger, metricsClient),
+			UnblockUser:  command.NewUnblockUserHandler(relationRepository, logger, metricsClient),
+
+			ReconcileFollowCounts: command.NewReconcileFollowCountsHandler(followCountReconciler, logger, metricsClient),
+			RecordFollowerGrowth:  command.NewRecordFollowerGrowthHandler(hotUsers, logger, metricsClient),
+		},
+		Queries: app.Queries{
+			FollowList:   query.NewFollowListHandler(relationFinder, userService, logger, metricsClient),
+			FollowerList: query.NewFollowerListHandler(relationFinder, userService, logger, metricsClient),
+			FriendList:   query.NewFriendListHandler(relationFinder, messageFinder, userService, logger, metricsClient),
+			BlockedList:  query.NewBlockedListHandler(relationFinder, userService, logger, metricsClient),
+			HotUsers:     query.NewHotUsersHandler(hotUsers, userService, logger, metricsClient),
+		},
+	}, []server.HealthCheck{
+		{Name: "mysql", Check: db.PingContext},
+		{Name: "redis", Check: func(ctx context.Context) error { return redisClient.Ping(ctx).Err() }},
+	}, func() {
+		_ = redisClient.Close()
+		_ = closeUserClient()
+		_ = db.Close()
+	}
+}
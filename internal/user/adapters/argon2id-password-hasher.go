@@ -0,0 +1,115 @@
+package adapters
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/argon2"
+)
+
+// Argon2idParams 是 Argon2id 的计算参数，Memory 的单位是 KiB
+type Argon2idParams struct {
+	Memory      uint32
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  uint32
+	KeyLength   uint32
+}
+
+// DefaultArgon2idParams 参考 OWASP 对 Argon2id 的推荐配置
+var DefaultArgon2idParams = Argon2idParams{
+	Memory:      64 * 1024,
+	Iterations:  3,
+	Parallelism: 2,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+// Argon2idPasswordHasher 把密码哈希为 PHC 格式：$argon2id$v=19$m=65536,t=3,p=2$<salt>$<hash>
+// salt 和 hash 使用不带填充的标准 base64 编码
+type Argon2idPasswordHasher struct {
+	params Argon2idParams
+}
+
+func NewArgon2idPasswordHasher(params Argon2idParams) Argon2idPasswordHasher {
+	if params.Memory == 0 || params.Iterations == 0 || params.Parallelism == 0 || params.SaltLength == 0 || params.KeyLength == 0 {
+		panic("invalid argon2id params")
+	}
+	return Argon2idPasswordHasher{params: params}
+}
+
+func (h Argon2idPasswordHasher) Hash(_ context.Context, password string) (string, error) {
+	salt := make([]byte, h.params.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", errors.Wrap(err, "unable to generate salt")
+	}
+	key := argon2.IDKey([]byte(password), salt, h.params.Iterations, h.params.Memory, h.params.Parallelism, h.params.KeyLength)
+	return encodeArgon2idHash(h.params, salt, key), nil
+}
+
+// Verify 使用 encodedHash 中记录的参数重新计算哈希，因此修改参数后旧的哈希仍然可以校验
+func (h Argon2idPasswordHasher) Verify(_ context.Context, password string, encodedHash string) (bool, error) {
+	params, salt, key, err := decodeArgon2idHash(encodedHash)
+	if err != nil {
+		return false, err
+	}
+	otherKey := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, params.KeyLength)
+	return subtle.ConstantTimeCompare(key, otherKey) == 1, nil
+}
+
+// NeedsRehash 在 encodedHash 的参数与当前参数不同时返回 true，无法解析的哈希同样需要重新计算
+func (h Argon2idPasswordHasher) NeedsRehash(encodedHash string) bool {
+	params, _, _, err := decodeArgon2idHash(encodedHash)
+	return err != nil || params != h.params
+}
+
+func encodeArgon2idHash(params Argon2idParams, salt []byte, key []byte) string {
+	return fmt.Sprintf(
+		"$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version,
+		params.Memory,
+		params.Iterations,
+		params.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	)
+}
+
+func decodeArgon2idHash(encodedHash string) (params Argon2idParams, salt []byte, key []byte, err error) {
+	parts := strings.Split(encodedHash, "$")
+	if len(parts) != 6 || parts[0] != "" || parts[1] != "argon2id" {
+		return Argon2idParams{}, nil, nil, errors.New("invalid argon2id hash format")
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return Argon2idParams{}, nil, nil, errors.Wrap(err, "invalid argon2id version")
+	}
+	if version != argon2.Version {
+		return Argon2idParams{}, nil, nil, errors.Errorf("unsupported argon2id version %d", version)
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism); err != nil {
+		return Argon2idParams{}, nil, nil, errors.Wrap(err, "invalid argon2id params")
+	}
+	if params.Memory == 0 || params.Iterations == 0 || params.Parallelism == 0 {
+		return Argon2idParams{}, nil, nil, errors.New("invalid argon2id params")
+	}
+
+	if salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return Argon2idParams{}, nil, nil, errors.Wrap(err, "invalid argon2id salt")
+	}
+	if key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil {
+		return Argon2idParams{}, nil, nil, errors.Wrap(err, "invalid argon2id hash")
+	}
+	if len(salt) == 0 || len(key) == 0 {
+		return Argon2idParams{}, nil, nil, errors.New("empty argon2id salt or hash")
+	}
+	params.SaltLength = uint32(len(salt))
+	params.KeyLength = uint32(len(key))
+	return params, salt, key, nil
+}
@@ -0,0 +1,148 @@
+package adapters_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"newTiktoken/internal/user/adapters"
+)
+
+// testArgon2idParams 降低计算成本，只用于测试
+var testArgon2idParams = adapters.Argon2idParams{
+	Memory:      1024,
+	Iterations:  1,
+	Parallelism: 1,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+func TestArgon2idPasswordHasherHashesInPHCFormat(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	hasher := adapters.NewArgon2idPasswordHasher(testArgon2idParams)
+
+	hash, err := hasher.Hash(ctx, "correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !regexp.MustCompile(`^\$argon2id\$v=19\$m=1024,t=1,p=1\$[A-Za-z0-9+/]{22}\$[A-Za-z0-9+/]{43}$`).MatchString(hash) {
+		t.Errorf("unexpected hash format %q", hash)
+	}
+
+	other, err := hasher.Hash(ctx, "correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other == hash {
+		t.Error("expected different salts for the same password")
+	}
+}
+
+func TestArgon2idPasswordHasherVerify(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	hash, err := adapters.NewArgon2idPasswordHasher(testArgon2idParams).Hash(ctx, "correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 参数从哈希中读取，使用其他参数的 hasher 也能校验
+	otherParams := testArgon2idParams
+	otherParams.Iterations = 2
+	hasher := adapters.NewArgon2idPasswordHasher(otherParams)
+
+	testCases := []struct {
+		Name     string
+		Password string
+		Hash     string
+		Expected bool
+		Err      bool
+	}{
+		{Name: "correct_password", Password: "correct horse", Hash: hash, Expected: true},
+		{Name: "wrong_password", Password: "battery staple", Hash: hash, Expected: false},
+		{Name: "other_algorithm", Password: "correct horse", Hash: "$argon2i$v=19$m=1024,t=1,p=1$c2FsdA$aGFzaA", Err: true},
+		{Name: "other_version", Password: "correct horse", Hash: "$argon2id$v=16$m=1024,t=1,p=1$c2FsdA$aGFzaA", Err: true},
+		{Name: "invalid_params", Password: "correct horse", Hash: "$argon2id$v=19$m=0,t=1,p=1$c2FsdA$aGFzaA", Err: true},
+		{Name: "invalid_base64", Password: "correct horse", Hash: "$argon2id$v=19$m=1024,t=1,p=1$!!$aGFzaA", Err: true},
+		{Name: "empty", Password: "correct horse", Hash: "", Err: true},
+	}
+
+	for _, c := range testCases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+			ok, err := hasher.Verify(ctx, c.Password, c.Hash)
+			if c.Err {
+				if err == nil {
+					t.Error("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ok != c.Expected {
+				t.Errorf("expected %v, got %v", c.Expected, ok)
+			}
+		})
+	}
+}
+
+func TestArgon2idPasswordHasherNeedsRehash(t *testing.T) {
+	t.Parallel()
+	hash, err := adapters.NewArgon2idPasswordHasher(testArgon2idParams).Hash(context.Background(), "correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	otherParams := testArgon2idParams
+	otherParams.Memory = 2048
+	testCases := []struct {
+		Name     string
+		Params   adapters.Argon2idParams
+		Hash     string
+		Expected bool
+	}{
+		{Name: "same_params", Params: testArgon2idParams, Hash: hash, Expected: false},
+		{Name: "other_params", Params: otherParams, Hash: hash, Expected: true},
+		{Name: "invalid_hash", Params: testArgon2idParams, Hash: "invalid", Expected: true},
+	}
+	for _, c := range testCases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+			if got := adapters.NewArgon2idPasswordHasher(c.Params).NeedsRehash(c.Hash); got != c.Expected {
+				t.Errorf("expected %v, got %v", c.Expected, got)
+			}
+		})
+	}
+}
+
+// BenchmarkArgon2idPasswordHasher 报告单核每秒可以计算的哈希数，用于选择 password_hash 的参数和 worker 数
+// go test ./internal/user/adapters -run '^$' -bench Argon2id
+func BenchmarkArgon2idPasswordHasher(b *testing.B) {
+	paramSets := []struct {
+		Name   string
+		Params adapters.Argon2idParams
+	}{
+		{Name: "m=19MiB,t=2,p=1", Params: adapters.Argon2idParams{Memory: 19 * 1024, Iterations: 2, Parallelism: 1, SaltLength: 16, KeyLength: 32}},
+		{Name: "m=46MiB,t=1,p=1", Params: adapters.Argon2idParams{Memory: 46 * 1024, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32}},
+		{Name: "default", Params: adapters.DefaultArgon2idParams},
+	}
+	for _, paramSet := range paramSets {
+		paramSet := paramSet
+		b.Run(paramSet.Name, func(b *testing.B) {
+			hasher := adapters.NewArgon2idPasswordHasher(paramSet.Params)
+			ctx := context.Background()
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := hasher.Hash(ctx, "correct horse"); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.ReportMetric(float64(b.N)/b.Elapsed().Seconds(), "hashes/s")
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package adapters
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"newTiktoken/internal/common/decorator"
+	commonerrors "newTiktoken/internal/common/errors"
+)
+
+var ErrPasswordHasherOverloaded = commonerrors.NewResourceExhaustedError(
+	"too many concurrent password operations, try again later",
+	"password-hasher-overloaded",
+)
+
+// passwordHasher 是被限制并发的哈希实现，与 command.PasswordHasher 的方法相同
+type passwordHasher interface {
+	Hash(ctx context.Context, password string) (string, error)
+	Verify(ctx context.Context, password string, encodedHash string) (bool, error)
+	NeedsRehash(encodedHash string) bool
+}
+
+// BoundedPasswordHasher 最多同时计算 workers 个哈希，防止 Argon2 占满 CPU 和内存
+// 其余请求最多 queueSize 个排队等待，队列满时立即返回 ErrPasswordHasherOverloaded，
+// 而不是让请求堆积到超时；排队时 ctx 结束的请求不会再计算
+type BoundedPasswordHasher struct {
+	hasher passwordHasher
+	// pending 是计算中和排队中的请求，running 是计算中的请求
+	pending       chan struct{}
+	running       chan struct{}
+	metricsClient decorator.MetricsClient
+}
+
+func NewBoundedPasswordHasher(
+	hasher passwordHasher,
+	workers int,
+	queueSize int,
+	metricsClient decorator.MetricsClient,
+) BoundedPasswordHasher {
+	if hasher == nil {
+		panic("nil hasher")
+	}
+	if workers <= 0 {
+		panic("workers must be positive")
+	}
+	if queueSize < 0 {
+		panic("negative queueSize")
+	}
+	if metricsClient == nil {
+		panic("nil metricsClient")
+	}
+	return BoundedPasswordHasher{
+		hasher:        hasher,
+		pending:       make(chan struct{}, workers+queueSize),
+		running:       make(chan struct{}, workers),
+		metricsClient: metricsClient,
+	}
+}
+
+func (h BoundedPasswordHasher) Hash(ctx context.Context, password string) (hash string, err error) {
+	err = h.run(ctx, "hash", func() (err error) {
+		hash, err = h.hasher.Hash(ctx, password)
+		return err
+	})
+	return hash, err
+}
+
+func (h BoundedPasswordHasher) Verify(ctx context.Context, password string, encodedHash string) (ok bool, err error) {
+	err = h.run(ctx, "verify", func() (err error) {
+		ok, err = h.hasher.Verify(ctx, password, encodedHash)
+		return err
+	})
+	return ok, err
+}
+
+// NeedsRehash 只解析哈希，不需要排队
+func (h BoundedPasswordHasher) NeedsRehash(encodedHash string) bool {
+	return h.hasher.NeedsRehash(encodedHash)
+}
+
+func (h BoundedPasswordHasher) run(ctx context.Context, operation string, fn func() error) error {
+	select {
+	case h.pending <- struct{}{}:
+	default:
+		h.metricsClient.Inc("password_hasher_rejected_total", map[string]string{"operation": operation}, 1)
+		return ErrPasswordHasherOverloaded
+	}
+	defer func() {
+		<-h.pending
+		h.recordQueueDepth()
+	}()
+	h.recordQueueDepth()
+
+	queuedAt := time.Now()
+	select {
+	case h.running <- struct{}{}:
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), "waiting for password hasher")
+	}
+	defer func() {
+		<-h.running
+	}()
+	h.recordQueueDepth()
+	h.metricsClient.ObserveDuration(
+		"password_hasher_queue_wait_seconds",
+		map[string]string{"operation": operation},
+		time.Since(queuedAt),
+	)
+
+	return fn()
+}
+
+// recordQueueDepth 记录排队等待的请求数，两个 channel 分别读取，并发时是近似值
+func (h BoundedPasswordHasher) recordQueueDepth() {
+	depth := len(h.pending) - len(h.running)
+	if depth < 0 {
+		depth = 0
+	}
+	h.metricsClient.SetGauge("password_hasher_queue_depth", map[string]string{}, float64(depth))
+}
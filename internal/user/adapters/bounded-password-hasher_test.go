@@ -0,0 +1,96 @@
+package adapters_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"newTiktoken/internal/common/metrics"
+	"newTiktoken/internal/user/adapters"
+)
+
+// blockingPasswordHasher 在 release 关闭前阻塞所有 Hash 调用，用于占满 worker
+type blockingPasswordHasher struct {
+	started chan struct{}
+	release chan struct{}
+	calls   *atomic.Int32
+}
+
+func newBlockingPasswordHasher() blockingPasswordHasher {
+	return blockingPasswordHasher{
+		started: make(chan struct{}, 10),
+		release: make(chan struct{}),
+		calls:   &atomic.Int32{},
+	}
+}
+
+func (b blockingPasswordHasher) Hash(context.Context, string) (string, error) {
+	b.calls.Add(1)
+	b.started <- struct{}{}
+	<-b.release
+	return "hash", nil
+}
+
+func (b blockingPasswordHasher) Verify(ctx context.Context, password string, _ string) (bool, error) {
+	_, err := b.Hash(ctx, password)
+	return true, err
+}
+
+func (b blockingPasswordHasher) NeedsRehash(string) bool {
+	return false
+}
+
+func TestBoundedPasswordHasherRejectsWhenSaturated(t *testing.T) {
+	t.Parallel()
+	blocking := newBlockingPasswordHasher()
+	hasher := adapters.NewBoundedPasswordHasher(blocking, 1, 0, metrics.NoOp{})
+	ctx := context.Background()
+
+	result := make(chan error, 1)
+	go func() {
+		_, err := hasher.Hash(ctx, "password")
+		result <- err
+	}()
+	<-blocking.started
+
+	if _, err := hasher.Verify(ctx, "password", "hash"); !errors.Is(err, adapters.ErrPasswordHasherOverloaded) {
+		t.Fatalf("expected ErrPasswordHasherOverloaded, got %v", err)
+	}
+
+	close(blocking.release)
+	if err := <-result; err != nil {
+		t.Fatal(err)
+	}
+	if _, err := hasher.Hash(ctx, "password"); err != nil {
+		t.Fatalf("expected hasher to accept work after the worker is free, got %v", err)
+	}
+}
+
+func TestBoundedPasswordHasherStopsWaitingWhenContextIsDone(t *testing.T) {
+	t.Parallel()
+	blocking := newBlockingPasswordHasher()
+	hasher := adapters.NewBoundedPasswordHasher(blocking, 1, 1, metrics.NoOp{})
+	ctx := context.Background()
+
+	result := make(chan error, 1)
+	go func() {
+		_, err := hasher.Hash(ctx, "password")
+		result <- err
+	}()
+	<-blocking.started
+
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := hasher.Hash(canceledCtx, "password"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+
+	close(blocking.release)
+	if err := <-result; err != nil {
+		t.Fatal(err)
+	}
+	if calls := blocking.calls.Load(); calls != 1 {
+		t.Errorf("expected canceled work to be skipped, got %d calls", calls)
+	}
+}
@@ -0,0 +1,46 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/cache"
+	"newTiktoken/internal/user/app/command"
+	userDomain "newTiktoken/internal/user/domain/user"
+)
+
+// CacheInvalidatingUserCounter 在计数修改提交后删除对应用户的用户信息缓存
+// 删除失败只记录日志，此时修改已经提交，缓存最多在 TTL 后过期
+type CacheInvalidatingUserCounter struct {
+	command.UserCounter
+	invalidator cache.UserInformationInvalidator
+	logger      *logrus.Entry
+}
+
+func NewCacheInvalidatingUserCounter(
+	counter command.UserCounter,
+	invalidator cache.UserInformationInvalidator,
+	logger *logrus.Entry,
+) CacheInvalidatingUserCounter {
+	if counter == nil {
+		panic("nil counter")
+	}
+	if logger == nil {
+		panic("nil logger")
+	}
+	return CacheInvalidatingUserCounter{UserCounter: counter, invalidator: invalidator, logger: logger}
+}
+
+func (c CacheInvalidatingUserCounter) AddCounts(ctx context.Context, eventUUID string, changes ...userDomain.CountsChange) error {
+	if err := c.UserCounter.AddCounts(ctx, eventUUID, changes...); err != nil {
+		return err
+	}
+	userUUIDs := make([]string, 0, len(changes))
+	for _, change := range changes {
+		userUUIDs = append(userUUIDs, change.UserUUID)
+	}
+	if err := c.invalidator.InvalidateUserInformation(ctx, userUUIDs...); err != nil {
+		c.logger.WithError(err).WithField("user_uuids", userUUIDs).Error("Unable to invalidate user information cache")
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/cache"
+	userDomain "newTiktoken/internal/user/domain/user"
+)
+
+// CacheInvalidatingUserRepository 在写入提交后删除用户信息缓存
+// 新建用户时同样需要删除，否则创建前缓存的“不存在”会在 NegativeTTL 内继续生效
+// 删除失败只记录日志，此时修改已经提交，缓存最多在 TTL 后过期
+type CacheInvalidatingUserRepository struct {
+	userDomain.Repository
+	invalidator cache.UserInformationInvalidator
+	logger      *logrus.Entry
+}
+
+func NewCacheInvalidatingUserRepository(
+	repository userDomain.Repository,
+	invalidator cache.UserInformationInvalidator,
+	logger *logrus.Entry,
+) CacheInvalidatingUserRepository {
+	if repository == nil {
+		panic("nil repository")
+	}
+	if logger == nil {
+		panic("nil logger")
+	}
+	return CacheInvalidatingUserRepository{Repository: repository, invalidator: invalidator, logger: logger}
+}
+
+func (r CacheInvalidatingUserRepository) AddUser(ctx context.Context, user *userDomain.User) error {
+	if err := r.Repository.AddUser(ctx, user); err != nil {
+		return err
+	}
+	r.invalidate(ctx, user.UUID())
+	return nil
+}
+
+func (r CacheInvalidatingUserRepository) UpdateUser(ctx context.Context, userUUID string, updateFn func(
+	ctx context.Context,
+	user *userDomain.User,
+) (*userDomain.User, error)) error {
+	if err := r.Repository.UpdateUser(ctx, userUUID, updateFn); err != nil {
+		return err
+	}
+	r.invalidate(ctx, userUUID)
+	return nil
+}
+
+func (r CacheInvalidatingUserRepository) invalidate(ctx context.Context, userUUID string) {
+	if err := r.invalidator.InvalidateUserInformation(ctx, userUUID); err != nil {
+		r.logger.WithError(err).WithField("user_uuid", userUUID).Error("Unable to invalidate user information cache")
+	}
+}
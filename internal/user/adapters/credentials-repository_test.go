@@ -0,0 +1,212 @@
+package adapters_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"newTiktoken/internal/user/adapters"
+	userDomain "newTiktoken/internal/user/domain/user"
+)
+
+// 内存凭证仓库注册时把用户写入传入的用户仓库，对应 MySQL 中 users 和 user_credentials 的同一事务
+func TestMemoryCredentialsRepository(t *testing.T) {
+	t.Parallel()
+	userRepository := adapters.NewMemoryUserRepository()
+	testCredentialsRepository(t, adapters.NewMemoryCredentialsRepository(userRepository), userRepository)
+}
+
+// TestMySQLCredentialsRepository 需要提供 MYSQL_DSN，数据库需要先执行 cmd/migrate up
+func TestMySQLCredentialsRepository(t *testing.T) {
+	t.Parallel()
+	dsn := os.Getenv("MYSQL_DSN")
+	if dsn == "" {
+		t.Skip("MYSQL_DSN is not set")
+	}
+	db, err := sql.Open("mysql", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	repository, err := adapters.NewMySQLCredentialsRepository(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	userRepository, err := adapters.NewMySQLUserRepository(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testCredentialsRepository(t, repository, userRepository)
+
+	// 用户名冲突时整个注册事务回滚，不会留下 UserCreated 事件
+	t.Run("UserCreatedOnlyForRegisteredUser", func(t *testing.T) {
+		t.Parallel()
+		ctx := context.Background()
+		username := newExampleUsername()
+		usr, credentials := newExampleCredentials(t, username)
+		if err := repository.RegisterUser(ctx, usr, credentials); err != nil {
+			t.Fatal(err)
+		}
+		rejectedUser, rejectedCredentials := newExampleCredentials(t, username)
+		if err := repository.RegisterUser(ctx, rejectedUser, rejectedCredentials); !errors.Is(err, userDomain.ErrUsernameTaken) {
+			t.Fatalf("expected ErrUsernameTaken, got %v", err)
+		}
+
+		assertUserCreatedEvents(t, db, usr.UUID(), 1)
+		assertUserCreatedEvents(t, db, rejectedUser.UUID(), 0)
+	})
+}
+
+func testCredentialsRepository(t *testing.T, repository userDomain.CredentialsRepository, userRepository userDomain.Repository) {
+	t.Run("RegisterUser", func(t *testing.T) {
+		t.Parallel()
+		testRegisterUser(t, repository, userRepository)
+	})
+	t.Run("RegisterTakenUsername", func(t *testing.T) {
+		t.Parallel()
+		testRegisterTakenUsername(t, repository, userRepository)
+	})
+	t.Run("ChangePasswordHash", func(t *testing.T) {
+		t.Parallel()
+		testChangePasswordHash(t, repository)
+	})
+}
+
+func testRegisterUser(t *testing.T, repository userDomain.CredentialsRepository, userRepository userDomain.Repository) {
+	ctx := context.Background()
+	usr, credentials := newExampleCredentials(t, newExampleUsername())
+
+	missing, err := repository.GetCredentialsByUsername(ctx, credentials.Username())
+	if err != nil {
+		t.Fatalf("expected nil error for missing credentials, got %v", err)
+	}
+	if missing != nil {
+		t.Fatalf("expected nil credentials before registration, got %+v", missing)
+	}
+
+	if err := repository.RegisterUser(ctx, usr, credentials); err != nil {
+		t.Fatal(err)
+	}
+
+	// 登录时用户名不区分大小写
+	found, err := repository.GetCredentialsByUsername(ctx, strings.ToUpper(credentials.Username()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found == nil {
+		t.Fatal("expected credentials to be found")
+	}
+	if found.UserUUID() != usr.UUID() || found.Username() != credentials.Username() || found.PasswordHash() != credentials.PasswordHash() {
+		t.Errorf("unexpected credentials %+v", found)
+	}
+
+	persistedUser, err := userRepository.GetUser(ctx, usr.UUID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if persistedUser == nil || persistedUser.Name() != usr.Name() {
+		t.Errorf("expected registered user to be persisted, got %+v", persistedUser)
+	}
+}
+
+func testRegisterTakenUsername(t *testing.T, repository userDomain.CredentialsRepository, userRepository userDomain.Repository) {
+	ctx := context.Background()
+	username := newExampleUsername()
+	usr, credentials := newExampleCredentials(t, username)
+	if err := repository.RegisterUser(ctx, usr, credentials); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, taken := range []string{username, strings.ToUpper(username)} {
+		otherUser, otherCredentials := newExampleCredentials(t, taken)
+		err := repository.RegisterUser(ctx, otherUser, otherCredentials)
+		if !errors.Is(err, userDomain.ErrUsernameTaken) {
+			t.Fatalf("expected ErrUsernameTaken for %s, got %v", taken, err)
+		}
+
+		persistedUser, err := userRepository.GetUser(ctx, otherUser.UUID())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if persistedUser != nil {
+			t.Errorf("expected user with taken username %s not to be persisted", taken)
+		}
+	}
+}
+
+// testChangePasswordHash 对应登录时按新参数重新哈希密码的场景
+func testChangePasswordHash(t *testing.T, repository userDomain.CredentialsRepository) {
+	ctx := context.Background()
+
+	called := false
+	err := repository.UpdateCredentials(ctx, newExampleUsername(), func(_ context.Context, found *userDomain.Credentials) (*userDomain.Credentials, error) {
+		called = true
+		return found, nil
+	})
+	if !errors.Is(err, userDomain.ErrInvalidCredentials) {
+		t.Fatalf("expected ErrInvalidCredentials for missing credentials, got %v", err)
+	}
+	if called {
+		t.Error("updateFn should not be called for missing credentials")
+	}
+
+	usr, credentials := newExampleCredentials(t, newExampleUsername())
+	if err := repository.RegisterUser(ctx, usr, credentials); err != nil {
+		t.Fatal(err)
+	}
+	newHash := "$argon2id$v=19$m=2048,t=1,p=1$c2FsdA$aGFzaA"
+	err = repository.UpdateCredentials(ctx, credentials.Username(), func(_ context.Context, found *userDomain.Credentials) (*userDomain.Credentials, error) {
+		if err := found.ChangePasswordHash(newHash); err != nil {
+			return nil, err
+		}
+		return found, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	found, err := repository.GetCredentialsByUsername(ctx, credentials.Username())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found == nil || found.PasswordHash() != newHash {
+		t.Errorf("expected updated password hash, got %+v", found)
+	}
+}
+
+func newExampleUsername() string {
+	return "user_" + strings.ReplaceAll(uuid.NewString(), "-", "")[:20]
+}
+
+func newExampleCredentials(t *testing.T, username string) (*userDomain.User, *userDomain.Credentials) {
+	t.Helper()
+	usr, err := userDomain.NewUser(uuid.NewString(), "example")
+	if err != nil {
+		t.Fatal(err)
+	}
+	credentials, err := userDomain.NewCredentials(usr.UUID(), username, "$argon2id$v=19$m=1024,t=1,p=1$c2FsdA$aGFzaA")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return usr, credentials
+}
+
+func assertUserCreatedEvents(t *testing.T, db *sql.DB, userUUID string, expected int) {
+	t.Helper()
+	var count int
+	err := db.QueryRow(
+		"SELECT COUNT(*) FROM outbox_events WHERE event_name = ? AND payload->>'$.uuid' = ?",
+		userDomain.UserCreated{}.EventName(), userUUID,
+	).Scan(&count)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != expected {
+		t.Errorf("expected %d UserCreated events for %s, got %d", expected, userUUID, count)
+	}
+}
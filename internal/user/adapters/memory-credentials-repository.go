@@ -0,0 +1,74 @@
+package adapters
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	userDomain "newTiktoken/internal/user/domain/user"
+)
+
+// MemoryCredentialsRepository 把用户写入 users，使注册的用户可以通过 MemoryUserRepository 查询
+type MemoryCredentialsRepository struct {
+	lock        *sync.RWMutex
+	users       *MemoryUserRepository
+	credentials map[string]userDomain.Credentials
+}
+
+func NewMemoryCredentialsRepository(users *MemoryUserRepository) *MemoryCredentialsRepository {
+	if users == nil {
+		panic("nil users")
+	}
+	return &MemoryCredentialsRepository{
+		lock:        &sync.RWMutex{},
+		users:       users,
+		credentials: map[string]userDomain.Credentials{},
+	}
+}
+
+func (m MemoryCredentialsRepository) RegisterUser(ctx context.Context, user *userDomain.User, credentials *userDomain.Credentials) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.credentials[credentials.Username()]; ok {
+		return errors.Wrapf(userDomain.ErrUsernameTaken, "username %s", credentials.Username())
+	}
+	if err := m.users.AddUser(ctx, user); err != nil {
+		return err
+	}
+	m.credentials[credentials.Username()] = *credentials
+	return nil
+}
+
+func (m MemoryCredentialsRepository) GetCredentialsByUsername(_ context.Context, username string) (*userDomain.Credentials, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	credentials, ok := m.credentials[userDomain.NormalizeUsername(username)]
+	if !ok {
+		return nil, nil
+	}
+	return &credentials, nil
+}
+
+// UpdateCredentials 在持有写锁期间执行 updateFn，对 updateFn 拿到的副本的修改只有在成功时才会保存
+func (m MemoryCredentialsRepository) UpdateCredentials(ctx context.Context, username string, updateFn func(
+	ctx context.Context,
+	credentials *userDomain.Credentials,
+) (*userDomain.Credentials, error)) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	username = userDomain.NormalizeUsername(username)
+	credentials, ok := m.credentials[username]
+	if !ok {
+		return errors.Wrapf(userDomain.ErrInvalidCredentials, "credentials of %s not found for update", username)
+	}
+
+	updatedCredentials, err := updateFn(ctx, &credentials)
+	if err != nil {
+		return errors.Wrap(err, "update function failed")
+	}
+	m.credentials[username] = *updatedCredentials
+	return nil
+}
@@ -0,0 +1,55 @@
+package adapters
+
+import (
+	"context"
+	"newTiktoken/internal/user/app/query"
+	userDomain "newTiktoken/internal/user/domain/user"
+)
+
+// MemoryUserFinder 基于 MemoryUserRepository 实现 InformationOfUserReadModel
+// 内存实现不维护关注数、作品数等统计字段，这些字段始终为 0
+type MemoryUserFinder struct {
+	repository *MemoryUserRepository
+}
+
+func NewMemoryUserFinder(repository *MemoryUserRepository) MemoryUserFinder {
+	if repository == nil {
+		panic("nil repository")
+	}
+	return MemoryUserFinder{repository: repository}
+}
+
+func (m MemoryUserFinder) FindInformationOfUser(ctx context.Context, userUUID string) (*query.User, error) {
+	usr, err := m.repository.GetUser(ctx, userUUID)
+	if err != nil || usr == nil {
+		return nil, err
+	}
+	userDTO := domainUserToQueryUser(usr)
+	return &userDTO, nil
+}
+
+func (m MemoryUserFinder) FindInformationOfUsers(ctx context.Context, userUUIDs []string) ([]query.User, error) {
+	users := make([]query.User, 0, len(userUUIDs))
+	for _, userUUID := range userUUIDs {
+		usr, err := m.repository.GetUser(ctx, userUUID)
+		if err != nil {
+			return nil, err
+		}
+		if usr == nil {
+			continue
+		}
+		users = append(users, domainUserToQueryUser(usr))
+	}
+	return users, nil
+}
+
+func domainUserToQueryUser(usr *userDomain.User) query.User {
+	return query.User{
+		UUID:      usr.UUID(),
+		Name:      usr.Name(),
+		Age:       usr.Age(),
+		Gender:    usr.Gender(),
+		CreatedAt: usr.CreatedAt(),
+		UpdatedAt: usr.UpdatedAt(),
+	}
+}
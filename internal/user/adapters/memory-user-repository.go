@@ -0,0 +1,64 @@
+package adapters
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	userDomain "newTiktoken/internal/user/domain/user"
+	"sync"
+)
+
+// MemoryUserRepository 是线程安全的内存用户仓库，用于测试和本地运行
+type MemoryUserRepository struct {
+	lock  *sync.RWMutex
+	users map[string]userDomain.User
+}
+
+func NewMemoryUserRepository() *MemoryUserRepository {
+	return &MemoryUserRepository{
+		lock:  &sync.RWMutex{},
+		users: map[string]userDomain.User{},
+	}
+}
+
+func (m MemoryUserRepository) GetUser(_ context.Context, userUUID string) (*userDomain.User, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	usr, ok := m.users[userUUID]
+	if !ok {
+		return nil, nil
+	}
+	return &usr, nil
+}
+
+func (m MemoryUserRepository) AddUser(_ context.Context, user *userDomain.User) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.users[user.UUID()]; ok {
+		return errors.Errorf("user %s already exists", user.UUID())
+	}
+	m.users[user.UUID()] = *user
+	return nil
+}
+
+// UpdateUser 在持有写锁期间执行 updateFn，对 updateFn 拿到的副本的修改只有在成功时才会保存
+func (m MemoryUserRepository) UpdateUser(ctx context.Context, userUUID string, updateFn func(
+	ctx context.Context,
+	user *userDomain.User,
+) (*userDomain.User, error)) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	usr, ok := m.users[userUUID]
+	if !ok {
+		return errors.Wrapf(userDomain.ErrUserNotFound, "user with uuid %s not found for update", userUUID)
+	}
+
+	updatedUser, err := updateFn(ctx, &usr)
+	if err != nil {
+		return errors.Wrap(err, "update function failed")
+	}
+	m.users[userUUID] = *updatedUser
+	return nil
+}
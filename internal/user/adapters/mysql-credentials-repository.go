@@ -0,0 +1,161 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/pkg/errors"
+	"newTiktoken/internal/common/events"
+	"newTiktoken/internal/common/tracing"
+	userDomain "newTiktoken/internal/user/domain/user"
+)
+
+// mysqlErrDuplicateEntry 是违反唯一索引时 MySQL 返回的错误码
+const mysqlErrDuplicateEntry = 1062
+
+type MySQLCredentialsRepository struct {
+	db *sql.DB
+}
+
+// NewMySQLCredentialsRepository 使用 users 和 user_credentials 表
+func NewMySQLCredentialsRepository(db *sql.DB) (*MySQLCredentialsRepository, error) {
+	if db == nil {
+		return nil, errors.New("nil db")
+	}
+	return &MySQLCredentialsRepository{db: db}, nil
+}
+
+func (m MySQLCredentialsRepository) RegisterUser(ctx context.Context, user *userDomain.User, credentials *userDomain.Credentials) (err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "MySQLCredentialsRepository.RegisterUser")
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		} else if err != nil {
+			_ = tx.Rollback()
+		} else {
+			err = tx.Commit()
+		}
+	}()
+
+	now := time.Now().UTC()
+	_, err = tx.ExecContext(ctx,
+		"INSERT INTO user_credentials (user_uuid, username, password_hash, created_at, updated_at) VALUES (?, ?, ?, ?, ?)",
+		credentials.UserUUID(), credentials.Username(), credentials.PasswordHash(), now, now)
+	if isDuplicateEntry(err, "uk_user_credentials_username") {
+		return errors.Wrapf(userDomain.ErrUsernameTaken, "username %s", credentials.Username())
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to insert credentials of user %s", credentials.UserUUID())
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"INSERT INTO users (user_uuid, user_name, age, gender, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)",
+		user.UUID(), user.Name(), user.Age(), user.Gender(), now, now)
+	if err != nil {
+		return errors.Wrapf(err, "failed to insert user %s", user.UUID())
+	}
+
+	return events.StoreInOutbox(ctx, tx, userDomain.NewUserCreated(user))
+}
+
+func (m MySQLCredentialsRepository) GetCredentialsByUsername(ctx context.Context, username string) (_ *userDomain.Credentials, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "MySQLCredentialsRepository.GetCredentialsByUsername")
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	var (
+		userUUID, storedUsername, passwordHash string
+		createdAt, updatedAt                   time.Time
+	)
+	err = m.db.QueryRowContext(ctx,
+		"SELECT user_uuid, username, password_hash, created_at, updated_at FROM user_credentials WHERE username = ?",
+		userDomain.NormalizeUsername(username),
+	).Scan(&userUUID, &storedUsername, &passwordHash, &createdAt, &updatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find credentials by username %s", username)
+	}
+
+	return userDomain.UnmarshalCredentialsFromDatabase(userUUID, storedUsername, passwordHash, createdAt, updatedAt)
+}
+
+func (m MySQLCredentialsRepository) UpdateCredentials(ctx context.Context, username string, updateFn func(
+	ctx context.Context,
+	credentials *userDomain.Credentials,
+) (*userDomain.Credentials, error)) (err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "MySQLCredentialsRepository.UpdateCredentials")
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		} else if err != nil {
+			_ = tx.Rollback()
+		} else {
+			err = tx.Commit()
+		}
+	}()
+
+	var (
+		userUUID, storedUsername, passwordHash string
+		createdAt, updatedAt                   time.Time
+	)
+	err = tx.QueryRowContext(ctx,
+		"SELECT user_uuid, username, password_hash, created_at, updated_at FROM user_credentials WHERE username = ? FOR UPDATE",
+		userDomain.NormalizeUsername(username),
+	).Scan(&userUUID, &storedUsername, &passwordHash, &createdAt, &updatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return errors.Wrapf(userDomain.ErrInvalidCredentials, "credentials of %s not found for update", username)
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to scan credentials for update")
+	}
+
+	credentials, err := userDomain.UnmarshalCredentialsFromDatabase(userUUID, storedUsername, passwordHash, createdAt, updatedAt)
+	if err != nil {
+		return errors.Wrap(err, "failed to unmarshal credentials for update")
+	}
+
+	updatedCredentials, err := updateFn(ctx, credentials)
+	if err != nil {
+		return errors.Wrap(err, "update function failed")
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"UPDATE user_credentials SET password_hash = ?, updated_at = ? WHERE user_uuid = ?",
+		updatedCredentials.PasswordHash(), time.Now().UTC(), updatedCredentials.UserUUID())
+	if err != nil {
+		return errors.Wrap(err, "failed to execute credentials update")
+	}
+	return nil
+}
+
+// isDuplicateEntry 判断 err 是否是违反了名为 key 的唯一索引
+func isDuplicateEntry(err error, key string) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlErrDuplicateEntry && strings.Contains(mysqlErr.Message, key)
+}
@@ -0,0 +1,66 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pkg/errors"
+	"newTiktoken/internal/common/events"
+	"newTiktoken/internal/common/tracing"
+	userDomain "newTiktoken/internal/user/domain/user"
+)
+
+// userCountsConsumer 是 processed_events 中记录用户计数更新的消费者名
+const userCountsConsumer = "user-service.user-counts"
+
+// MySQLUserCounter 根据其他服务的事件修改 users 表中的计数
+type MySQLUserCounter struct {
+	db *sql.DB
+}
+
+func NewMySQLUserCounter(db *sql.DB) (*MySQLUserCounter, error) {
+	if db == nil {
+		return nil, errors.New("nil db")
+	}
+	return &MySQLUserCounter{db: db}, nil
+}
+
+// AddCounts 在同一事务中记录事件已处理并修改计数，重复投递的事件不会再次计数
+func (m MySQLUserCounter) AddCounts(ctx context.Context, eventUUID string, changes ...userDomain.CountsChange) (err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "MySQLUserCounter.AddCounts")
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		} else if err != nil {
+			_ = tx.Rollback()
+		} else {
+			err = tx.Commit()
+		}
+	}()
+
+	firstTime, err := events.MarkProcessed(ctx, tx, userCountsConsumer, eventUUID)
+	if err != nil || !firstTime {
+		return err
+	}
+
+	for _, change := range changes {
+		_, err = tx.ExecContext(ctx,
+			"UPDATE users SET work_count = work_count + ?, total_favorite = total_favorite + ?, favorite_count = favorite_count + ? WHERE user_uuid = ?",
+			change.WorkCount, change.TotalFavorite, change.FavoriteCount, change.UserUUID,
+		)
+		if err != nil {
+			return errors.Wrapf(err, "failed to update counts of %s", change.UserUUID)
+		}
+	}
+	return nil
+}
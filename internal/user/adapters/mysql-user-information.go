@@ -4,7 +4,9 @@ import (
 	"context"
 	"database/sql"
 	"github.com/pkg/errors"
+	"newTiktoken/internal/common/tracing"
 	"newTiktoken/internal/user/app/query"
+	"strings"
 )
 
 type MySQLUserFinder struct {
@@ -17,16 +19,74 @@ func NewMySQLUserFinder(db *sql.DB) (*MySQLUserFinder, error) {
 	}, nil
 }
 
-func (m MySQLUserFinder) FindInformationOfUser(ctx context.Context, userUUID string) (*query.User, error) {
-	selectQuery := `
+const selectInformationOfUser = `
         SELECT
             user_uuid, user_name, age, gender,
             following_count, follower_count, total_favorite, work_count, favorite_count,
             created_at, updated_at
-        FROM users
+        FROM users`
+
+func (m MySQLUserFinder) FindInformationOfUser(ctx context.Context, userUUID string) (_ *query.User, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "MySQLUserFinder.FindInformationOfUser")
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	selectQuery := selectInformationOfUser + `
         WHERE user_uuid = ?`
 	row := m.db.QueryRowContext(ctx, selectQuery, userUUID)
 
+	userDTO, err := scanInformationOfUser(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to scan user information for %s", userUUID)
+	}
+	return &userDTO, nil
+}
+
+func (m MySQLUserFinder) FindInformationOfUsers(ctx context.Context, userUUIDs []string) (_ []query.User, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "MySQLUserFinder.FindInformationOfUsers")
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	if len(userUUIDs) == 0 {
+		return nil, nil
+	}
+	selectQuery := selectInformationOfUser + `
+        WHERE user_uuid IN (` + strings.TrimSuffix(strings.Repeat("?, ", len(userUUIDs)), ", ") + `)`
+	args := make([]any, 0, len(userUUIDs))
+	for _, userUUID := range userUUIDs {
+		args = append(args, userUUID)
+	}
+
+	rows, err := m.db.QueryContext(ctx, selectQuery, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query users information")
+	}
+	defer rows.Close()
+
+	users := make([]query.User, 0, len(userUUIDs))
+	for rows.Next() {
+		userDTO, err := scanInformationOfUser(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan users information")
+		}
+		users = append(users, userDTO)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate users information")
+	}
+	return users, nil
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanInformationOfUser(row scanner) (query.User, error) {
 	var userDTO query.User
 	var age sql.NullInt16
 	var gender sql.NullInt16
@@ -44,11 +104,7 @@ func (m MySQLUserFinder) FindInformationOfUser(ctx context.Context, userUUID str
 		&userDTO.CreatedAt,
 		&userDTO.UpdatedAt,
 	)
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, nil
-		}
-		return nil, errors.Wrapf(err, "failed to scan user information for %s", userUUID)
-	}
-	return &userDTO, nil
+	userDTO.Age = uint16(age.Int16)
+	userDTO.Gender = uint16(gender.Int16)
+	return userDTO, err
 }
@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 	"github.com/pkg/errors"
+	"newTiktoken/internal/common/events"
+	"newTiktoken/internal/common/tracing"
 	userDomain "newTiktoken/internal/user/domain/user"
 	"time"
 )
@@ -32,16 +34,16 @@ func (m MySQLUserRepository) unmarshalUser(user *mysqlUser) (*userDomain.User, e
 	return userDomain.UnmarshalUserFromDatabase(
 		user.UserUUID,
 		user.Username,
-		uint16(user.Gender.Int16),
 		uint16(user.Age.Int16),
+		uint16(user.Gender.Int16),
 		user.CreatedAt,
 		user.UpdatedAt,
 	)
 }
 
 // NewMySQLUserRepository 创建一个新的 MySQL 用户仓库实例
+// users 表由 cmd/migrate 执行 internal/common/migrations 中的迁移创建
 func NewMySQLUserRepository(db *sql.DB) (userDomain.Repository, error) {
-	// 自动迁移模式，确保表结构是最新的
 	return &MySQLUserRepository{
 		db: db,
 	}, nil
@@ -52,6 +54,11 @@ func (m MySQLUserRepository) UpdateUser(ctx context.Context, userUUID string, up
 	ctx context.Context,
 	user *userDomain.User,
 ) (*userDomain.User, error)) (err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "MySQLUserRepository.UpdateUser")
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
 	tx, err := m.db.BeginTx(ctx, nil)
 	if err != nil {
 		return errors.Wrap(err, "failed to begin transaction")
@@ -73,7 +80,7 @@ func (m MySQLUserRepository) UpdateUser(ctx context.Context, userUUID string, up
 	var foundUser mysqlUser
 	if err = row.Scan(&foundUser.UserUUID, &foundUser.Username, &foundUser.Age, &foundUser.Gender, &foundUser.CreatedAt, &foundUser.UpdatedAt); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return errors.Wrapf(err, "user with uuid %s not found for update", userUUID)
+			return errors.Wrapf(userDomain.ErrUserNotFound, "user with uuid %s not found for update", userUUID)
 		}
 		return errors.Wrap(err, "failed to scan user for update")
 	}
@@ -99,22 +106,49 @@ func (m MySQLUserRepository) UpdateUser(ctx context.Context, userUUID string, up
 		return errors.Wrap(err, "failed to execute user update")
 	}
 
-	return nil
+	return events.StoreInOutbox(ctx, tx, userDomain.NewUserUpdated(updatedDomainUser))
 }
 
-// AddUser 添加用户
-func (m MySQLUserRepository) AddUser(ctx context.Context, user *userDomain.User) error {
+// AddUser 添加用户，并在同一事务中写入 UserCreated 事件
+func (m MySQLUserRepository) AddUser(ctx context.Context, user *userDomain.User) (err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "MySQLUserRepository.AddUser")
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		} else if err != nil {
+			_ = tx.Rollback()
+		} else {
+			err = tx.Commit()
+		}
+	}()
+
 	insertQuery := "INSERT INTO users (user_uuid, user_name, age, gender, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)"
 	now := time.Now().UTC()
-	_, err := m.db.ExecContext(ctx, insertQuery, user.UUID(), user.Name(), user.Age(), user.Gender(), now, now)
+	_, err = tx.ExecContext(ctx, insertQuery, user.UUID(), user.Name(), user.Age(), user.Gender(), now, now)
 	if err != nil {
 		return errors.Wrapf(err, "failed to insert user %s", user.UUID())
 	}
-	return nil
+
+	return events.StoreInOutbox(ctx, tx, userDomain.NewUserCreated(user))
 }
 
 // GetUser 根据用户UUID查找用户
-func (m MySQLUserRepository) GetUser(ctx context.Context, userUUID string) (*userDomain.User, error) {
+func (m MySQLUserRepository) GetUser(ctx context.Context, userUUID string) (_ *userDomain.User, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "MySQLUserRepository.GetUser")
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
 	selectQuery := "SELECT user_uuid, user_name, age, gender, created_at, updated_at FROM users WHERE user_uuid = ?"
 	row := m.db.QueryRowContext(ctx, selectQuery, userUUID)
 
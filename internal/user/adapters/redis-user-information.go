@@ -0,0 +1,173 @@
+package adapters
+
+import (
+	"context"
+	"encoding/json"
+	"math/rand/v2"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/cache"
+	"newTiktoken/internal/common/config"
+	"newTiktoken/internal/common/tracing"
+	"newTiktoken/internal/user/app/query"
+)
+
+// notFoundMarker 缓存不存在的用户，避免不存在的 UUID 每次都查询数据库
+const notFoundMarker = "-"
+
+// CachedUserFinder 以 cache-aside 方式在 readModel 前加一层 Redis 缓存
+// Redis 出错时直接回退到 readModel，缓存只影响性能不影响正确性
+// 用户信息或关注数变化后由 UserInformationInvalidator 删除缓存，TTL 兜底其他来源的修改（如关注数对账）
+type CachedUserFinder struct {
+	client    redis.UniversalClient
+	readModel query.InformationOfUserReadModel
+	cfg       config.CacheConfig
+	logger    *logrus.Entry
+}
+
+func NewCachedUserFinder(
+	client redis.UniversalClient,
+	readModel query.InformationOfUserReadModel,
+	cfg config.CacheConfig,
+	logger *logrus.Entry,
+) CachedUserFinder {
+	if client == nil {
+		panic("nil redis client")
+	}
+	if readModel == nil {
+		panic("nil readModel")
+	}
+	if logger == nil {
+		panic("nil logger")
+	}
+	return CachedUserFinder{client: client, readModel: readModel, cfg: cfg, logger: logger}
+}
+
+func (c CachedUserFinder) FindInformationOfUser(ctx context.Context, userUUID string) (_ *query.User, err error) {
+	ctx, span := tracing.StartRedisSpan(ctx, "CachedUserFinder.FindInformationOfUser")
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	cached, err := c.client.Get(ctx, cache.UserInformationKey(userUUID)).Result()
+	switch {
+	case err == nil:
+		usr, found, err := decodeCachedUser(cached)
+		if err == nil {
+			if !found {
+				return nil, nil
+			}
+			return usr, nil
+		}
+		c.logger.WithError(err).WithField("user_uuid", userUUID).Warn("Ignoring invalid cached user information")
+	case !errors.Is(err, redis.Nil):
+		c.logger.WithError(err).Warn("Unable to read user information cache")
+	}
+
+	usr, err := c.readModel.FindInformationOfUser(ctx, userUUID)
+	if err != nil {
+		return nil, err
+	}
+	c.store(ctx, map[string]*query.User{userUUID: usr})
+	return usr, nil
+}
+
+func (c CachedUserFinder) FindInformationOfUsers(ctx context.Context, userUUIDs []string) (_ []query.User, err error) {
+	ctx, span := tracing.StartRedisSpan(ctx, "CachedUserFinder.FindInformationOfUsers")
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	if len(userUUIDs) == 0 {
+		return nil, nil
+	}
+	keys := make([]string, 0, len(userUUIDs))
+	for _, userUUID := range userUUIDs {
+		keys = append(keys, cache.UserInformationKey(userUUID))
+	}
+
+	cachedValues, err := c.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		c.logger.WithError(err).Warn("Unable to read user information cache")
+		cachedValues = make([]interface{}, len(userUUIDs))
+	}
+
+	users := make([]query.User, 0, len(userUUIDs))
+	var missing []string
+	for i, userUUID := range userUUIDs {
+		cached, ok := cachedValues[i].(string)
+		if !ok {
+			missing = append(missing, userUUID)
+			continue
+		}
+		usr, found, err := decodeCachedUser(cached)
+		if err != nil {
+			missing = append(missing, userUUID)
+			continue
+		}
+		if found {
+			users = append(users, *usr)
+		}
+	}
+	if len(missing) == 0 {
+		return users, nil
+	}
+
+	loaded, err := c.readModel.FindInformationOfUsers(ctx, missing)
+	if err != nil {
+		return nil, err
+	}
+	toStore := make(map[string]*query.User, len(missing))
+	for _, userUUID := range missing {
+		toStore[userUUID] = nil
+	}
+	for i := range loaded {
+		toStore[loaded[i].UUID] = &loaded[i]
+	}
+	c.store(ctx, toStore)
+
+	return append(users, loaded...), nil
+}
+
+// store 缓存查询结果，值为 nil 的用户按不存在缓存 NegativeTTL
+func (c CachedUserFinder) store(ctx context.Context, users map[string]*query.User) {
+	_, err := c.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for userUUID, usr := range users {
+			if usr == nil {
+				pipe.Set(ctx, cache.UserInformationKey(userUUID), notFoundMarker, c.cfg.NegativeTTL)
+				continue
+			}
+			encoded, err := json.Marshal(usr)
+			if err != nil {
+				return errors.Wrapf(err, "failed to encode user %s", userUUID)
+			}
+			pipe.Set(ctx, cache.UserInformationKey(userUUID), encoded, c.ttl())
+		}
+		return nil
+	})
+	if err != nil {
+		c.logger.WithError(err).Warn("Unable to write user information cache")
+	}
+}
+
+// ttl 在 TTL 上加随机抖动，避免同一批写入的缓存同时过期
+func (c CachedUserFinder) ttl() time.Duration {
+	if c.cfg.Jitter <= 0 {
+		return c.cfg.TTL
+	}
+	return c.cfg.TTL + rand.N(c.cfg.Jitter)
+}
+
+func decodeCachedUser(cached string) (usr *query.User, found bool, err error) {
+	if cached == notFoundMarker {
+		return nil, false, nil
+	}
+	usr = &query.User{}
+	if err := json.Unmarshal([]byte(cached), usr); err != nil {
+		return nil, false, errors.Wrap(err, "failed to decode cached user")
+	}
+	return usr, true, nil
+}
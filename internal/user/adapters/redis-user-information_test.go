@@ -0,0 +1,165 @@
+package adapters_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/cache"
+	"newTiktoken/internal/common/config"
+	"newTiktoken/internal/user/adapters"
+	"newTiktoken/internal/user/app/query"
+)
+
+// countingReadModel 记录实际落到下层读模型的查询次数
+type countingReadModel struct {
+	query.InformationOfUserReadModel
+	single atomic.Int32
+	batch  atomic.Int32
+}
+
+func (c *countingReadModel) FindInformationOfUser(ctx context.Context, userUUID string) (*query.User, error) {
+	c.single.Add(1)
+	return c.InformationOfUserReadModel.FindInformationOfUser(ctx, userUUID)
+}
+
+func (c *countingReadModel) FindInformationOfUsers(ctx context.Context, userUUIDs []string) ([]query.User, error) {
+	c.batch.Add(1)
+	return c.InformationOfUserReadModel.FindInformationOfUsers(ctx, userUUIDs)
+}
+
+type cachedFinderFixture struct {
+	redis      *miniredis.Miniredis
+	repository *adapters.MemoryUserRepository
+	readModel  *countingReadModel
+	finder     adapters.CachedUserFinder
+	cfg        config.CacheConfig
+}
+
+func newCachedFinderFixture(t *testing.T) cachedFinderFixture {
+	t.Helper()
+	redisServer := miniredis.RunT(t)
+	redisClient := redis.NewClient(&redis.Options{Addr: redisServer.Addr(), MaxRetries: -1})
+	t.Cleanup(func() { _ = redisClient.Close() })
+
+	repository := adapters.NewMemoryUserRepository()
+	readModel := &countingReadModel{InformationOfUserReadModel: adapters.NewMemoryUserFinder(repository)}
+	cfg := config.CacheConfig{
+		Enabled:     true,
+		TTL:         10 * time.Minute,
+		Jitter:      time.Minute,
+		NegativeTTL: 30 * time.Second,
+	}
+	return cachedFinderFixture{
+		redis:      redisServer,
+		repository: repository,
+		readModel:  readModel,
+		finder:     adapters.NewCachedUserFinder(redisClient, readModel, cfg, logrus.NewEntry(logrus.StandardLogger())),
+		cfg:        cfg,
+	}
+}
+
+func TestCachedUserFinderServesHitsFromCache(t *testing.T) {
+	f := newCachedFinderFixture(t)
+	ctx := context.Background()
+	usr := addExampleUser(t, f.repository)
+
+	for i := 0; i < 3; i++ {
+		found, err := f.finder.FindInformationOfUser(ctx, usr.UUID())
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertQueryUserEquals(t, usr, *found)
+	}
+	if calls := f.readModel.single.Load(); calls != 1 {
+		t.Errorf("expected 1 read model call, got %d", calls)
+	}
+
+	ttl := f.redis.TTL(cache.UserInformationKey(usr.UUID()))
+	if ttl < f.cfg.TTL || ttl >= f.cfg.TTL+f.cfg.Jitter {
+		t.Errorf("expected ttl in [%s, %s), got %s", f.cfg.TTL, f.cfg.TTL+f.cfg.Jitter, ttl)
+	}
+}
+
+func TestCachedUserFinderCachesMissingUsers(t *testing.T) {
+	f := newCachedFinderFixture(t)
+	ctx := context.Background()
+	missingUUID := uuid.NewString()
+
+	for i := 0; i < 3; i++ {
+		found, err := f.finder.FindInformationOfUser(ctx, missingUUID)
+		if err != nil || found != nil {
+			t.Fatalf("expected missing user, got %+v, %v", found, err)
+		}
+	}
+	if calls := f.readModel.single.Load(); calls != 1 {
+		t.Errorf("expected 1 read model call, got %d", calls)
+	}
+	if ttl := f.redis.TTL(cache.UserInformationKey(missingUUID)); ttl != f.cfg.NegativeTTL {
+		t.Errorf("expected negative ttl %s, got %s", f.cfg.NegativeTTL, ttl)
+	}
+
+	f.redis.FastForward(f.cfg.NegativeTTL)
+	if _, err := f.finder.FindInformationOfUser(ctx, missingUUID); err != nil {
+		t.Fatal(err)
+	}
+	if calls := f.readModel.single.Load(); calls != 2 {
+		t.Errorf("expected read model to be queried after negative ttl, got %d calls", calls)
+	}
+}
+
+func TestCachedUserFinderBatchLoadsOnlyMisses(t *testing.T) {
+	f := newCachedFinderFixture(t)
+	ctx := context.Background()
+	cached := addExampleUser(t, f.repository)
+	uncached := addExampleUser(t, f.repository)
+	missingUUID := uuid.NewString()
+
+	if _, err := f.finder.FindInformationOfUser(ctx, cached.UUID()); err != nil {
+		t.Fatal(err)
+	}
+
+	found, err := f.finder.FindInformationOfUsers(ctx, []string{cached.UUID(), uncached.UUID(), missingUUID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(found) != 2 {
+		t.Fatalf("expected 2 users, got %+v", found)
+	}
+	if !f.redis.Exists(cache.UserInformationKey(uncached.UUID())) || !f.redis.Exists(cache.UserInformationKey(missingUUID)) {
+		t.Error("expected loaded and missing users to be cached")
+	}
+
+	if _, err := f.finder.FindInformationOfUsers(ctx, []string{cached.UUID(), uncached.UUID(), missingUUID}); err != nil {
+		t.Fatal(err)
+	}
+	if calls := f.readModel.batch.Load(); calls != 1 {
+		t.Errorf("expected 1 batch read model call, got %d", calls)
+	}
+}
+
+func TestCachedUserFinderFallsBackWhenRedisIsDown(t *testing.T) {
+	f := newCachedFinderFixture(t)
+	ctx := context.Background()
+	usr := addExampleUser(t, f.repository)
+	f.redis.Close()
+
+	found, err := f.finder.FindInformationOfUser(ctx, usr.UUID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertQueryUserEquals(t, usr, *found)
+
+	users, err := f.finder.FindInformationOfUsers(ctx, []string{usr.UUID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(users) != 1 {
+		t.Errorf("expected 1 user, got %+v", users)
+	}
+}
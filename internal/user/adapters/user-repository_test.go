@@ -0,0 +1,409 @@
+package adapters_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/alicebob/miniredis/v2"
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/cache"
+	"newTiktoken/internal/common/config"
+	"newTiktoken/internal/user/adapters"
+	"newTiktoken/internal/user/app/query"
+	userDomain "newTiktoken/internal/user/domain/user"
+	"os"
+	"sync"
+	"testing"
+)
+
+// repositories 是同一份存储上的写模型和读模型，contract 测试对每一种实现都运行一遍
+type repositories struct {
+	Name       string
+	Repository userDomain.Repository
+	ReadModel  query.InformationOfUserReadModel
+}
+
+func createRepositories(t *testing.T) []repositories {
+	t.Helper()
+	memoryRepository := adapters.NewMemoryUserRepository()
+	repos := []repositories{
+		{
+			Name:       "memory",
+			Repository: memoryRepository,
+			ReadModel:  adapters.NewMemoryUserFinder(memoryRepository),
+		},
+		newCachedRepositories(t),
+	}
+
+	// MySQL 实现只有在提供 MYSQL_DSN 时才参与测试，数据库需要先执行 cmd/migrate up
+	if dsn := os.Getenv("MYSQL_DSN"); dsn != "" {
+		db, err := sql.Open("mysql", dsn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { _ = db.Close() })
+		mysqlRepository, err := adapters.NewMySQLUserRepository(db)
+		if err != nil {
+			t.Fatal(err)
+		}
+		mysqlFinder, err := adapters.NewMySQLUserFinder(db)
+		if err != nil {
+			t.Fatal(err)
+		}
+		repos = append(repos, repositories{
+			Name:       "mysql",
+			Repository: mysqlRepository,
+			ReadModel:  mysqlFinder,
+		})
+	}
+	return repos
+}
+
+// newCachedRepositories 在内存实现前加上 miniredis 缓存，验证缓存不改变读写语义
+func newCachedRepositories(t *testing.T) repositories {
+	t.Helper()
+	redisClient := redis.NewClient(&redis.Options{Addr: miniredis.RunT(t).Addr()})
+	t.Cleanup(func() { _ = redisClient.Close() })
+	logger := logrus.NewEntry(logrus.StandardLogger())
+
+	memoryRepository := adapters.NewMemoryUserRepository()
+	return repositories{
+		Name: "cached",
+		Repository: adapters.NewCacheInvalidatingUserRepository(
+			memoryRepository,
+			cache.NewUserInformationInvalidator(redisClient),
+			logger,
+		),
+		ReadModel: adapters.NewCachedUserFinder(
+			redisClient,
+			adapters.NewMemoryUserFinder(memoryRepository),
+			config.Default().Cache,
+			logger,
+		),
+	}
+}
+
+func TestRepository(t *testing.T) {
+	t.Parallel()
+	for _, r := range createRepositories(t) {
+		r := r
+		t.Run(r.Name, func(t *testing.T) {
+			t.Parallel()
+
+			t.Run("testGetMissingUser", func(t *testing.T) {
+				t.Parallel()
+				testGetMissingUser(t, r.Repository)
+			})
+			t.Run("testAddUser", func(t *testing.T) {
+				t.Parallel()
+				testAddUser(t, r.Repository)
+			})
+			t.Run("testAddExistingUser", func(t *testing.T) {
+				t.Parallel()
+				testAddExistingUser(t, r.Repository)
+			})
+			t.Run("testUpdateUser", func(t *testing.T) {
+				t.Parallel()
+				testUpdateUser(t, r.Repository)
+			})
+			t.Run("testUpdateMissingUser", func(t *testing.T) {
+				t.Parallel()
+				testUpdateMissingUser(t, r.Repository)
+			})
+			t.Run("testUpdateUserRollback", func(t *testing.T) {
+				t.Parallel()
+				testUpdateUserRollback(t, r.Repository)
+			})
+			t.Run("testUpdateUserInParallel", func(t *testing.T) {
+				t.Parallel()
+				testUpdateUserInParallel(t, r.Repository)
+			})
+			t.Run("testFindInformationOfUser", func(t *testing.T) {
+				t.Parallel()
+				testFindInformationOfUser(t, r.Repository, r.ReadModel)
+			})
+			t.Run("testFindInformationOfUsers", func(t *testing.T) {
+				t.Parallel()
+				testFindInformationOfUsers(t, r.Repository, r.ReadModel)
+			})
+			t.Run("testFindInformationAfterWrites", func(t *testing.T) {
+				t.Parallel()
+				testFindInformationAfterWrites(t, r.Repository, r.ReadModel)
+			})
+		})
+	}
+}
+
+func testGetMissingUser(t *testing.T, repository userDomain.Repository) {
+	usr, err := repository.GetUser(context.Background(), uuid.NewString())
+	if err != nil {
+		t.Fatalf("expected nil error for missing user, got %v", err)
+	}
+	if usr != nil {
+		t.Fatalf("expected nil user for missing user, got %+v", usr)
+	}
+}
+
+func testAddUser(t *testing.T, repository userDomain.Repository) {
+	ctx := context.Background()
+	usr := newExampleUser(t, 18, 1)
+
+	if err := repository.AddUser(ctx, usr); err != nil {
+		t.Fatal(err)
+	}
+	assertPersistedUserEquals(t, repository, usr)
+}
+
+func testAddExistingUser(t *testing.T, repository userDomain.Repository) {
+	ctx := context.Background()
+	usr := addExampleUser(t, repository)
+
+	if err := repository.AddUser(ctx, usr); err == nil {
+		t.Fatal("expected error when adding existing user")
+	}
+}
+
+func testUpdateUser(t *testing.T, repository userDomain.Repository) {
+	ctx := context.Background()
+	usr := addExampleUser(t, repository)
+
+	err := repository.UpdateUser(ctx, usr.UUID(), func(_ context.Context, found *userDomain.User) (*userDomain.User, error) {
+		if err := found.ChangeUserName("updated-name"); err != nil {
+			return nil, err
+		}
+		if err := found.ChangeAge(42); err != nil {
+			return nil, err
+		}
+		if err := found.ChangeGender(2); err != nil {
+			return nil, err
+		}
+		return found, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected, err := userDomain.UnmarshalUserFromDatabase(usr.UUID(), "updated-name", 42, 2, usr.CreatedAt(), usr.UpdatedAt())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertPersistedUserEquals(t, repository, expected)
+}
+
+func testUpdateMissingUser(t *testing.T, repository userDomain.Repository) {
+	called := false
+	err := repository.UpdateUser(context.Background(), uuid.NewString(), func(_ context.Context, found *userDomain.User) (*userDomain.User, error) {
+		called = true
+		return found, nil
+	})
+	if !errors.Is(err, userDomain.ErrUserNotFound) {
+		t.Fatalf("expected ErrUserNotFound when updating missing user, got %v", err)
+	}
+	if called {
+		t.Error("updateFn should not be called for missing user")
+	}
+}
+
+func testUpdateUserRollback(t *testing.T, repository userDomain.Repository) {
+	ctx := context.Background()
+	usr := addExampleUser(t, repository)
+	errUpdate := errors.New("update failed")
+
+	err := repository.UpdateUser(ctx, usr.UUID(), func(_ context.Context, found *userDomain.User) (*userDomain.User, error) {
+		if err := found.ChangeUserName("should-not-be-saved"); err != nil {
+			return nil, err
+		}
+		return nil, errUpdate
+	})
+	if !errors.Is(err, errUpdate) {
+		t.Fatalf("expected updateFn error, got %v", err)
+	}
+	assertPersistedUserEquals(t, repository, usr)
+}
+
+func testUpdateUserInParallel(t *testing.T, repository userDomain.Repository) {
+	ctx := context.Background()
+	usr := addExampleUser(t, repository)
+
+	const workers = 10
+	wg := sync.WaitGroup{}
+	errs := make(chan error, workers)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(age uint16) {
+			defer wg.Done()
+			errs <- repository.UpdateUser(ctx, usr.UUID(), func(_ context.Context, found *userDomain.User) (*userDomain.User, error) {
+				if err := found.ChangeAge(age); err != nil {
+					return nil, err
+				}
+				return found, nil
+			})
+		}(uint16(20 + i))
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	persisted, err := repository.GetUser(ctx, usr.UUID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if persisted.Age() < 20 || persisted.Age() >= 20+workers {
+		t.Errorf("expected age written by one of the workers, got %d", persisted.Age())
+	}
+}
+
+func testFindInformationOfUser(t *testing.T, repository userDomain.Repository, readModel query.InformationOfUserReadModel) {
+	ctx := context.Background()
+	usr := addExampleUser(t, repository)
+
+	found, err := readModel.FindInformationOfUser(ctx, usr.UUID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found == nil {
+		t.Fatal("expected user to be found")
+	}
+	assertQueryUserEquals(t, usr, *found)
+
+	missing, err := readModel.FindInformationOfUser(ctx, uuid.NewString())
+	if err != nil {
+		t.Fatalf("expected nil error for missing user, got %v", err)
+	}
+	if missing != nil {
+		t.Fatalf("expected nil user for missing user, got %+v", missing)
+	}
+}
+
+func testFindInformationOfUsers(t *testing.T, repository userDomain.Repository, readModel query.InformationOfUserReadModel) {
+	ctx := context.Background()
+	first := addExampleUser(t, repository)
+	second := addExampleUser(t, repository)
+
+	found, err := readModel.FindInformationOfUsers(ctx, []string{first.UUID(), uuid.NewString(), second.UUID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(found) != 2 {
+		t.Fatalf("expected 2 users, got %d", len(found))
+	}
+	foundByUUID := map[string]query.User{}
+	for _, usr := range found {
+		foundByUUID[usr.UUID] = usr
+	}
+	for _, usr := range []*userDomain.User{first, second} {
+		foundUser, ok := foundByUUID[usr.UUID()]
+		if !ok {
+			t.Fatalf("user %s not found", usr.UUID())
+		}
+		assertQueryUserEquals(t, usr, foundUser)
+	}
+}
+
+// testFindInformationAfterWrites 确认读模型在用户创建和修改后不会返回之前读到的结果
+func testFindInformationAfterWrites(t *testing.T, repository userDomain.Repository, readModel query.InformationOfUserReadModel) {
+	ctx := context.Background()
+	usr := newExampleUser(t, 18, 1)
+
+	missing, err := readModel.FindInformationOfUser(ctx, usr.UUID())
+	if err != nil || missing != nil {
+		t.Fatalf("expected missing user before creation, got %+v, %v", missing, err)
+	}
+	if err := repository.AddUser(ctx, usr); err != nil {
+		t.Fatal(err)
+	}
+	found, err := readModel.FindInformationOfUser(ctx, usr.UUID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found == nil {
+		t.Fatal("expected user to be found after creation")
+	}
+
+	err = repository.UpdateUser(ctx, usr.UUID(), func(ctx context.Context, user *userDomain.User) (*userDomain.User, error) {
+		if err := user.ChangeAge(30); err != nil {
+			return nil, err
+		}
+		return user, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	found, err = readModel.FindInformationOfUser(ctx, usr.UUID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found.Age != 30 {
+		t.Errorf("expected updated age 30, got %d", found.Age)
+	}
+	users, err := readModel.FindInformationOfUsers(ctx, []string{usr.UUID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(users) != 1 || users[0].Age != 30 {
+		t.Errorf("expected updated age 30 in batch read, got %+v", users)
+	}
+}
+
+func newExampleUser(t *testing.T, age uint16, gender uint16) *userDomain.User {
+	t.Helper()
+	usr, err := userDomain.NewUser(uuid.NewString(), "example-user")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := usr.ChangeAge(age); err != nil {
+		t.Fatal(err)
+	}
+	if err := usr.ChangeGender(gender); err != nil {
+		t.Fatal(err)
+	}
+	return usr
+}
+
+func addExampleUser(t *testing.T, repository userDomain.Repository) *userDomain.User {
+	t.Helper()
+	usr := newExampleUser(t, 18, 1)
+	if err := repository.AddUser(context.Background(), usr); err != nil {
+		t.Fatal(err)
+	}
+	return usr
+}
+
+// assertPersistedUserEquals 不比较时间字段，MySQL 实现会使用写入时的时间
+func assertPersistedUserEquals(t *testing.T, repository userDomain.Repository, expected *userDomain.User) {
+	t.Helper()
+	persisted, err := repository.GetUser(context.Background(), expected.UUID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if persisted == nil {
+		t.Fatalf("user %s not persisted", expected.UUID())
+	}
+	if persisted.UUID() != expected.UUID() ||
+		persisted.Name() != expected.Name() ||
+		persisted.Age() != expected.Age() ||
+		persisted.Gender() != expected.Gender() {
+		t.Errorf("persisted user (%s, %s, %d, %d) doesn't match expected (%s, %s, %d, %d)",
+			persisted.UUID(), persisted.Name(), persisted.Age(), persisted.Gender(),
+			expected.UUID(), expected.Name(), expected.Age(), expected.Gender())
+	}
+}
+
+func assertQueryUserEquals(t *testing.T, expected *userDomain.User, found query.User) {
+	t.Helper()
+	if found.UUID != expected.UUID() ||
+		found.Name != expected.Name() ||
+		found.Age != expected.Age() ||
+		found.Gender != expected.Gender() {
+		t.Errorf("found user %+v doesn't match expected (%s, %s, %d, %d)",
+			found, expected.UUID(), expected.Name(), expected.Age(), expected.Gender())
+	}
+}
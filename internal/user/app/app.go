@@ -13,8 +13,14 @@ type Application struct {
 type Commands struct {
 	CreateUser command.CreateUserHandler
 	UpdateUser command.UpdateUserHandler
+	Register   command.RegisterHandler
+	Login      command.LoginHandler
+
+	RecordVideoPublished  command.RecordVideoPublishedHandler
+	RecordFavoriteChanged command.RecordFavoriteChangedHandler
 }
 
 type Queries struct {
-	InformationOfUser query.InformationOfUserHandler
+	InformationOfUser  query.InformationOfUserHandler
+	InformationOfUsers query.InformationOfUsersHandler
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/auth"
 	"newTiktoken/internal/common/decorator"
 	"newTiktoken/internal/common/logs"
 	"newTiktoken/internal/user/domain/user"
@@ -11,6 +12,8 @@ import (
 )
 
 type CreateUser struct {
+	User auth.User
+
 	UUID      string
 	Name      string
 	Age       uint16
@@ -29,6 +32,9 @@ func (c createUserHandler) Handle(ctx context.Context, cmd CreateUser) (err erro
 	defer func() {
 		logs.LogCommandExecution("CreateUser", cmd, err)
 	}()
+	if err := CreateUserPolicy.Authorize(cmd.User, cmd); err != nil {
+		return err
+	}
 	existingUser, err := c.repo.GetUser(ctx, cmd.UUID)
 	if err != nil {
 		return errors.Wrapf(err, "check %s is existed faild", cmd.UUID)
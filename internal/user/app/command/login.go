@@ -0,0 +1,153 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/auth"
+	"newTiktoken/internal/common/decorator"
+	"newTiktoken/internal/common/logs"
+	"newTiktoken/internal/user/domain/user"
+)
+
+type Login struct {
+	Username string
+	Password Password
+}
+
+type LoginResult struct {
+	UserUUID    string
+	AccessToken string
+	ExpiresAt   time.Time
+}
+
+type LoginHandler decorator.CommandWithResultHandler[Login, LoginResult]
+
+type loginHandler struct {
+	credentialsRepo user.CredentialsRepository
+	userRepo        user.Repository
+	hasher          PasswordHasher
+	issuer          TokenIssuer
+
+	// dummyHash 用于用户名不存在时仍然校验一次密码，使两种失败的耗时一致
+	dummyHash string
+}
+
+// Handle 在用户名不存在和密码错误时都返回 user.ErrInvalidCredentials
+func (h loginHandler) Handle(ctx context.Context, cmd Login) (result LoginResult, err error) {
+	defer func() {
+		logs.LogCommandExecution("Login", cmd, err)
+	}()
+
+	credentials, err := h.credentialsRepo.GetCredentialsByUsername(ctx, cmd.Username)
+	if err != nil {
+		return LoginResult{}, errors.Wrap(err, "unable to get credentials")
+	}
+	if credentials == nil {
+		if _, err := h.hasher.Verify(ctx, string(cmd.Password), h.dummyHash); err != nil {
+			return LoginResult{}, errors.Wrap(err, "unable to verify password")
+		}
+		return LoginResult{}, user.ErrInvalidCredentials
+	}
+
+	ok, err := h.hasher.Verify(ctx, string(cmd.Password), credentials.PasswordHash())
+	if err != nil {
+		return LoginResult{}, errors.Wrap(err, "unable to verify password")
+	}
+	if !ok {
+		return LoginResult{}, user.ErrInvalidCredentials
+	}
+	if h.hasher.NeedsRehash(credentials.PasswordHash()) {
+		h.rehash(ctx, credentials, cmd.Password)
+	}
+
+	usr, err := h.userRepo.GetUser(ctx, credentials.UserUUID())
+	if err != nil {
+		return LoginResult{}, errors.Wrap(err, "unable to get user")
+	}
+	if usr == nil {
+		return LoginResult{}, errors.Wrapf(user.ErrUserNotFound, "user %s of credentials", credentials.UserUUID())
+	}
+
+	token, err := h.issuer.IssueToken(auth.User{
+		UUID:        usr.UUID(),
+		Role:        auth.RoleUser,
+		DisplayName: usr.Name(),
+	})
+	if err != nil {
+		return LoginResult{}, errors.Wrap(err, "unable to issue access token")
+	}
+	return LoginResult{
+		UserUUID:    usr.UUID(),
+		AccessToken: token.Token,
+		ExpiresAt:   token.ExpiresAt,
+	}, nil
+}
+
+// rehash 使用当前参数重新计算密码哈希，失败只记录日志，不影响本次登录
+// 如果读取凭证后密码已被修改，则保留新的哈希
+func (h loginHandler) rehash(ctx context.Context, credentials *user.Credentials, password Password) {
+	passwordHash, err := h.hasher.Hash(ctx, string(password))
+	if err == nil {
+		err = h.credentialsRepo.UpdateCredentials(ctx, credentials.Username(), func(
+			_ context.Context,
+			found *user.Credentials,
+		) (*user.Credentials, error) {
+			if found.PasswordHash() != credentials.PasswordHash() {
+				return found, nil
+			}
+			if err := found.ChangePasswordHash(passwordHash); err != nil {
+				return nil, err
+			}
+			return found, nil
+		})
+	}
+	if err != nil {
+		logrus.WithError(err).WithField("user_uuid", credentials.UserUUID()).Warn("Unable to rehash password")
+	}
+}
+
+// NewLoginHandler 使用 dummyHasher 生成 dummyHash，生成失败时 panic，避免以无效的哈希启动
+// dummyHasher 应与 hasher 使用相同的参数，但不限制并发，启动时不占用 hasher 的计算名额
+func NewLoginHandler(
+	credentialsRepo user.CredentialsRepository,
+	userRepo user.Repository,
+	hasher PasswordHasher,
+	dummyHasher PasswordHasher,
+	issuer TokenIssuer,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) LoginHandler {
+	if credentialsRepo == nil {
+		panic("nil credentialsRepo")
+	}
+	if userRepo == nil {
+		panic("nil userRepo")
+	}
+	if hasher == nil {
+		panic("nil hasher")
+	}
+	if dummyHasher == nil {
+		panic("nil dummyHasher")
+	}
+	if issuer == nil {
+		panic("nil issuer")
+	}
+	dummyHash, err := dummyHasher.Hash(context.Background(), "dummy password")
+	if err != nil {
+		panic(errors.Wrap(err, "unable to generate dummy password hash"))
+	}
+	return decorator.ApplyCommandWithResultDecorators[Login, LoginResult](
+		loginHandler{
+			credentialsRepo: credentialsRepo,
+			userRepo:        userRepo,
+			hasher:          hasher,
+			issuer:          issuer,
+			dummyHash:       dummyHash,
+		},
+		logger,
+		metricsClient,
+	)
+}
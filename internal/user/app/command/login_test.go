@@ -0,0 +1,249 @@
+package command_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/auth"
+	"newTiktoken/internal/common/metrics"
+	"newTiktoken/internal/user/adapters"
+	"newTiktoken/internal/user/app/command"
+	userDomain "newTiktoken/internal/user/domain/user"
+)
+
+var testJWTSecret = []byte("test_secret")
+
+// testArgon2idParams 降低计算成本，只用于测试
+var testArgon2idParams = adapters.Argon2idParams{
+	Memory:      1024,
+	Iterations:  1,
+	Parallelism: 1,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+type authenticationDependencies struct {
+	CredentialsRepository *adapters.MemoryCredentialsRepository
+	UserRepository        *adapters.MemoryUserRepository
+	Register              command.RegisterHandler
+	Login                 command.LoginHandler
+}
+
+func newAuthenticationDependencies() authenticationDependencies {
+	userRepository := adapters.NewMemoryUserRepository()
+	deps := authenticationDependencies{
+		CredentialsRepository: adapters.NewMemoryCredentialsRepository(userRepository),
+		UserRepository:        userRepository,
+	}
+	deps.Register = command.NewRegisterHandler(
+		deps.CredentialsRepository,
+		adapters.NewArgon2idPasswordHasher(testArgon2idParams),
+		logrus.NewEntry(logrus.StandardLogger()),
+		metrics.NoOp{},
+	)
+	deps.Login = deps.newLoginHandler(testArgon2idParams)
+	return deps
+}
+
+func (d authenticationDependencies) newLoginHandler(params adapters.Argon2idParams) command.LoginHandler {
+	return command.NewLoginHandler(
+		d.CredentialsRepository,
+		d.UserRepository,
+		adapters.NewArgon2idPasswordHasher(params),
+		adapters.NewArgon2idPasswordHasher(params),
+		auth.JWTTokenIssuer{Secret: testJWTSecret, TTL: time.Hour},
+		logrus.NewEntry(logrus.StandardLogger()),
+		metrics.NoOp{},
+	)
+}
+
+func TestLoginIssuesVerifiableToken(t *testing.T) {
+	t.Parallel()
+	deps := newAuthenticationDependencies()
+	ctx := context.Background()
+	if err := deps.Register.Handle(ctx, command.Register{
+		UserUUID: "user-a",
+		Username: "Alice",
+		Password: "correct horse",
+		Name:     "Alice Liddell",
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := deps.Login.Handle(ctx, command.Login{Username: "alice", Password: "correct horse"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.UserUUID != "user-a" {
+		t.Errorf("expected user-a, got %s", result.UserUUID)
+	}
+
+	user, err := auth.JWTTokenVerifier{Secret: testJWTSecret}.VerifyToken(ctx, result.AccessToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := auth.User{UUID: "user-a", Role: auth.RoleUser, DisplayName: "Alice Liddell"}
+	if user != expected {
+		t.Errorf("expected %+v, got %+v", expected, user)
+	}
+}
+
+func TestLoginFailuresAreIndistinguishable(t *testing.T) {
+	t.Parallel()
+	deps := newAuthenticationDependencies()
+	ctx := context.Background()
+	if err := deps.Register.Handle(ctx, command.Register{
+		UserUUID: "user-a",
+		Username: "alice",
+		Password: "correct horse",
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		Name  string
+		Login command.Login
+	}{
+		{Name: "wrong_password", Login: command.Login{Username: "alice", Password: "battery staple"}},
+		{Name: "unknown_username", Login: command.Login{Username: "bob", Password: "correct horse"}},
+		{Name: "empty_password", Login: command.Login{Username: "alice"}},
+	}
+	for _, c := range testCases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+			_, err := deps.Login.Handle(ctx, c.Login)
+			if !errors.Is(err, userDomain.ErrInvalidCredentials) {
+				t.Fatalf("expected ErrInvalidCredentials, got %v", err)
+			}
+		})
+	}
+}
+
+// failingHasher 模拟无法生成哈希的实现，例如参数超出内存限制
+type failingHasher struct {
+	adapters.Argon2idPasswordHasher
+}
+
+func (failingHasher) Hash(context.Context, string) (string, error) {
+	return "", errors.New("out of memory")
+}
+
+// 生成 dummyHash 失败时不能以空哈希启动，否则不存在的用户名会立即返回，耗时与密码错误不同
+func TestNewLoginHandlerPanicsWhenDummyHashFails(t *testing.T) {
+	t.Parallel()
+	deps := newAuthenticationDependencies()
+	defer func() {
+		if recover() == nil {
+			t.Error("expected NewLoginHandler to panic when dummy hash cannot be generated")
+		}
+	}()
+	command.NewLoginHandler(
+		deps.CredentialsRepository,
+		deps.UserRepository,
+		adapters.NewArgon2idPasswordHasher(testArgon2idParams),
+		failingHasher{adapters.NewArgon2idPasswordHasher(testArgon2idParams)},
+		auth.JWTTokenIssuer{Secret: testJWTSecret, TTL: time.Hour},
+		logrus.NewEntry(logrus.StandardLogger()),
+		metrics.NoOp{},
+	)
+}
+
+func TestLoginRehashesOutdatedHash(t *testing.T) {
+	t.Parallel()
+	deps := newAuthenticationDependencies()
+	ctx := context.Background()
+	if err := deps.Register.Handle(ctx, command.Register{UserUUID: "user-a", Username: "alice", Password: "correct horse"}); err != nil {
+		t.Fatal(err)
+	}
+
+	upgradedParams := testArgon2idParams
+	upgradedParams.Iterations = 2
+	upgradedHasher := adapters.NewArgon2idPasswordHasher(upgradedParams)
+	login := deps.newLoginHandler(upgradedParams)
+
+	if _, err := login.Handle(ctx, command.Login{Username: "alice", Password: "battery staple"}); !errors.Is(err, userDomain.ErrInvalidCredentials) {
+		t.Fatalf("expected ErrInvalidCredentials, got %v", err)
+	}
+	credentials, err := deps.CredentialsRepository.GetCredentialsByUsername(ctx, "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !upgradedHasher.NeedsRehash(credentials.PasswordHash()) {
+		t.Fatal("failed login must not rehash the password")
+	}
+
+	if _, err := login.Handle(ctx, command.Login{Username: "alice", Password: "correct horse"}); err != nil {
+		t.Fatal(err)
+	}
+	credentials, err = deps.CredentialsRepository.GetCredentialsByUsername(ctx, "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if upgradedHasher.NeedsRehash(credentials.PasswordHash()) {
+		t.Errorf("expected hash to be upgraded, got %s", credentials.PasswordHash())
+	}
+
+	// 旧参数的 handler 仍然可以校验升级后的哈希
+	if _, err := deps.Login.Handle(ctx, command.Login{Username: "alice", Password: "correct horse"}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRegisterValidation(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		Name     string
+		Register command.Register
+	}{
+		{Name: "short_username", Register: command.Register{UserUUID: "user-a", Username: "al", Password: "correct horse"}},
+		{Name: "invalid_username", Register: command.Register{UserUUID: "user-a", Username: "alice smith", Password: "correct horse"}},
+		{Name: "short_password", Register: command.Register{UserUUID: "user-a", Username: "alice", Password: "short"}},
+		{Name: "long_password", Register: command.Register{UserUUID: "user-a", Username: "alice", Password: command.Password(strings.Repeat("x", 129))}},
+		{Name: "empty_uuid", Register: command.Register{Username: "alice", Password: "correct horse"}},
+	}
+	for _, c := range testCases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+			if err := newAuthenticationDependencies().Register.Handle(context.Background(), c.Register); err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
+
+func TestRegisterTakenUsername(t *testing.T) {
+	t.Parallel()
+	deps := newAuthenticationDependencies()
+	ctx := context.Background()
+	if err := deps.Register.Handle(ctx, command.Register{UserUUID: "user-a", Username: "alice", Password: "correct horse"}); err != nil {
+		t.Fatal(err)
+	}
+
+	err := deps.Register.Handle(ctx, command.Register{UserUUID: "user-b", Username: "ALICE", Password: "correct horse"})
+	if !errors.Is(err, userDomain.ErrUsernameTaken) {
+		t.Fatalf("expected ErrUsernameTaken, got %v", err)
+	}
+}
+
+func TestPasswordIsRedacted(t *testing.T) {
+	t.Parallel()
+	cmd := command.Login{Username: "alice", Password: "correct horse"}
+
+	body, err := json.Marshal(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, formatted := range []string{fmt.Sprintf("%v", cmd), fmt.Sprintf("%+v", cmd), fmt.Sprintf("%#v", cmd), string(body)} {
+		if strings.Contains(formatted, "correct horse") {
+			t.Errorf("password leaked in %s", formatted)
+		}
+	}
+}
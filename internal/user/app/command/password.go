@@ -0,0 +1,19 @@
+package command
+
+// redactedPassword 是密码在日志中的输出，日志装饰器会完整输出命令
+const redactedPassword = "[REDACTED]"
+
+// Password 是明文密码，格式化和序列化时都不会输出内容
+type Password string
+
+func (Password) String() string {
+	return redactedPassword
+}
+
+func (Password) GoString() string {
+	return `"` + redactedPassword + `"`
+}
+
+func (Password) MarshalText() ([]byte, error) {
+	return []byte(redactedPassword), nil
+}
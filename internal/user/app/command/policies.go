@@ -0,0 +1,15 @@
+package command
+
+import "newTiktoken/internal/common/auth"
+
+// CreateUserPolicy 用户只能创建自己的资料，管理员可以代为创建
+var CreateUserPolicy = auth.AnyOf(
+	auth.Owner(func(cmd CreateUser) string { return cmd.UUID }),
+	auth.Role[CreateUser](auth.RoleAdmin),
+)
+
+// UpdateUserPolicy 只有用户本人或管理员可以修改资料
+var UpdateUserPolicy = auth.AnyOf(
+	auth.Owner(func(cmd UpdateUser) string { return cmd.UUID }),
+	auth.Role[UpdateUser](auth.RoleAdmin),
+)
@@ -0,0 +1,57 @@
+package command
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/decorator"
+	userDomain "newTiktoken/internal/user/domain/user"
+)
+
+// RecordFavoriteChanged 把一次态度变化计入点赞用户的点赞数和视频作者的获赞数，EventUUID 用于消息重复投递时去重
+// VideoAuthorUUID 为空时只修改点赞用户的点赞数
+type RecordFavoriteChanged struct {
+	EventUUID       string
+	UserUUID        string
+	VideoAuthorUUID string
+	WasLiked        bool
+	IsLiked         bool
+}
+
+type RecordFavoriteChangedHandler decorator.CommandHandler[RecordFavoriteChanged]
+
+type recordFavoriteChangedHandler struct {
+	counter UserCounter
+}
+
+func (h recordFavoriteChangedHandler) Handle(ctx context.Context, cmd RecordFavoriteChanged) error {
+	var delta int64
+	switch {
+	case !cmd.WasLiked && cmd.IsLiked:
+		delta = 1
+	case cmd.WasLiked && !cmd.IsLiked:
+		delta = -1
+	default:
+		// 踩和取消踩不影响计数
+		return nil
+	}
+
+	changes := []userDomain.CountsChange{{UserUUID: cmd.UserUUID, FavoriteCount: delta}}
+	if cmd.VideoAuthorUUID != "" {
+		changes = append(changes, userDomain.CountsChange{UserUUID: cmd.VideoAuthorUUID, TotalFavorite: delta})
+	}
+	return h.counter.AddCounts(ctx, cmd.EventUUID, changes...)
+}
+
+func NewRecordFavoriteChangedHandler(counter UserCounter,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient) RecordFavoriteChangedHandler {
+	if counter == nil {
+		panic("nil counter")
+	}
+	return decorator.ApplyCommandDecorators[RecordFavoriteChanged](
+		recordFavoriteChangedHandler{counter: counter},
+		logger,
+		metricsClient,
+	)
+}
@@ -0,0 +1,43 @@
+package command
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/decorator"
+	userDomain "newTiktoken/internal/user/domain/user"
+)
+
+// RecordVideoPublished 把一次投稿计入作者的作品数，EventUUID 用于消息重复投递时去重
+type RecordVideoPublished struct {
+	EventUUID  string
+	AuthorUUID string
+}
+
+type RecordVideoPublishedHandler decorator.CommandHandler[RecordVideoPublished]
+
+// UserCounter 修改用户的计数，同一个 eventUUID 只能生效一次
+type UserCounter interface {
+	AddCounts(ctx context.Context, eventUUID string, changes ...userDomain.CountsChange) error
+}
+
+type recordVideoPublishedHandler struct {
+	counter UserCounter
+}
+
+func (h recordVideoPublishedHandler) Handle(ctx context.Context, cmd RecordVideoPublished) error {
+	return h.counter.AddCounts(ctx, cmd.EventUUID, userDomain.CountsChange{UserUUID: cmd.AuthorUUID, WorkCount: 1})
+}
+
+func NewRecordVideoPublishedHandler(counter UserCounter,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient) RecordVideoPublishedHandler {
+	if counter == nil {
+		panic("nil counter")
+	}
+	return decorator.ApplyCommandDecorators[RecordVideoPublished](
+		recordVideoPublishedHandler{counter: counter},
+		logger,
+		metricsClient,
+	)
+}
@@ -0,0 +1,79 @@
+package command
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/decorator"
+	"newTiktoken/internal/common/logs"
+	"newTiktoken/internal/user/domain/user"
+)
+
+// Register 由未认证的调用方发起，因此没有 User 字段和 Policy
+// UserUUID 由调用方生成，Name 为空时使用用户名作为昵称
+type Register struct {
+	UserUUID string
+	Username string
+	Password Password
+	Name     string
+}
+
+type RegisterHandler decorator.CommandHandler[Register]
+
+type registerHandler struct {
+	repo   user.CredentialsRepository
+	hasher PasswordHasher
+}
+
+func (h registerHandler) Handle(ctx context.Context, cmd Register) (err error) {
+	defer func() {
+		logs.LogCommandExecution("Register", cmd, err)
+	}()
+
+	// 先完成所有校验，避免为不合法的请求计算哈希
+	username := user.NormalizeUsername(cmd.Username)
+	if err := user.ValidateUsername(username); err != nil {
+		return err
+	}
+	if err := user.ValidatePassword(string(cmd.Password)); err != nil {
+		return err
+	}
+	name := cmd.Name
+	if name == "" {
+		name = username
+	}
+	usr, err := user.NewUser(cmd.UserUUID, name)
+	if err != nil {
+		return err
+	}
+
+	passwordHash, err := h.hasher.Hash(ctx, string(cmd.Password))
+	if err != nil {
+		return errors.Wrap(err, "unable to hash password")
+	}
+	credentials, err := user.NewCredentials(cmd.UserUUID, username, passwordHash)
+	if err != nil {
+		return err
+	}
+	return h.repo.RegisterUser(ctx, usr, credentials)
+}
+
+func NewRegisterHandler(
+	repo user.CredentialsRepository,
+	hasher PasswordHasher,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) RegisterHandler {
+	if repo == nil {
+		panic("nil repo")
+	}
+	if hasher == nil {
+		panic("nil hasher")
+	}
+	return decorator.ApplyCommandDecorators[Register](
+		registerHandler{repo: repo, hasher: hasher},
+		logger,
+		metricsClient,
+	)
+}
@@ -0,0 +1,20 @@
+package command
+
+import (
+	"context"
+
+	"newTiktoken/internal/common/auth"
+)
+
+// PasswordHasher 生成和校验 PHC 格式的密码哈希
+type PasswordHasher interface {
+	Hash(ctx context.Context, password string) (string, error)
+	// Verify 在密码不匹配时返回 false, nil，只有哈希格式错误等异常才返回错误
+	Verify(ctx context.Context, password string, encodedHash string) (bool, error)
+	// NeedsRehash 在哈希使用的参数与当前配置不同时返回 true
+	NeedsRehash(encodedHash string) bool
+}
+
+type TokenIssuer interface {
+	IssueToken(user auth.User) (auth.AccessToken, error)
+}
@@ -3,6 +3,7 @@ package command
 import (
 	"context"
 	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/auth"
 	"newTiktoken/internal/common/decorator"
 	"newTiktoken/internal/common/logs"
 	"newTiktoken/internal/user/domain/user"
@@ -10,6 +11,8 @@ import (
 )
 
 type UpdateUser struct {
+	User auth.User
+
 	UUID      string
 	Name      string
 	Gender    uint16
@@ -28,6 +31,9 @@ func (c updateUserHandler) Handle(ctx context.Context, cmd UpdateUser) (err erro
 	defer func() {
 		logs.LogCommandExecution("UpdateUser", cmd, err)
 	}()
+	if err := UpdateUserPolicy.Authorize(cmd.User, cmd); err != nil {
+		return err
+	}
 	if err := c.repo.UpdateUser(ctx, cmd.UUID, func(ctx context.Context, user *user.User) (*user.User, error) {
 		err := user.ChangeUserName(cmd.Name)
 		if err != nil {
@@ -41,7 +47,7 @@ func (c updateUserHandler) Handle(ctx context.Context, cmd UpdateUser) (err erro
 		if err != nil {
 			return nil, err
 		}
-		return nil, nil
+		return user, nil
 	}); err != nil {
 		return err
 	}
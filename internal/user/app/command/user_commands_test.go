@@ -0,0 +1,244 @@
+package command_test
+
+import (
+	"context"
+	"errors"
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/auth"
+	commonerrors "newTiktoken/internal/common/errors"
+	"newTiktoken/internal/common/metrics"
+	"newTiktoken/internal/user/adapters"
+	"newTiktoken/internal/user/app/command"
+	userDomain "newTiktoken/internal/user/domain/user"
+	"reflect"
+	"testing"
+)
+
+var userA = auth.User{UUID: "user-a", Role: "user"}
+
+func TestCreateUserIsIdempotent(t *testing.T) {
+	t.Parallel()
+	repository := adapters.NewMemoryUserRepository()
+	handler := command.NewCreateUserHandler(repository, logrus.NewEntry(logrus.StandardLogger()), metrics.NoOp{})
+	ctx := context.Background()
+
+	if err := handler.Handle(ctx, command.CreateUser{User: userA, UUID: "user-a", Name: "first"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := handler.Handle(ctx, command.CreateUser{User: userA, UUID: "user-a", Name: "second"}); err != nil {
+		t.Fatal(err)
+	}
+
+	usr, err := repository.GetUser(ctx, "user-a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if usr == nil || usr.Name() != "first" {
+		t.Fatalf("expected the first created user to be kept, got %+v", usr)
+	}
+}
+
+func TestUpdateUser(t *testing.T) {
+	t.Parallel()
+	repository := adapters.NewMemoryUserRepository()
+	logger := logrus.NewEntry(logrus.StandardLogger())
+	ctx := context.Background()
+	if err := command.NewCreateUserHandler(repository, logger, metrics.NoOp{}).Handle(ctx, command.CreateUser{
+		User: userA,
+		UUID: "user-a",
+		Name: "before",
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	err := command.NewUpdateUserHandler(repository, logger, metrics.NoOp{}).Handle(ctx, command.UpdateUser{
+		User:   userA,
+		UUID:   "user-a",
+		Name:   "after",
+		Age:    30,
+		Gender: 2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	usr, err := repository.GetUser(ctx, "user-a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if usr.Name() != "after" || usr.Age() != 30 || usr.Gender() != 2 {
+		t.Errorf("unexpected user after update: name=%s age=%d gender=%d", usr.Name(), usr.Age(), usr.Gender())
+	}
+}
+
+func TestUpdateUserRejectsInvalidAge(t *testing.T) {
+	t.Parallel()
+	repository := adapters.NewMemoryUserRepository()
+	logger := logrus.NewEntry(logrus.StandardLogger())
+	ctx := context.Background()
+	if err := command.NewCreateUserHandler(repository, logger, metrics.NoOp{}).Handle(ctx, command.CreateUser{
+		User: userA,
+		UUID: "user-a",
+		Name: "before",
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	err := command.NewUpdateUserHandler(repository, logger, metrics.NoOp{}).Handle(ctx, command.UpdateUser{
+		User: userA,
+		UUID: "user-a",
+		Name: "after",
+		Age:  200,
+	})
+	if err == nil {
+		t.Fatal("expected error for invalid age")
+	}
+
+	usr, err := repository.GetUser(ctx, "user-a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if usr.Name() != "before" {
+		t.Errorf("user should not be changed by failed update, got name %s", usr.Name())
+	}
+}
+
+func TestUpdateUserAuthorization(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		Name        string
+		User        auth.User
+		ExpectedErr bool
+	}{
+		{Name: "owner", User: userA},
+		{Name: "admin", User: auth.User{UUID: "admin-user", Role: auth.RoleAdmin}},
+		{Name: "other_user", User: auth.User{UUID: "user-b", Role: "user"}, ExpectedErr: true},
+		{Name: "anonymous", User: auth.User{}, ExpectedErr: true},
+	}
+	for _, c := range testCases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+			repository := adapters.NewMemoryUserRepository()
+			logger := logrus.NewEntry(logrus.StandardLogger())
+			ctx := context.Background()
+			if err := command.NewCreateUserHandler(repository, logger, metrics.NoOp{}).Handle(ctx, command.CreateUser{
+				User: userA,
+				UUID: "user-a",
+				Name: "before",
+			}); err != nil {
+				t.Fatal(err)
+			}
+
+			err := command.NewUpdateUserHandler(repository, logger, metrics.NoOp{}).Handle(ctx, command.UpdateUser{
+				User: c.User,
+				UUID: "user-a",
+				Name: "after",
+			})
+			if !c.ExpectedErr {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			var slugError commonerrors.SlugError
+			if !errors.As(err, &slugError) || slugError.ErrorType() != commonerrors.ErrorTypeAuthorization {
+				t.Fatalf("expected authorization error, got %v", err)
+			}
+			usr, err := repository.GetUser(ctx, "user-a")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if usr.Name() != "before" {
+				t.Errorf("denied update changed user name to %s", usr.Name())
+			}
+		})
+	}
+}
+
+type userCounterStub struct {
+	eventUUID string
+	changes   []userDomain.CountsChange
+}
+
+func (s *userCounterStub) AddCounts(_ context.Context, eventUUID string, changes ...userDomain.CountsChange) error {
+	s.eventUUID = eventUUID
+	s.changes = append(s.changes, changes...)
+	return nil
+}
+
+func TestRecordVideoPublishedIncreasesWorkCount(t *testing.T) {
+	t.Parallel()
+	counter := &userCounterStub{}
+	handler := command.NewRecordVideoPublishedHandler(counter, logrus.NewEntry(logrus.StandardLogger()), metrics.NoOp{})
+
+	if err := handler.Handle(context.Background(), command.RecordVideoPublished{
+		EventUUID:  "event-1",
+		AuthorUUID: "user-a",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	expected := []userDomain.CountsChange{{UserUUID: "user-a", WorkCount: 1}}
+	if counter.eventUUID != "event-1" || !reflect.DeepEqual(counter.changes, expected) {
+		t.Errorf("expected %+v for event-1, got %+v for %s", expected, counter.changes, counter.eventUUID)
+	}
+}
+
+func TestRecordFavoriteChangedUpdatesFavoriteCounts(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		Name            string
+		VideoAuthorUUID string
+		WasLiked        bool
+		IsLiked         bool
+		Expected        []userDomain.CountsChange
+	}{
+		{
+			Name:            "like",
+			VideoAuthorUUID: "author",
+			IsLiked:         true,
+			Expected: []userDomain.CountsChange{
+				{UserUUID: "user-a", FavoriteCount: 1},
+				{UserUUID: "author", TotalFavorite: 1},
+			},
+		},
+		{
+			Name:            "cancel_like",
+			VideoAuthorUUID: "author",
+			WasLiked:        true,
+			Expected: []userDomain.CountsChange{
+				{UserUUID: "user-a", FavoriteCount: -1},
+				{UserUUID: "author", TotalFavorite: -1},
+			},
+		},
+		{
+			Name:     "missing_video_author",
+			IsLiked:  true,
+			Expected: []userDomain.CountsChange{{UserUUID: "user-a", FavoriteCount: 1}},
+		},
+		{
+			Name:            "dislike",
+			VideoAuthorUUID: "author",
+		},
+	}
+	for _, c := range testCases {
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+			counter := &userCounterStub{}
+			handler := command.NewRecordFavoriteChangedHandler(counter, logrus.NewEntry(logrus.StandardLogger()), metrics.NoOp{})
+
+			if err := handler.Handle(context.Background(), command.RecordFavoriteChanged{
+				EventUUID:       "event-1",
+				UserUUID:        "user-a",
+				VideoAuthorUUID: c.VideoAuthorUUID,
+				WasLiked:        c.WasLiked,
+				IsLiked:         c.IsLiked,
+			}); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(counter.changes, c.Expected) {
+				t.Errorf("expected %+v, got %+v", c.Expected, counter.changes)
+			}
+		})
+	}
+}
@@ -14,7 +14,10 @@ type InformationOfUser struct {
 type InformationOfUserHandler decorator.QueryHandler[InformationOfUser, *User]
 
 type InformationOfUserReadModel interface {
+	// FindInformationOfUser 在用户不存在时返回 nil, nil
 	FindInformationOfUser(ctx context.Context, userUUID string) (*User, error)
+	// FindInformationOfUsers 用一次查询返回存在的用户，不存在的用户不出现在结果中，顺序不作保证
+	FindInformationOfUsers(ctx context.Context, userUUIDs []string) ([]User, error)
 }
 type informationOfUserHandler struct {
 	readModel InformationOfUserReadModel
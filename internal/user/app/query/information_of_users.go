@@ -0,0 +1,84 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/decorator"
+	commonErrors "newTiktoken/internal/common/errors"
+)
+
+// MaxBatchUsers 是单次批量查询允许的最大用户数
+const MaxBatchUsers = 500
+
+type InformationOfUsers struct {
+	UUIDs []string
+}
+
+// UsersInformation 中 Users 按请求顺序排列（重复的 UUID 只出现一次），MissingUUIDs 为不存在的用户
+type UsersInformation struct {
+	Users        []User
+	MissingUUIDs []string
+}
+
+type InformationOfUsersHandler decorator.QueryHandler[InformationOfUsers, UsersInformation]
+
+type informationOfUsersHandler struct {
+	readModel InformationOfUserReadModel
+}
+
+func (h informationOfUsersHandler) Handle(ctx context.Context, query InformationOfUsers) (UsersInformation, error) {
+	uuids := make([]string, 0, len(query.UUIDs))
+	seen := make(map[string]struct{}, len(query.UUIDs))
+	for _, uuid := range query.UUIDs {
+		if _, ok := seen[uuid]; ok {
+			continue
+		}
+		seen[uuid] = struct{}{}
+		uuids = append(uuids, uuid)
+	}
+	if len(uuids) > MaxBatchUsers {
+		return UsersInformation{}, commonErrors.NewIncorrectInputError(
+			fmt.Sprintf("at most %d users can be queried at once, got %d", MaxBatchUsers, len(uuids)),
+			"too-many-users",
+		)
+	}
+	if len(uuids) == 0 {
+		return UsersInformation{}, nil
+	}
+
+	found, err := h.readModel.FindInformationOfUsers(ctx, uuids)
+	if err != nil {
+		return UsersInformation{}, err
+	}
+	foundByUUID := make(map[string]User, len(found))
+	for _, usr := range found {
+		foundByUUID[usr.UUID] = usr
+	}
+
+	result := UsersInformation{Users: make([]User, 0, len(found))}
+	for _, uuid := range uuids {
+		usr, ok := foundByUUID[uuid]
+		if !ok {
+			result.MissingUUIDs = append(result.MissingUUIDs, uuid)
+			continue
+		}
+		result.Users = append(result.Users, usr)
+	}
+	return result, nil
+}
+
+func NewInformationOfUsersHandler(
+	readModel InformationOfUserReadModel,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) InformationOfUsersHandler {
+	if readModel == nil {
+		panic("nil readModel")
+	}
+	return decorator.ApplyQueryDecorators[InformationOfUsers, UsersInformation](
+		informationOfUsersHandler{readModel: readModel},
+		logger,
+		metricsClient,
+	)
+}
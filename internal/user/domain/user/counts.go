@@ -0,0 +1,10 @@
+package user
+
+// CountsChange 是一次事件对用户计数的修改，这些计数由其他服务的事件驱动
+// WorkCount 是作品数，TotalFavorite 是作品获赞总数，FavoriteCount 是点赞过的视频数
+type CountsChange struct {
+	UserUUID      string
+	WorkCount     int64
+	TotalFavorite int64
+	FavoriteCount int64
+}
@@ -0,0 +1,17 @@
+package user
+
+import "context"
+
+// CredentialsRepository 保存用户的登录凭证
+// 所有实现都需要通过 adapters 中的 credentials repository contract 测试
+type CredentialsRepository interface {
+	// RegisterUser 在同一事务中写入用户、凭证和 UserCreated 事件，用户名已被使用时返回 ErrUsernameTaken
+	RegisterUser(ctx context.Context, user *User, credentials *Credentials) error
+	// GetCredentialsByUsername 在用户名不存在时返回 nil, nil
+	GetCredentialsByUsername(ctx context.Context, username string) (*Credentials, error)
+	// UpdateCredentials 在用户名不存在（返回 ErrInvalidCredentials）或 updateFn 返回错误时不做任何修改并返回错误
+	UpdateCredentials(ctx context.Context, username string, updateFn func(
+		ctx context.Context,
+		credentials *Credentials,
+	) (*Credentials, error)) error
+}
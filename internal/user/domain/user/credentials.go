@@ -0,0 +1,123 @@
+package user
+
+import (
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	commonerrors "newTiktoken/internal/common/errors"
+)
+
+// ErrInvalidCredentials 是所有登录失败的统一错误，调用方无法据此判断用户名是否存在
+var ErrInvalidCredentials = commonerrors.NewAuthenticationError("invalid username or password", "invalid-credentials")
+
+var ErrUsernameTaken = commonerrors.NewConflictError("username is already taken", "username-taken")
+
+const (
+	minPasswordLength = 8
+	maxPasswordLength = 128
+)
+
+var usernamePattern = regexp.MustCompile(`^[a-z0-9_.-]{3,32}$`)
+
+// Credentials 是 User 的登录凭证，只保存密码的 PHC 格式哈希
+type Credentials struct {
+	userUUID     string
+	username     string
+	passwordHash string
+	createdAt    time.Time
+	updatedAt    time.Time
+}
+
+func NewCredentials(userUUID string, username string, passwordHash string) (*Credentials, error) {
+	if userUUID == "" {
+		return nil, commonerrors.NewIncorrectInputError("空的用户uuid", "empty-user-uuid")
+	}
+	username = NormalizeUsername(username)
+	if err := ValidateUsername(username); err != nil {
+		return nil, err
+	}
+	if passwordHash == "" {
+		return nil, commonerrors.NewIncorrectInputError("empty password hash", "empty-password-hash")
+	}
+	now := time.Now()
+	return &Credentials{
+		userUUID:     userUUID,
+		username:     username,
+		passwordHash: passwordHash,
+		createdAt:    now,
+		updatedAt:    now,
+	}, nil
+}
+
+func UnmarshalCredentialsFromDatabase(
+	userUUID string,
+	username string,
+	passwordHash string,
+	createdAt time.Time,
+	updatedAt time.Time,
+) (*Credentials, error) {
+	credentials, err := NewCredentials(userUUID, username, passwordHash)
+	if err != nil {
+		return nil, err
+	}
+	credentials.createdAt = createdAt
+	credentials.updatedAt = updatedAt
+	return credentials, nil
+}
+
+// NormalizeUsername 用户名不区分大小写，注册和登录前都需要先规范化
+func NormalizeUsername(username string) string {
+	return strings.ToLower(strings.TrimSpace(username))
+}
+
+// ValidateUsername 校验规范化后的用户名
+func ValidateUsername(username string) error {
+	if !usernamePattern.MatchString(username) {
+		return commonerrors.NewIncorrectInputError(
+			"username must be 3-32 characters of letters, digits, '_', '.' or '-'",
+			"invalid-username",
+		)
+	}
+	return nil
+}
+
+// ValidatePassword 在哈希前校验明文密码，长度按字符计算
+func ValidatePassword(password string) error {
+	length := utf8.RuneCountInString(password)
+	if length < minPasswordLength || length > maxPasswordLength {
+		return commonerrors.NewIncorrectInputError("password must be 8-128 characters", "invalid-password")
+	}
+	return nil
+}
+
+// ChangePasswordHash 在同一密码按新参数重新哈希后调用
+func (c *Credentials) ChangePasswordHash(passwordHash string) error {
+	if passwordHash == "" {
+		return commonerrors.NewIncorrectInputError("empty password hash", "empty-password-hash")
+	}
+	c.passwordHash = passwordHash
+	c.updatedAt = time.Now()
+	return nil
+}
+
+func (c Credentials) UserUUID() string {
+	return c.userUUID
+}
+
+func (c Credentials) Username() string {
+	return c.username
+}
+
+func (c Credentials) PasswordHash() string {
+	return c.passwordHash
+}
+
+func (c Credentials) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+func (c Credentials) UpdatedAt() time.Time {
+	return c.updatedAt
+}
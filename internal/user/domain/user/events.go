@@ -0,0 +1,49 @@
+package user
+
+import "time"
+
+// UserCreated 在新用户写入后产生
+type UserCreated struct {
+	UUID      string    `json:"uuid"`
+	Name      string    `json:"name"`
+	Age       uint16    `json:"age"`
+	Gender    uint16    `json:"gender"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (UserCreated) EventName() string {
+	return "UserCreated"
+}
+
+func NewUserCreated(user *User) UserCreated {
+	return UserCreated{
+		UUID:      user.UUID(),
+		Name:      user.Name(),
+		Age:       user.Age(),
+		Gender:    user.Gender(),
+		CreatedAt: user.CreatedAt().UTC(),
+	}
+}
+
+// UserUpdated 在用户资料更新后产生
+type UserUpdated struct {
+	UUID      string    `json:"uuid"`
+	Name      string    `json:"name"`
+	Age       uint16    `json:"age"`
+	Gender    uint16    `json:"gender"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (UserUpdated) EventName() string {
+	return "UserUpdated"
+}
+
+func NewUserUpdated(user *User) UserUpdated {
+	return UserUpdated{
+		UUID:      user.UUID(),
+		Name:      user.Name(),
+		Age:       user.Age(),
+		Gender:    user.Gender(),
+		UpdatedAt: user.UpdatedAt().UTC(),
+	}
+}
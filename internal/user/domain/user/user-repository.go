@@ -5,9 +5,13 @@ import (
 )
 
 // Repository 是user domain repository的接口
+// 所有实现都需要通过 adapters 中的 repository contract 测试
 type Repository interface {
+	// GetUser 在用户不存在时返回 nil, nil
 	GetUser(ctx context.Context, userUUID string) (*User, error)
+	// AddUser 在用户已存在时返回错误
 	AddUser(ctx context.Context, user *User) error
+	// UpdateUser 在用户不存在（返回 ErrUserNotFound）或 updateFn 返回错误时不做任何修改并返回错误
 	UpdateUser(ctx context.Context, userUUID string, updateFn func(
 		ctx context.Context,
 		user *User,
@@ -1,10 +1,12 @@
 package user
 
 import (
-	"github.com/pkg/errors"
+	commonerrors "newTiktoken/internal/common/errors"
 	"time"
 )
 
+var ErrUserNotFound = commonerrors.NewNotFoundError("user not found", "user-not-found")
+
 // User 是领域模型，代表一个用户
 type User struct {
 	uuid      string
@@ -18,10 +20,10 @@ type User struct {
 // NewUser 创建一个新的用户实例
 func NewUser(uuid string, name string) (*User, error) {
 	if uuid == "" {
-		return nil, errors.New("空的用户uuid")
+		return nil, commonerrors.NewIncorrectInputError("空的用户uuid", "empty-user-uuid")
 	}
 	if name == "" {
-		return nil, errors.New("空的用户名")
+		return nil, commonerrors.NewIncorrectInputError("空的用户名", "empty-user-name")
 	}
 	return &User{
 		uuid:      uuid,
@@ -78,7 +80,7 @@ func (u *User) ChangeUserName(userName string) error {
 		return nil
 	}
 	if userName == "" {
-		return errors.New("can't set user name to empty string")
+		return commonerrors.NewIncorrectInputError("can't set user name to empty string", "empty-user-name")
 	}
 	u.name = userName
 	u.updatedAt = time.Now()
@@ -87,7 +89,7 @@ func (u *User) ChangeUserName(userName string) error {
 
 func (u *User) ChangeGender(gender uint16) error {
 	if !(gender == 0 || gender == 1 || gender == 2) {
-		return errors.New("gender must be 0 or 1 or 2")
+		return commonerrors.NewIncorrectInputError("gender must be 0 or 1 or 2", "invalid-gender")
 	}
 	u.gender = gender
 	u.updatedAt = time.Now()
@@ -96,9 +98,9 @@ func (u *User) ChangeGender(gender uint16) error {
 
 func (u *User) ChangeAge(age uint16) error {
 	if age >= 150 {
-		return errors.New("age must be less than 150")
+		return commonerrors.NewIncorrectInputError("age must be less than 150", "invalid-age")
 	}
-	u.gender = age
+	u.age = age
 	u.updatedAt = time.Now()
 	return nil
 }
@@ -0,0 +1,64 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/events"
+	"newTiktoken/internal/user/app"
+	"newTiktoken/internal/user/app/command"
+)
+
+// videoPublished 是 video 服务发布的 VideoPublished 事件中用户服务需要的字段
+type videoPublished struct {
+	AuthorUUID string `json:"author_uuid"`
+}
+
+// favoriteChanged 是 video-favorite 服务发布的 FavoriteChanged 事件中用户服务需要的字段
+type favoriteChanged struct {
+	UserUUID        string `json:"user_uuid"`
+	VideoAuthorUUID string `json:"video_author_uuid"`
+	PreviousStatus  int    `json:"previous_status"`
+	Status          int    `json:"status"`
+}
+
+const (
+	videoPublishedEventName  = "VideoPublished"
+	favoriteChangedEventName = "FavoriteChanged"
+
+	// likedStatus 与 video-favorite 服务中 favorite.Liked.Int() 的值一致
+	likedStatus = 1
+)
+
+// NewEventHandlers 返回服务消费的事件：VideoPublished 更新作者的作品数，FavoriteChanged 更新点赞数和获赞数
+func NewEventHandlers(application app.Application) map[string]events.Handler {
+	return map[string]events.Handler{
+		videoPublishedEventName: events.JSONHandler(
+			func(ctx context.Context, eventUUID string, event videoPublished) error {
+				if event.AuthorUUID == "" {
+					logrus.WithField("event_uuid", eventUUID).Error("Skipping VideoPublished event without author")
+					return nil
+				}
+				return application.Commands.RecordVideoPublished.Handle(ctx, command.RecordVideoPublished{
+					EventUUID:  eventUUID,
+					AuthorUUID: event.AuthorUUID,
+				})
+			},
+		),
+		favoriteChangedEventName: events.JSONHandler(
+			func(ctx context.Context, eventUUID string, event favoriteChanged) error {
+				if event.UserUUID == "" {
+					logrus.WithField("event_uuid", eventUUID).Error("Skipping FavoriteChanged event without user")
+					return nil
+				}
+				return application.Commands.RecordFavoriteChanged.Handle(ctx, command.RecordFavoriteChanged{
+					EventUUID:       eventUUID,
+					UserUUID:        event.UserUUID,
+					VideoAuthorUUID: event.VideoAuthorUUID,
+					WasLiked:        event.PreviousStatus == likedStatus,
+					IsLiked:         event.Status == likedStatus,
+				})
+			},
+		),
+	}
+}
@@ -2,15 +2,16 @@ package ports
 
 import (
 	"context"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
 	"google.golang.org/protobuf/types/known/emptypb"
-	"log"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"newTiktoken/internal/common/auth"
 	userPb "newTiktoken/internal/common/genproto/user"
 	"newTiktoken/internal/user/app"
 	"newTiktoken/internal/user/app/command"
 	"newTiktoken/internal/user/app/query"
+	userDomain "newTiktoken/internal/user/domain/user"
 )
 
 type GrpcServer struct {
@@ -22,41 +23,107 @@ func NewGrpcServer(application app.Application) *GrpcServer {
 	return &GrpcServer{app: application}
 }
 
+// CreateUser 为请求中的 uuid 创建资料，uuid 为空时为当前认证用户创建，是否允许由 command.CreateUserPolicy 决定
 func (g *GrpcServer) CreateUser(ctx context.Context, req *userPb.CreateUserRequest) (*emptypb.Empty, error) {
+	user, err := auth.GRPCUserFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
 	if err := g.app.Commands.CreateUser.Handle(ctx, command.CreateUser{
-		UUID:   req.GetUuid(),
+		User:   user,
+		UUID:   targetUUID(user, req.GetUuid()),
 		Name:   req.GetName(),
 		Age:    uint16(req.GetAge()),
 		Gender: uint16(req.GetGender()),
 	}); err != nil {
-		log.Printf("ERROR: failed to handle command: %v", err)
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 	return &emptypb.Empty{}, nil
 }
 
+// UpdateUser 更新请求中 uuid 的资料，uuid 为空时更新当前认证用户，是否允许由 command.UpdateUserPolicy 决定
 func (g *GrpcServer) UpdateUser(ctx context.Context, req *userPb.UpdateUserRequest) (*emptypb.Empty, error) {
+	user, err := auth.GRPCUserFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
 	if err := g.app.Commands.UpdateUser.Handle(ctx, command.UpdateUser{
-		UUID:   req.GetUuid(),
+		User:   user,
+		UUID:   targetUUID(user, req.GetUuid()),
 		Name:   req.GetName(),
 		Age:    uint16(req.GetAge()),
 		Gender: uint16(req.GetGender()),
 	}); err != nil {
-		log.Printf("ERROR: failed to handle command: %v", err)
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 	return &emptypb.Empty{}, nil
 }
 
+// targetUUID 返回请求操作的用户，请求中没有指定时为当前认证用户
+func targetUUID(user auth.User, requestUUID string) string {
+	if requestUUID == "" {
+		return user.UUID
+	}
+	return requestUUID
+}
+
 func (g *GrpcServer) GetUserInformation(ctx context.Context, request *userPb.GetUserInformationRequest) (*userPb.User, error) {
 	usr, err := g.app.Queries.InformationOfUser.Handle(ctx, query.InformationOfUser{
 		User: auth.User{UUID: request.GetUuid()},
 	})
-	pbUser := queryUserToProtoUser(usr)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
+	}
+	if usr == nil {
+		return nil, errors.Wrapf(userDomain.ErrUserNotFound, "user %s not found", request.GetUuid())
+	}
+	return queryUserToProtoUser(usr), nil
+}
+
+func (g *GrpcServer) BatchGetUserInformation(ctx context.Context, request *userPb.BatchGetUserInformationRequest) (*userPb.BatchGetUserInformationResponse, error) {
+	result, err := g.app.Queries.InformationOfUsers.Handle(ctx, query.InformationOfUsers{
+		UUIDs: request.GetUuids(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	users := make([]*userPb.User, 0, len(result.Users))
+	for i := range result.Users {
+		users = append(users, queryUserToProtoUser(&result.Users[i]))
+	}
+	return &userPb.BatchGetUserInformationResponse{
+		Users:        users,
+		MissingUuids: result.MissingUUIDs,
+	}, nil
+}
+
+// Register 为未认证的调用方创建用户和登录凭证，用户 uuid 由服务端生成
+func (g *GrpcServer) Register(ctx context.Context, req *userPb.RegisterRequest) (*userPb.RegisterResponse, error) {
+	userUUID := uuid.NewString()
+	if err := g.app.Commands.Register.Handle(ctx, command.Register{
+		UserUUID: userUUID,
+		Username: req.GetUsername(),
+		Password: command.Password(req.GetPassword()),
+		Name:     req.GetName(),
+	}); err != nil {
+		return nil, err
+	}
+	return &userPb.RegisterResponse{UserUuid: userUUID}, nil
+}
+
+func (g *GrpcServer) Login(ctx context.Context, req *userPb.LoginRequest) (*userPb.LoginResponse, error) {
+	result, err := g.app.Commands.Login.Handle(ctx, command.Login{
+		Username: req.GetUsername(),
+		Password: command.Password(req.GetPassword()),
+	})
+	if err != nil {
+		return nil, err
 	}
-	return pbUser, nil
+	return &userPb.LoginResponse{
+		UserUuid:    result.UserUUID,
+		AccessToken: result.AccessToken,
+		ExpiresAt:   timestamppb.New(result.ExpiresAt),
+	}, nil
 }
 
 func queryUserToProtoUser(user *query.User) *userPb.User {
@@ -70,5 +137,7 @@ func queryUserToProtoUser(user *query.User) *userPb.User {
 		TotalFavorite:  user.TotalFavorite,
 		WorkCount:      user.WorkCount,
 		FavoriteCount:  user.FavoriteCount,
+		CreatedAt:      timestamppb.New(user.CreatedAt),
+		UpdatedAt:      timestamppb.New(user.UpdatedAt),
 	}
 }
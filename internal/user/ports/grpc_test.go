@@ -0,0 +1,118 @@
+package ports_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/auth"
+	commonerrors "newTiktoken/internal/common/errors"
+	userPb "newTiktoken/internal/common/genproto/user"
+	"newTiktoken/internal/common/metrics"
+	"newTiktoken/internal/user/adapters"
+	"newTiktoken/internal/user/app"
+	"newTiktoken/internal/user/app/command"
+	"newTiktoken/internal/user/ports"
+)
+
+var (
+	owner = auth.User{UUID: "user-a", Role: auth.RoleUser}
+	other = auth.User{UUID: "user-b", Role: auth.RoleUser}
+	admin = auth.User{UUID: "admin", Role: auth.RoleAdmin}
+)
+
+func newTestServer(t *testing.T) (*ports.GrpcServer, *adapters.MemoryUserRepository) {
+	t.Helper()
+	repository := adapters.NewMemoryUserRepository()
+	logger := logrus.NewEntry(logrus.StandardLogger())
+	server := ports.NewGrpcServer(app.Application{Commands: app.Commands{
+		CreateUser: command.NewCreateUserHandler(repository, logger, metrics.NoOp{}),
+		UpdateUser: command.NewUpdateUserHandler(repository, logger, metrics.NoOp{}),
+	}})
+
+	ctx := auth.ContextWithUser(context.Background(), owner)
+	if _, err := server.CreateUser(ctx, &userPb.CreateUserRequest{Name: "before"}); err != nil {
+		t.Fatal(err)
+	}
+	return server, repository
+}
+
+func TestUpdateUserOfAnotherUser(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		Name         string
+		Caller       auth.User
+		ExpectedName string
+		Denied       bool
+	}{
+		{Name: "owner", Caller: owner, ExpectedName: "after"},
+		{Name: "admin", Caller: admin, ExpectedName: "after"},
+		{Name: "other_user", Caller: other, ExpectedName: "before", Denied: true},
+	}
+	for _, c := range testCases {
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+			server, repository := newTestServer(t)
+			ctx := auth.ContextWithUser(context.Background(), c.Caller)
+
+			_, err := server.UpdateUser(ctx, &userPb.UpdateUserRequest{Uuid: owner.UUID, Name: "after", Age: 30, Gender: 1})
+			if c.Denied {
+				var slugErr commonerrors.SlugError
+				if !errors.As(err, &slugErr) || slugErr.ErrorType() != commonerrors.ErrorTypeAuthorization {
+					t.Fatalf("expected authorization error, got %v", err)
+				}
+			} else if err != nil {
+				t.Fatal(err)
+			}
+
+			usr, err := repository.GetUser(context.Background(), owner.UUID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if usr.Name() != c.ExpectedName {
+				t.Errorf("expected name %s, got %s", c.ExpectedName, usr.Name())
+			}
+		})
+	}
+}
+
+// 请求中没有 uuid 时更新当前认证用户
+func TestUpdateUserDefaultsToCaller(t *testing.T) {
+	t.Parallel()
+	server, repository := newTestServer(t)
+	ctx := auth.ContextWithUser(context.Background(), owner)
+
+	if _, err := server.UpdateUser(ctx, &userPb.UpdateUserRequest{Name: "after", Age: 30, Gender: 1}); err != nil {
+		t.Fatal(err)
+	}
+	usr, err := repository.GetUser(context.Background(), owner.UUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if usr.Name() != "after" {
+		t.Errorf("expected the caller to be updated, got %s", usr.Name())
+	}
+}
+
+func TestCreateUserForAnotherUser(t *testing.T) {
+	t.Parallel()
+	server, repository := newTestServer(t)
+
+	_, err := server.CreateUser(auth.ContextWithUser(context.Background(), other), &userPb.CreateUserRequest{Uuid: "user-c", Name: "c"})
+	var slugErr commonerrors.SlugError
+	if !errors.As(err, &slugErr) || slugErr.ErrorType() != commonerrors.ErrorTypeAuthorization {
+		t.Fatalf("expected authorization error, got %v", err)
+	}
+
+	if _, err := server.CreateUser(auth.ContextWithUser(context.Background(), admin), &userPb.CreateUserRequest{Uuid: "user-c", Name: "c"}); err != nil {
+		t.Fatal(err)
+	}
+	usr, err := repository.GetUser(context.Background(), "user-c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if usr == nil || usr.Name() != "c" {
+		t.Errorf("expected admin to create user-c, got %+v", usr)
+	}
+}
@@ -2,43 +2,137 @@ package service
 
 import (
 	"context"
-	"database/sql"
+	"time"
+
 	_ "github.com/go-sql-driver/mysql"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
-	"newTiktoken/internal/common/metrics"
+	"newTiktoken/internal/common/auth"
+	"newTiktoken/internal/common/cache"
+	"newTiktoken/internal/common/config"
+	"newTiktoken/internal/common/decorator"
+	"newTiktoken/internal/common/keys"
+	"newTiktoken/internal/common/server"
 	"newTiktoken/internal/user/adapters"
 	"newTiktoken/internal/user/app"
 	"newTiktoken/internal/user/app/command"
 	"newTiktoken/internal/user/app/query"
-	"os"
+	userDomain "newTiktoken/internal/user/domain/user"
 )
 
-func NewApplication(ctx context.Context) app.Application {
-	db, err := sql.Open("mysql", os.Getenv("MYSQL_DSN"))
+// NewApplication 返回应用、驱动 gRPC 健康状态的依赖检查以及退出时的清理函数
+// signer 不为 nil 时使用它的私钥签发 ES256 token，否则使用 auth.jwt_secret 签发 HS256 token
+func NewApplication(
+	ctx context.Context,
+	cfg config.Config,
+	metricsClient decorator.MetricsClient,
+	signer keys.Signer,
+) (app.Application, []server.HealthCheck, func()) {
+	db, err := cfg.MySQL.Open()
+	if err != nil {
+		panic(err)
+	}
+	mysqlUserRepository, err := adapters.NewMySQLUserRepository(db)
+	if err != nil {
+		panic(err)
+	}
+	mysqlUserFinder, err := adapters.NewMySQLUserFinder(db)
 	if err != nil {
 		panic(err)
 	}
-	userRepository, err := adapters.NewMySQLUserRepository(db)
+	mysqlCredentialsRepository, err := adapters.NewMySQLCredentialsRepository(db)
 	if err != nil {
 		panic(err)
 	}
-	userFinder, err := adapters.NewMySQLUserFinder(db)
+	mysqlUserCounter, err := adapters.NewMySQLUserCounter(db)
 	if err != nil {
 		panic(err)
 	}
 	logger := logrus.NewEntry(logrus.StandardLogger())
-	metricsClient := metrics.NoOp{}
-
+	argon2idHasher := adapters.NewArgon2idPasswordHasher(adapters.Argon2idParams{
+		Memory:      uint32(cfg.PasswordHash.MemoryKiB),
+		Iterations:  uint32(cfg.PasswordHash.Iterations),
+		Parallelism: uint8(cfg.PasswordHash.Parallelism),
+		SaltLength:  adapters.DefaultArgon2idParams.SaltLength,
+		KeyLength:   adapters.DefaultArgon2idParams.KeyLength,
+	})
+	hasher := adapters.NewBoundedPasswordHasher(
+		argon2idHasher,
+		cfg.PasswordHash.Workers,
+		cfg.PasswordHash.QueueSize,
+		metricsClient,
+	)
+	tokenIssuer, err := newTokenIssuer(cfg.Auth, signer)
 	if err != nil {
 		panic(err)
 	}
+
+	var userRepository userDomain.Repository = mysqlUserRepository
+	var userFinder query.InformationOfUserReadModel = mysqlUserFinder
+	var userCounter command.UserCounter = mysqlUserCounter
+	closeRedis := func() error { return nil }
+	if cfg.Cache.Enabled {
+		redisClient := cache.NewRedisClient(cfg.Redis)
+		closeRedis = redisClient.Close
+		userRepository = adapters.NewCacheInvalidatingUserRepository(
+			userRepository,
+			cache.NewUserInformationInvalidator(redisClient),
+			logger,
+		)
+		userCounter = adapters.NewCacheInvalidatingUserCounter(
+			userCounter,
+			cache.NewUserInformationInvalidator(redisClient),
+			logger,
+		)
+		userFinder = adapters.NewCachedUserFinder(redisClient, userFinder, cfg.Cache, logger)
+	}
+
 	return app.Application{
 		Commands: app.Commands{
 			UpdateUser: command.NewUpdateUserHandler(userRepository, logger, metricsClient),
 			CreateUser: command.NewCreateUserHandler(userRepository, logger, metricsClient),
+			Register:   command.NewRegisterHandler(mysqlCredentialsRepository, hasher, logger, metricsClient),
+			Login: command.NewLoginHandler(
+				mysqlCredentialsRepository,
+				userRepository,
+				hasher,
+				argon2idHasher,
+				tokenIssuer,
+				logger,
+				metricsClient,
+			),
+			RecordVideoPublished:  command.NewRecordVideoPublishedHandler(userCounter, logger, metricsClient),
+			RecordFavoriteChanged: command.NewRecordFavoriteChangedHandler(userCounter, logger, metricsClient),
 		},
 		Queries: app.Queries{
-			InformationOfUser: query.NewInformationForUserHandler(userFinder, logger, metricsClient),
+			InformationOfUser:  query.NewInformationForUserHandler(userFinder, logger, metricsClient),
+			InformationOfUsers: query.NewInformationOfUsersHandler(userFinder, logger, metricsClient),
 		},
+	}, []server.HealthCheck{
+		{Name: "mysql", Check: db.PingContext},
+	}, func() {
+		_ = closeRedis()
+		_ = db.Close()
+	}
+}
+
+// newTokenIssuer 返回的 issuer 每次签发时读取 signer 当前的私钥，私钥轮换后立即使用新的私钥
+func newTokenIssuer(cfg config.AuthConfig, signer keys.Signer) (command.TokenIssuer, error) {
+	if signer != nil {
+		return signerTokenIssuer{signer: signer, ttl: cfg.AccessTokenTTL}, nil
+	}
+	if cfg.JWTSecret == "" {
+		return nil, errors.New("auth.jwt_secret, auth.signing_key_file or keys.etcd_prefix is required")
 	}
+	return auth.JWTTokenIssuer{Secret: []byte(cfg.JWTSecret), TTL: cfg.AccessTokenTTL}, nil
+}
+
+type signerTokenIssuer struct {
+	signer keys.Signer
+	ttl    time.Duration
+}
+
+func (i signerTokenIssuer) IssueToken(user auth.User) (auth.AccessToken, error) {
+	privateKey, keyID := i.signer.SigningKey()
+	return auth.ES256TokenIssuer{PrivateKey: privateKey, KeyID: keyID, TTL: i.ttl}.IssueToken(user)
 }
@@ -0,0 +1,275 @@
+package adapters_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+	"newTiktoken/internal/common/pagination"
+	"newTiktoken/internal/video-comment/adapters"
+	"newTiktoken/internal/video-comment/app/query"
+	"newTiktoken/internal/video-comment/domain/comment"
+)
+
+func TestMemoryCommentRepository(t *testing.T) {
+	t.Parallel()
+	repository := adapters.NewMemoryCommentRepository()
+	testCommentRepository(t, repository, repository)
+}
+
+// TestMySQLCommentRepository 需要提供 MYSQL_DSN，数据库需要先执行 cmd/migrate up
+func TestMySQLCommentRepository(t *testing.T) {
+	t.Parallel()
+	dsn := os.Getenv("MYSQL_DSN")
+	if dsn == "" {
+		t.Skip("MYSQL_DSN is not set")
+	}
+	db, err := sql.Open("mysql", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	repository, err := adapters.NewMySQLCommentRepository(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	finder, err := adapters.NewMySQLCommentFinder(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testCommentRepository(t, repository, finder)
+
+	t.Run("CommentCountAndEvents", func(t *testing.T) {
+		t.Parallel()
+		testCommentCountAndEvents(t, db, repository)
+	})
+}
+
+func testCommentRepository(t *testing.T, repository comment.Repository, readModel query.CommentReadModel) {
+	t.Run("GetMissingComment", func(t *testing.T) {
+		t.Parallel()
+		c, err := repository.GetComment(context.Background(), uuid.NewString())
+		if err != nil {
+			t.Fatalf("expected nil error for missing comment, got %v", err)
+		}
+		if c != nil {
+			t.Fatalf("expected nil comment for missing comment, got %+v", c)
+		}
+	})
+	t.Run("UpdateMissingComment", func(t *testing.T) {
+		t.Parallel()
+		err := repository.UpdateComment(context.Background(), uuid.NewString(), func(_ context.Context, c *comment.Comment) (*comment.Comment, error) {
+			t.Fatal("updateFn should not be called for missing comment")
+			return c, nil
+		})
+		if !errors.Is(err, comment.ErrCommentNotFound) {
+			t.Fatalf("expected ErrCommentNotFound, got %v", err)
+		}
+	})
+	t.Run("CommentLifecycle", func(t *testing.T) {
+		t.Parallel()
+		testCommentLifecycle(t, repository, readModel)
+	})
+	t.Run("FindCommentsPaging", func(t *testing.T) {
+		t.Parallel()
+		testFindCommentsPaging(t, repository, readModel)
+	})
+}
+
+// testCommentLifecycle 覆盖评论从发布到软删除的过程，删除后仓库仍能读到评论，读模型中不再出现
+func testCommentLifecycle(t *testing.T, repository comment.Repository, readModel query.CommentReadModel) {
+	ctx := context.Background()
+	c := addExampleComment(t, repository, uuid.NewString(), time.Now())
+
+	found, err := repository.GetComment(ctx, c.UUID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found == nil {
+		t.Fatal("expected comment to be persisted")
+	}
+	if found.VideoUUID() != c.VideoUUID() || found.AuthorUUID() != c.AuthorUUID() || found.Content() != c.Content() || found.IsDeleted() {
+		t.Errorf("expected %+v, got %+v", c, found)
+	}
+	if err := repository.AddComment(ctx, c); err == nil {
+		t.Error("expected error when adding existing comment")
+	}
+
+	qc, err := readModel.FindComment(ctx, c.UUID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if qc == nil || qc.Author.UUID != c.AuthorUUID() || !qc.CreatedAt.Equal(c.CreatedAt()) {
+		t.Errorf("unexpected comment in read model %+v", qc)
+	}
+
+	deleteComment(t, repository, c)
+
+	found, err = repository.GetComment(ctx, c.UUID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found == nil || !found.IsDeleted() {
+		t.Fatalf("expected comment to be soft deleted, got %+v", found)
+	}
+	qc, err = readModel.FindComment(ctx, c.UUID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if qc != nil {
+		t.Errorf("expected deleted comment to be hidden, got %+v", qc)
+	}
+	comments, err := readModel.FindComments(ctx, c.VideoUUID(), pagination.Cursor{}, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertCommentUUIDs(t, comments)
+}
+
+// testFindCommentsPaging 中两条评论的时间相同，需要按 UUID 继续分页
+func testFindCommentsPaging(t *testing.T, repository comment.Repository, readModel query.CommentReadModel) {
+	ctx := context.Background()
+	videoUUID := uuid.NewString()
+	now := time.Now()
+	oldest := addExampleComment(t, repository, videoUUID, now.Add(-time.Minute))
+	sameTimeA := addExampleComment(t, repository, videoUUID, now)
+	sameTimeB := addExampleComment(t, repository, videoUUID, now)
+	addExampleComment(t, repository, uuid.NewString(), now)
+
+	first, second := sameTimeA, sameTimeB
+	if first.UUID() < second.UUID() {
+		first, second = second, first
+	}
+
+	comments, err := readModel.FindComments(ctx, videoUUID, pagination.Cursor{}, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertCommentUUIDs(t, comments, first.UUID(), second.UUID())
+
+	comments, err = readModel.FindComments(ctx, videoUUID, pagination.Cursor{Time: first.CreatedAt(), UUID: first.UUID()}, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertCommentUUIDs(t, comments, second.UUID(), oldest.UUID())
+}
+
+// testCommentCountAndEvents 检查评论数和 outbox 事件与评论写入在同一事务中更新，重复删除失败且不会再次减少评论数
+func testCommentCountAndEvents(t *testing.T, db *sql.DB, repository comment.Repository) {
+	ctx := context.Background()
+	videoUUID := uuid.NewString()
+	now := time.Now().UTC()
+	_, err := db.ExecContext(ctx,
+		"INSERT INTO videos (video_uuid, author_uuid, title, play_url, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)",
+		videoUUID, uuid.NewString(), "example video", "https://cdn.example.com/videos/example.mp4", now, now,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	kept := addExampleComment(t, repository, videoUUID, now)
+	deleted := addExampleComment(t, repository, videoUUID, now)
+	assertCommentCount(t, db, videoUUID, 2)
+
+	deleteComment(t, repository, deleted)
+	err = repository.UpdateComment(ctx, deleted.UUID(), func(_ context.Context, c *comment.Comment) (*comment.Comment, error) {
+		return c, c.Delete(c.AuthorUUID(), "")
+	})
+	if !errors.Is(err, comment.ErrCommentNotFound) {
+		t.Fatalf("expected ErrCommentNotFound when deleting twice, got %v", err)
+	}
+	assertCommentCount(t, db, videoUUID, 1)
+
+	assertOutboxEvents(t, db, comment.CommentCreated{}.EventName(), videoUUID, kept.UUID(), deleted.UUID())
+	assertOutboxEvents(t, db, comment.CommentDeleted{}.EventName(), videoUUID, deleted.UUID())
+}
+
+func addExampleComment(t *testing.T, repository comment.Repository, videoUUID string, createdAt time.Time) *comment.Comment {
+	t.Helper()
+	c := comment.UnmarshalCommentFromDatabase(
+		uuid.NewString(),
+		videoUUID,
+		uuid.NewString(),
+		"example comment",
+		createdAt.Truncate(time.Microsecond),
+		nil,
+	)
+	if err := repository.AddComment(context.Background(), c); err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func deleteComment(t *testing.T, repository comment.Repository, c *comment.Comment) {
+	t.Helper()
+	err := repository.UpdateComment(context.Background(), c.UUID(), func(_ context.Context, c *comment.Comment) (*comment.Comment, error) {
+		if err := c.Delete(c.AuthorUUID(), ""); err != nil {
+			return nil, err
+		}
+		return c, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func assertCommentUUIDs(t *testing.T, comments []query.Comment, expected ...string) {
+	t.Helper()
+	if len(comments) != len(expected) {
+		t.Fatalf("expected %d comments, got %+v", len(expected), comments)
+	}
+	for i := range expected {
+		if comments[i].UUID != expected[i] {
+			t.Errorf("expected comment %d to be %s, got %s", i, expected[i], comments[i].UUID)
+		}
+	}
+}
+
+func assertCommentCount(t *testing.T, db *sql.DB, videoUUID string, expected int64) {
+	t.Helper()
+	var count int64
+	if err := db.QueryRow("SELECT comment_count FROM videos WHERE video_uuid = ?", videoUUID).Scan(&count); err != nil {
+		t.Fatal(err)
+	}
+	if count != expected {
+		t.Errorf("expected comment count %d, got %d", expected, count)
+	}
+}
+
+func assertOutboxEvents(t *testing.T, db *sql.DB, eventName, videoUUID string, expectedCommentUUIDs ...string) {
+	t.Helper()
+	rows, err := db.Query(
+		"SELECT payload->>'$.uuid' FROM outbox_events WHERE event_name = ? AND payload->>'$.video_uuid' = ?",
+		eventName, videoUUID,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	found := map[string]int{}
+	for rows.Next() {
+		var commentUUID string
+		if err := rows.Scan(&commentUUID); err != nil {
+			t.Fatal(err)
+		}
+		found[commentUUID]++
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(found) != len(expectedCommentUUIDs) {
+		t.Fatalf("expected %d %s events, got %v", len(expectedCommentUUIDs), eventName, found)
+	}
+	for _, commentUUID := range expectedCommentUUIDs {
+		if found[commentUUID] != 1 {
+			t.Errorf("expected one %s event for %s, got %d", eventName, commentUUID, found[commentUUID])
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package adapters
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+	"newTiktoken/internal/common/pagination"
+	"newTiktoken/internal/common/users"
+	"newTiktoken/internal/video-comment/app/query"
+	"newTiktoken/internal/video-comment/domain/comment"
+)
+
+// MemoryCommentRepository 是线程安全的内存评论仓库，同时实现 query.CommentReadModel，用于测试和本地运行
+type MemoryCommentRepository struct {
+	lock     *sync.RWMutex
+	comments map[string]comment.Comment
+}
+
+func NewMemoryCommentRepository() *MemoryCommentRepository {
+	return &MemoryCommentRepository{
+		lock:     &sync.RWMutex{},
+		comments: map[string]comment.Comment{},
+	}
+}
+
+func (m MemoryCommentRepository) GetComment(_ context.Context, commentUUID string) (*comment.Comment, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	c, ok := m.comments[commentUUID]
+	if !ok {
+		return nil, nil
+	}
+	return &c, nil
+}
+
+func (m MemoryCommentRepository) AddComment(_ context.Context, c *comment.Comment) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.comments[c.UUID()]; ok {
+		return errors.Errorf("comment %s already exists", c.UUID())
+	}
+	m.comments[c.UUID()] = *c
+	return nil
+}
+
+func (m MemoryCommentRepository) UpdateComment(
+	ctx context.Context,
+	commentUUID string,
+	updateFn func(ctx context.Context, c *comment.Comment) (*comment.Comment, error),
+) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	c, ok := m.comments[commentUUID]
+	if !ok {
+		return comment.ErrCommentNotFound
+	}
+	updated, err := updateFn(ctx, &c)
+	if err != nil {
+		return err
+	}
+	m.comments[commentUUID] = *updated
+	return nil
+}
+
+func (m MemoryCommentRepository) FindComment(_ context.Context, commentUUID string) (*query.Comment, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	c, ok := m.comments[commentUUID]
+	if !ok || c.IsDeleted() {
+		return nil, nil
+	}
+	qc := domainCommentToQueryComment(c)
+	return &qc, nil
+}
+
+// FindComments 按评论时间倒序返回，评论时间相同时按 UUID 倒序，与 MySQL 实现一致
+func (m MemoryCommentRepository) FindComments(_ context.Context, videoUUID string, after pagination.Cursor, limit int) ([]query.Comment, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	var comments []query.Comment
+	for _, c := range m.comments {
+		if c.VideoUUID() != videoUUID || c.IsDeleted() {
+			continue
+		}
+		if !after.IsZero() && !isBefore(c, after) {
+			continue
+		}
+		comments = append(comments, domainCommentToQueryComment(c))
+	}
+	sort.Slice(comments, func(i, j int) bool {
+		if !comments[i].CreatedAt.Equal(comments[j].CreatedAt) {
+			return comments[i].CreatedAt.After(comments[j].CreatedAt)
+		}
+		return comments[i].UUID > comments[j].UUID
+	})
+	if len(comments) > limit {
+		comments = comments[:limit]
+	}
+	return comments, nil
+}
+
+// isBefore 判断评论在倒序排列中是否位于游标之后
+func isBefore(c comment.Comment, after pagination.Cursor) bool {
+	if !c.CreatedAt().Equal(after.Time) {
+		return c.CreatedAt().Before(after.Time)
+	}
+	return c.UUID() < after.UUID
+}
+
+func domainCommentToQueryComment(c comment.Comment) query.Comment {
+	return query.Comment{
+		UUID:      c.UUID(),
+		VideoUUID: c.VideoUUID(),
+		Author:    users.User{UUID: c.AuthorUUID()},
+		Content:   c.Content(),
+		CreatedAt: c.CreatedAt(),
+	}
+}
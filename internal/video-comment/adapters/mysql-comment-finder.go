@@ -0,0 +1,74 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pkg/errors"
+	"newTiktoken/internal/common/pagination"
+	"newTiktoken/internal/common/tracing"
+	"newTiktoken/internal/video-comment/app/query"
+)
+
+type MySQLCommentFinder struct {
+	db *sql.DB
+}
+
+func NewMySQLCommentFinder(db *sql.DB) (*MySQLCommentFinder, error) {
+	if db == nil {
+		return nil, errors.New("nil db")
+	}
+	return &MySQLCommentFinder{db: db}, nil
+}
+
+const selectQueryComment = "SELECT comment_uuid, video_uuid, author_uuid, content, created_at FROM comments"
+
+func (m MySQLCommentFinder) FindComment(ctx context.Context, commentUUID string) (_ *query.Comment, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "MySQLCommentFinder.FindComment")
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	comments, err := m.findComments(ctx, selectQueryComment+" WHERE comment_uuid = ? AND deleted_at IS NULL", commentUUID)
+	if err != nil || len(comments) == 0 {
+		return nil, err
+	}
+	return &comments[0], nil
+}
+
+// FindComments 使用 idx_comments_video_created_at 索引做 keyset 分页
+func (m MySQLCommentFinder) FindComments(ctx context.Context, videoUUID string, after pagination.Cursor, limit int) (_ []query.Comment, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "MySQLCommentFinder.FindComments")
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	selectQuery := selectQueryComment + " WHERE video_uuid = ? AND deleted_at IS NULL"
+	args := []any{videoUUID}
+	if !after.IsZero() {
+		selectQuery += " AND (created_at < ? OR (created_at = ? AND comment_uuid < ?))"
+		args = append(args, after.Time, after.Time, after.UUID)
+	}
+	selectQuery += " ORDER BY created_at DESC, comment_uuid DESC LIMIT ?"
+	args = append(args, limit)
+
+	return m.findComments(ctx, selectQuery, args...)
+}
+
+func (m MySQLCommentFinder) findComments(ctx context.Context, selectQuery string, args ...any) ([]query.Comment, error) {
+	rows, err := m.db.QueryContext(ctx, selectQuery, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query comments")
+	}
+	defer rows.Close()
+
+	var comments []query.Comment
+	for rows.Next() {
+		var c query.Comment
+		if err := rows.Scan(&c.UUID, &c.VideoUUID, &c.Author.UUID, &c.Content, &c.CreatedAt); err != nil {
+			return nil, errors.Wrap(err, "failed to scan comment")
+		}
+		comments = append(comments, c)
+	}
+	return comments, errors.Wrap(rows.Err(), "failed to iterate comments")
+}
@@ -0,0 +1,160 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/pkg/errors"
+	"newTiktoken/internal/common/events"
+	"newTiktoken/internal/common/tracing"
+	"newTiktoken/internal/video-comment/domain/comment"
+)
+
+type MySQLCommentRepository struct {
+	db *sql.DB
+}
+
+// NewMySQLCommentRepository 创建一个新的 MySQL 评论仓库实例
+// comments 表由 cmd/migrate 执行 internal/common/migrations 中的迁移创建
+func NewMySQLCommentRepository(db *sql.DB) (comment.Repository, error) {
+	if db == nil {
+		return nil, errors.New("nil db")
+	}
+	return &MySQLCommentRepository{db: db}, nil
+}
+
+// GetComment 根据评论UUID查找评论，已删除的评论同样返回
+func (m MySQLCommentRepository) GetComment(ctx context.Context, commentUUID string) (_ *comment.Comment, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "MySQLCommentRepository.GetComment")
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	c, err := scanComment(m.db.QueryRowContext(ctx, selectComment+" WHERE comment_uuid = ?", commentUUID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return c, err
+}
+
+// AddComment 添加评论，在同一事务中把视频的评论数加一并写入 CommentCreated 事件
+func (m MySQLCommentRepository) AddComment(ctx context.Context, c *comment.Comment) (err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "MySQLCommentRepository.AddComment")
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	return m.inTransaction(ctx, func(tx *sql.Tx) error {
+		insertQuery := "INSERT INTO comments (comment_uuid, video_uuid, author_uuid, content, created_at) VALUES (?, ?, ?, ?, ?)"
+		_, err := tx.ExecContext(ctx, insertQuery,
+			c.UUID(),
+			c.VideoUUID(),
+			c.AuthorUUID(),
+			c.Content(),
+			c.CreatedAt().UTC(),
+		)
+		if err != nil {
+			return errors.Wrapf(err, "failed to insert comment %s", c.UUID())
+		}
+
+		_, err = tx.ExecContext(ctx, "UPDATE videos SET comment_count = comment_count + 1 WHERE video_uuid = ?", c.VideoUUID())
+		if err != nil {
+			return errors.Wrapf(err, "failed to increase comment count of %s", c.VideoUUID())
+		}
+
+		return events.StoreInOutbox(ctx, tx, comment.NewCommentCreated(c))
+	})
+}
+
+// UpdateComment 加锁读取评论后调用 updateFn，评论被删除时在同一事务中把视频的评论数减一并写入 CommentDeleted 事件
+// 评论内容不可修改，目前只会持久化删除状态
+func (m MySQLCommentRepository) UpdateComment(
+	ctx context.Context,
+	commentUUID string,
+	updateFn func(ctx context.Context, c *comment.Comment) (*comment.Comment, error),
+) (err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "MySQLCommentRepository.UpdateComment")
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	return m.inTransaction(ctx, func(tx *sql.Tx) error {
+		c, err := scanComment(tx.QueryRowContext(ctx, selectComment+" WHERE comment_uuid = ? FOR UPDATE", commentUUID))
+		if errors.Is(err, sql.ErrNoRows) {
+			return comment.ErrCommentNotFound
+		}
+		if err != nil {
+			return err
+		}
+		wasDeleted := c.IsDeleted()
+
+		updated, err := updateFn(ctx, c)
+		if err != nil {
+			return err
+		}
+		if wasDeleted || !updated.IsDeleted() {
+			return nil
+		}
+
+		_, err = tx.ExecContext(ctx, "UPDATE comments SET deleted_at = ? WHERE comment_uuid = ?",
+			updated.DeletedAt().UTC(),
+			updated.UUID(),
+		)
+		if err != nil {
+			return errors.Wrapf(err, "failed to delete comment %s", updated.UUID())
+		}
+
+		_, err = tx.ExecContext(ctx,
+			"UPDATE videos SET comment_count = comment_count - 1 WHERE video_uuid = ? AND comment_count > 0",
+			updated.VideoUUID(),
+		)
+		if err != nil {
+			return errors.Wrapf(err, "failed to decrease comment count of %s", updated.VideoUUID())
+		}
+
+		return events.StoreInOutbox(ctx, tx, comment.NewCommentDeleted(updated))
+	})
+}
+
+func (m MySQLCommentRepository) inTransaction(ctx context.Context, fn func(tx *sql.Tx) error) (err error) {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		} else if err != nil {
+			_ = tx.Rollback()
+		} else {
+			err = tx.Commit()
+		}
+	}()
+
+	return fn(tx)
+}
+
+const selectComment = "SELECT comment_uuid, video_uuid, author_uuid, content, created_at, deleted_at FROM comments"
+
+// scanComment 在评论不存在时返回 sql.ErrNoRows
+func scanComment(row *sql.Row) (*comment.Comment, error) {
+	var (
+		commentUUID, videoUUID, authorUUID, content string
+		createdAt                                   time.Time
+		deletedAt                                   sql.NullTime
+	)
+	if err := row.Scan(&commentUUID, &videoUUID, &authorUUID, &content, &createdAt, &deletedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "failed to scan comment")
+	}
+	var deletedAtPtr *time.Time
+	if deletedAt.Valid {
+		deletedAtPtr = &deletedAt.Time
+	}
+	return comment.UnmarshalCommentFromDatabase(commentUUID, videoUUID, authorUUID, content, createdAt, deletedAtPtr), nil
+}
@@ -0,0 +1,40 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pkg/errors"
+	"newTiktoken/internal/common/tracing"
+	"newTiktoken/internal/video-comment/domain/comment"
+)
+
+// MySQLVideoService 直接读取 video 服务的 videos 表获取视频作者
+// 评论服务与视频服务共用数据库，评论数也在同一事务中更新，因此不需要经过 gRPC
+type MySQLVideoService struct {
+	db *sql.DB
+}
+
+func NewMySQLVideoService(db *sql.DB) (*MySQLVideoService, error) {
+	if db == nil {
+		return nil, errors.New("nil db")
+	}
+	return &MySQLVideoService{db: db}, nil
+}
+
+func (m MySQLVideoService) GetVideoAuthor(ctx context.Context, videoUUID string) (_ string, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "MySQLVideoService.GetVideoAuthor")
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	var authorUUID string
+	err = m.db.QueryRowContext(ctx, "SELECT author_uuid FROM videos WHERE video_uuid = ?", videoUUID).Scan(&authorUUID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", comment.ErrVideoNotFound
+	}
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to find author of video %s", videoUUID)
+	}
+	return authorUUID, nil
+}
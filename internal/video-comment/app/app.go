@@ -0,0 +1,21 @@
+package app
+
+import (
+	"newTiktoken/internal/video-comment/app/command"
+	"newTiktoken/internal/video-comment/app/query"
+)
+
+type Application struct {
+	Commands Commands
+	Queries  Queries
+}
+
+type Commands struct {
+	CreateComment command.CreateCommentHandler
+	DeleteComment command.DeleteCommentHandler
+}
+
+type Queries struct {
+	CommentDetail query.CommentDetailHandler
+	CommentList   query.CommentListHandler
+}
@@ -0,0 +1,62 @@
+package command
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/auth"
+	"newTiktoken/internal/common/decorator"
+	"newTiktoken/internal/common/logs"
+	"newTiktoken/internal/video-comment/domain/comment"
+)
+
+// CreateComment 以 AuthorUUID 的身份评论视频，CommentUUID 由调用方生成
+type CreateComment struct {
+	User auth.User
+
+	CommentUUID string
+	VideoUUID   string
+	AuthorUUID  string
+	Content     string
+}
+
+type CreateCommentHandler decorator.CommandHandler[CreateComment]
+
+type createCommentHandler struct {
+	repo         comment.Repository
+	videoService VideoService
+}
+
+func (h createCommentHandler) Handle(ctx context.Context, cmd CreateComment) (err error) {
+	defer func() {
+		logs.LogCommandExecution("CreateComment", cmd, err)
+	}()
+	if err := CreateCommentPolicy.Authorize(cmd.User, cmd); err != nil {
+		return err
+	}
+	c, err := comment.NewComment(cmd.CommentUUID, cmd.VideoUUID, cmd.AuthorUUID, cmd.Content)
+	if err != nil {
+		return err
+	}
+	if _, err := h.videoService.GetVideoAuthor(ctx, cmd.VideoUUID); err != nil {
+		return err
+	}
+	return h.repo.AddComment(ctx, c)
+}
+
+func NewCreateCommentHandler(repo comment.Repository,
+	videoService VideoService,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient) CreateCommentHandler {
+	if repo == nil {
+		panic("nil repo")
+	}
+	if videoService == nil {
+		panic("nil videoService")
+	}
+	return decorator.ApplyCommandDecorators[CreateComment](
+		createCommentHandler{repo: repo, videoService: videoService},
+		logger,
+		metricsClient,
+	)
+}
@@ -0,0 +1,85 @@
+package command_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/auth"
+	commonerrors "newTiktoken/internal/common/errors"
+	"newTiktoken/internal/common/metrics"
+	"newTiktoken/internal/video-comment/adapters"
+	"newTiktoken/internal/video-comment/app/command"
+)
+
+var userA = auth.User{UUID: "user-a", Role: "user"}
+
+func TestCreateComment(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		Name        string
+		Command     command.CreateComment
+		ExpectedErr commonerrors.ErrorType
+	}{
+		{
+			Name: "own_comment",
+			Command: command.CreateComment{
+				User: userA, CommentUUID: "comment-1", VideoUUID: "video-1", AuthorUUID: "user-a", Content: " nice ",
+			},
+		},
+		{
+			Name: "other_author",
+			Command: command.CreateComment{
+				User: userA, CommentUUID: "comment-2", VideoUUID: "video-1", AuthorUUID: "user-b", Content: "nice",
+			},
+			ExpectedErr: commonerrors.ErrorTypeAuthorization,
+		},
+		{
+			Name: "blank_content",
+			Command: command.CreateComment{
+				User: userA, CommentUUID: "comment-3", VideoUUID: "video-1", AuthorUUID: "user-a", Content: "  ",
+			},
+			ExpectedErr: commonerrors.ErrorTypeIncorrectInput,
+		},
+		{
+			Name: "missing_video",
+			Command: command.CreateComment{
+				User: userA, CommentUUID: "comment-4", VideoUUID: "missing", AuthorUUID: "user-a", Content: "nice",
+			},
+			ExpectedErr: commonerrors.ErrorTypeNotFound,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			repository := adapters.NewMemoryCommentRepository()
+			handler := command.NewCreateCommentHandler(repository, videos, logrus.NewEntry(logrus.StandardLogger()), metrics.NoOp{})
+
+			err := handler.Handle(ctx, tc.Command)
+			c, getErr := repository.GetComment(ctx, tc.Command.CommentUUID)
+			if getErr != nil {
+				t.Fatal(getErr)
+			}
+
+			if tc.ExpectedErr == (commonerrors.ErrorType{}) {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if c == nil || c.Content() != "nice" {
+					t.Errorf("expected trimmed comment to be created, got %+v", c)
+				}
+				return
+			}
+			var slugErr commonerrors.SlugError
+			if !errors.As(err, &slugErr) || slugErr.ErrorType() != tc.ExpectedErr {
+				t.Fatalf("expected %v error, got %v", tc.ExpectedErr, err)
+			}
+			if c != nil {
+				t.Errorf("expected comment not to be created, got %+v", c)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package command
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/auth"
+	"newTiktoken/internal/common/decorator"
+	"newTiktoken/internal/common/logs"
+	"newTiktoken/internal/video-comment/domain/comment"
+)
+
+// DeleteComment 软删除评论，只有评论作者或视频作者可以删除，规则由 comment.Comment.Delete 保证
+type DeleteComment struct {
+	User auth.User
+
+	CommentUUID string
+}
+
+type DeleteCommentHandler decorator.CommandHandler[DeleteComment]
+
+type deleteCommentHandler struct {
+	repo         comment.Repository
+	videoService VideoService
+}
+
+func (h deleteCommentHandler) Handle(ctx context.Context, cmd DeleteComment) (err error) {
+	defer func() {
+		logs.LogCommandExecution("DeleteComment", cmd, err)
+	}()
+	return h.repo.UpdateComment(ctx, cmd.CommentUUID, func(ctx context.Context, c *comment.Comment) (*comment.Comment, error) {
+		videoAuthorUUID, err := h.videoService.GetVideoAuthor(ctx, c.VideoUUID())
+		if err != nil {
+			return nil, err
+		}
+		if err := c.Delete(cmd.User.UUID, videoAuthorUUID); err != nil {
+			return nil, err
+		}
+		return c, nil
+	})
+}
+
+func NewDeleteCommentHandler(repo comment.Repository,
+	videoService VideoService,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient) DeleteCommentHandler {
+	if repo == nil {
+		panic("nil repo")
+	}
+	if videoService == nil {
+		panic("nil videoService")
+	}
+	return decorator.ApplyCommandDecorators[DeleteComment](
+		deleteCommentHandler{repo: repo, videoService: videoService},
+		logger,
+		metricsClient,
+	)
+}
@@ -0,0 +1,91 @@
+package command_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/auth"
+	commonerrors "newTiktoken/internal/common/errors"
+	"newTiktoken/internal/common/metrics"
+	"newTiktoken/internal/video-comment/adapters"
+	"newTiktoken/internal/video-comment/app/command"
+	"newTiktoken/internal/video-comment/domain/comment"
+)
+
+// videoAuthors 是按视频 UUID 返回作者的 command.VideoService
+type videoAuthors map[string]string
+
+func (v videoAuthors) GetVideoAuthor(_ context.Context, videoUUID string) (string, error) {
+	author, ok := v[videoUUID]
+	if !ok {
+		return "", comment.ErrVideoNotFound
+	}
+	return author, nil
+}
+
+var videos = videoAuthors{"video-1": "video-owner"}
+
+func TestDeleteComment(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		Name           string
+		Deleter        string
+		AlreadyDeleted bool
+		CommentUUID    string
+		ExpectedErr    commonerrors.ErrorType
+	}{
+		{Name: "comment_author", Deleter: "comment-author"},
+		{Name: "video_owner", Deleter: "video-owner"},
+		{Name: "other_user", Deleter: "user-c", ExpectedErr: commonerrors.ErrorTypeAuthorization},
+		{Name: "already_deleted", Deleter: "comment-author", AlreadyDeleted: true, ExpectedErr: commonerrors.ErrorTypeNotFound},
+		{Name: "missing_comment", Deleter: "comment-author", CommentUUID: "missing", ExpectedErr: commonerrors.ErrorTypeNotFound},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			repository := adapters.NewMemoryCommentRepository()
+			var deletedAt *time.Time
+			if tc.AlreadyDeleted {
+				now := time.Now()
+				deletedAt = &now
+			}
+			existing := comment.UnmarshalCommentFromDatabase("comment-1", "video-1", "comment-author", "content", time.Now(), deletedAt)
+			if err := repository.AddComment(ctx, existing); err != nil {
+				t.Fatal(err)
+			}
+			commentUUID := existing.UUID()
+			if tc.CommentUUID != "" {
+				commentUUID = tc.CommentUUID
+			}
+			handler := command.NewDeleteCommentHandler(repository, videos, logrus.NewEntry(logrus.StandardLogger()), metrics.NoOp{})
+
+			err := handler.Handle(ctx, command.DeleteComment{
+				User:        auth.User{UUID: tc.Deleter, Role: "user"},
+				CommentUUID: commentUUID,
+			})
+
+			if tc.ExpectedErr != (commonerrors.ErrorType{}) {
+				var slugErr commonerrors.SlugError
+				if !errors.As(err, &slugErr) || slugErr.ErrorType() != tc.ExpectedErr {
+					t.Fatalf("expected %v error, got %v", tc.ExpectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			c, err := repository.GetComment(ctx, existing.UUID())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !c.IsDeleted() {
+				t.Error("expected comment to be deleted")
+			}
+		})
+	}
+}
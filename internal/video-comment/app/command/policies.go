@@ -0,0 +1,6 @@
+package command
+
+import "newTiktoken/internal/common/auth"
+
+// CreateCommentPolicy 用户只能以自己的身份发表评论
+var CreateCommentPolicy = auth.Owner(func(cmd CreateComment) string { return cmd.AuthorUUID })
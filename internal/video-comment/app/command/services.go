@@ -0,0 +1,8 @@
+package command
+
+import "context"
+
+// VideoService 用于确认视频存在并获取视频作者，视频不存在时返回 comment.ErrVideoNotFound
+type VideoService interface {
+	GetVideoAuthor(ctx context.Context, videoUUID string) (string, error)
+}
@@ -0,0 +1,51 @@
+package query
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/decorator"
+)
+
+// CommentDetail 查询一条未删除的评论及其作者，评论不存在时返回 nil
+type CommentDetail struct {
+	CommentUUID string
+}
+
+type CommentDetailHandler decorator.QueryHandler[CommentDetail, *Comment]
+
+type commentDetailHandler struct {
+	readModel   CommentReadModel
+	userService UserService
+}
+
+func (h commentDetailHandler) Handle(ctx context.Context, query CommentDetail) (*Comment, error) {
+	c, err := h.readModel.FindComment(ctx, query.CommentUUID)
+	if err != nil || c == nil {
+		return nil, err
+	}
+	comments := []Comment{*c}
+	if err := fillAuthors(ctx, h.userService, comments); err != nil {
+		return nil, err
+	}
+	return &comments[0], nil
+}
+
+func NewCommentDetailHandler(
+	readModel CommentReadModel,
+	userService UserService,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) CommentDetailHandler {
+	if readModel == nil {
+		panic("nil readModel")
+	}
+	if userService == nil {
+		panic("nil userService")
+	}
+	return decorator.ApplyQueryDecorators[CommentDetail, *Comment](
+		commentDetailHandler{readModel: readModel, userService: userService},
+		logger,
+		metricsClient,
+	)
+}
@@ -0,0 +1,67 @@
+package query
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/decorator"
+	"newTiktoken/internal/common/pagination"
+)
+
+// CommentList 查询视频的评论，按评论时间倒序分页
+type CommentList struct {
+	VideoUUID string
+	Cursor    string
+	Limit     int
+}
+
+type CommentListHandler decorator.QueryHandler[CommentList, CommentPage]
+
+type commentListHandler struct {
+	readModel   CommentReadModel
+	userService UserService
+}
+
+// Handle 多取一条记录用于判断是否存在下一页
+func (h commentListHandler) Handle(ctx context.Context, query CommentList) (CommentPage, error) {
+	after, err := pagination.DecodeCursor(query.Cursor)
+	if err != nil {
+		return CommentPage{}, err
+	}
+	limit := pagination.NormalizePageSize(query.Limit)
+
+	comments, err := h.readModel.FindComments(ctx, query.VideoUUID, after, limit+1)
+	if err != nil {
+		return CommentPage{}, err
+	}
+	var nextCursor string
+	if len(comments) > limit {
+		comments = comments[:limit]
+		last := comments[len(comments)-1]
+		nextCursor = pagination.Cursor{Time: last.CreatedAt, UUID: last.UUID}.Encode()
+	}
+
+	if err := fillAuthors(ctx, h.userService, comments); err != nil {
+		return CommentPage{}, err
+	}
+	return CommentPage{Comments: comments, NextCursor: nextCursor}, nil
+}
+
+func NewCommentListHandler(
+	readModel CommentReadModel,
+	userService UserService,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) CommentListHandler {
+	if readModel == nil {
+		panic("nil readModel")
+	}
+	if userService == nil {
+		panic("nil userService")
+	}
+	return decorator.ApplyQueryDecorators[CommentList, CommentPage](
+		commentListHandler{readModel: readModel, userService: userService},
+		logger,
+		metricsClient,
+	)
+}
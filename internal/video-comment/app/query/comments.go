@@ -0,0 +1,21 @@
+package query
+
+import (
+	"context"
+
+	"newTiktoken/internal/common/pagination"
+	"newTiktoken/internal/common/users"
+)
+
+// CommentReadModel 只返回未删除的评论，返回的评论只填充 Author.UUID
+type CommentReadModel interface {
+	// FindComment 在评论不存在或已删除时返回 nil, nil
+	FindComment(ctx context.Context, commentUUID string) (*Comment, error)
+	// FindComments 按评论时间倒序返回 after 之后的至多 limit 条评论
+	FindComments(ctx context.Context, videoUUID string, after pagination.Cursor, limit int) ([]Comment, error)
+}
+
+// fillAuthors 批量补全评论的作者资料，用户服务中不存在的作者只保留 UUID
+func fillAuthors(ctx context.Context, userService UserService, comments []Comment) error {
+	return users.Fill(ctx, userService, comments, func(c *Comment) *users.User { return &c.Author })
+}
@@ -0,0 +1,22 @@
+package query
+
+import (
+	"time"
+
+	"newTiktoken/internal/common/users"
+)
+
+// Comment 是返回给客户端的评论，读模型只填充 Author.UUID，其余作者资料由 UserService 补全
+type Comment struct {
+	UUID      string
+	VideoUUID string
+	Author    users.User
+	Content   string
+	CreatedAt time.Time
+}
+
+// CommentPage 是评论列表的一页数据，NextCursor 为空表示没有下一页
+type CommentPage struct {
+	Comments   []Comment
+	NextCursor string
+}
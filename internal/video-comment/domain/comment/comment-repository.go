@@ -0,0 +1,17 @@
+package comment
+
+import "context"
+
+// Repository 是 comment domain repository 的接口
+// 所有实现都需要通过 adapters 中的 repository contract 测试
+type Repository interface {
+	// GetComment 在评论不存在时返回 nil, nil，已删除的评论同样会返回
+	GetComment(ctx context.Context, commentUUID string) (*Comment, error)
+	// AddComment 在评论已存在时返回错误
+	AddComment(ctx context.Context, comment *Comment) error
+	// UpdateComment 在评论不存在（返回 ErrCommentNotFound）或 updateFn 返回错误时不做任何修改并返回错误
+	UpdateComment(ctx context.Context, commentUUID string, updateFn func(
+		ctx context.Context,
+		comment *Comment,
+	) (*Comment, error)) error
+}
@@ -0,0 +1,116 @@
+package comment
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	commonerrors "newTiktoken/internal/common/errors"
+)
+
+const maxContentLength = 500
+
+var (
+	ErrCommentNotFound    = commonerrors.NewNotFoundError("comment not found", "comment-not-found")
+	ErrVideoNotFound      = commonerrors.NewNotFoundError("video not found", "video-not-found")
+	ErrEmptyContent       = commonerrors.NewIncorrectInputError("empty comment content", "empty-comment-content")
+	ErrContentTooLong     = commonerrors.NewIncorrectInputError("comment content is too long", "comment-content-too-long")
+	ErrNotAllowedToDelete = commonerrors.NewAuthorizationError("only the author or the video owner can delete the comment", "not-allowed-to-delete-comment")
+)
+
+// Comment 是视频下的一条评论，删除为软删除
+type Comment struct {
+	uuid       string
+	videoUUID  string
+	authorUUID string
+	content    string
+	createdAt  time.Time
+	deletedAt  *time.Time
+}
+
+// NewComment 创建一条新评论，content 会去掉首尾空白
+func NewComment(uuid string, videoUUID string, authorUUID string, content string) (*Comment, error) {
+	if uuid == "" {
+		return nil, commonerrors.NewIncorrectInputError("空的评论uuid", "empty-comment-uuid")
+	}
+	if videoUUID == "" {
+		return nil, commonerrors.NewIncorrectInputError("空的视频uuid", "empty-video-uuid")
+	}
+	if authorUUID == "" {
+		return nil, commonerrors.NewIncorrectInputError("空的作者uuid", "empty-author-uuid")
+	}
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return nil, ErrEmptyContent
+	}
+	if utf8.RuneCountInString(content) > maxContentLength {
+		return nil, ErrContentTooLong
+	}
+	return &Comment{
+		uuid:       uuid,
+		videoUUID:  videoUUID,
+		authorUUID: authorUUID,
+		content:    content,
+		createdAt:  time.Now(),
+	}, nil
+}
+
+func UnmarshalCommentFromDatabase(
+	uuid string,
+	videoUUID string,
+	authorUUID string,
+	content string,
+	createdAt time.Time,
+	deletedAt *time.Time,
+) *Comment {
+	return &Comment{
+		uuid:       uuid,
+		videoUUID:  videoUUID,
+		authorUUID: authorUUID,
+		content:    content,
+		createdAt:  createdAt,
+		deletedAt:  deletedAt,
+	}
+}
+
+func (c Comment) UUID() string {
+	return c.uuid
+}
+
+func (c Comment) VideoUUID() string {
+	return c.videoUUID
+}
+
+func (c Comment) AuthorUUID() string {
+	return c.authorUUID
+}
+
+func (c Comment) Content() string {
+	return c.content
+}
+
+func (c Comment) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+// DeletedAt 在评论未删除时返回 nil
+func (c Comment) DeletedAt() *time.Time {
+	return c.deletedAt
+}
+
+func (c Comment) IsDeleted() bool {
+	return c.deletedAt != nil
+}
+
+// Delete 软删除评论，只有评论作者或视频作者可以删除；已删除的评论按不存在处理
+func (c *Comment) Delete(deleterUUID string, videoAuthorUUID string) error {
+	if c.IsDeleted() {
+		return ErrCommentNotFound
+	}
+	if deleterUUID != c.authorUUID && deleterUUID != videoAuthorUUID {
+		return ErrNotAllowedToDelete
+	}
+	now := time.Now()
+	c.deletedAt = &now
+	return nil
+}
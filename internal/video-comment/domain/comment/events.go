@@ -0,0 +1,43 @@
+package comment
+
+import "time"
+
+// CommentCreated 在新评论写入后产生
+type CommentCreated struct {
+	UUID       string    `json:"uuid"`
+	VideoUUID  string    `json:"video_uuid"`
+	AuthorUUID string    `json:"author_uuid"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+func (CommentCreated) EventName() string {
+	return "CommentCreated"
+}
+
+func NewCommentCreated(comment *Comment) CommentCreated {
+	return CommentCreated{
+		UUID:       comment.UUID(),
+		VideoUUID:  comment.VideoUUID(),
+		AuthorUUID: comment.AuthorUUID(),
+		CreatedAt:  comment.CreatedAt().UTC(),
+	}
+}
+
+// CommentDeleted 在评论被软删除后产生
+type CommentDeleted struct {
+	UUID      string    `json:"uuid"`
+	VideoUUID string    `json:"video_uuid"`
+	DeletedAt time.Time `json:"deleted_at"`
+}
+
+func (CommentDeleted) EventName() string {
+	return "CommentDeleted"
+}
+
+func NewCommentDeleted(comment *Comment) CommentDeleted {
+	return CommentDeleted{
+		UUID:      comment.UUID(),
+		VideoUUID: comment.VideoUUID(),
+		DeletedAt: comment.DeletedAt().UTC(),
+	}
+}
@@ -0,0 +1,94 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"newTiktoken/internal/common/auth"
+	commentPb "newTiktoken/internal/common/genproto/video_comment"
+	"newTiktoken/internal/common/users"
+	"newTiktoken/internal/video-comment/app"
+	"newTiktoken/internal/video-comment/app/command"
+	"newTiktoken/internal/video-comment/app/query"
+)
+
+type GrpcServer struct {
+	commentPb.UnimplementedCommentServiceServer
+	app app.Application
+}
+
+func NewGrpcServer(application app.Application) *GrpcServer {
+	return &GrpcServer{app: application}
+}
+
+// CommentAction 以当前认证用户的身份发表或删除评论，发表成功时返回新评论
+func (g *GrpcServer) CommentAction(ctx context.Context, req *commentPb.CommentActionRequest) (*commentPb.CommentActionResponse, error) {
+	user, err := auth.GRPCUserFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	switch req.GetActionType() {
+	case commentPb.CommentActionType_COMMENT:
+		commentUUID := uuid.NewString()
+		if err := g.app.Commands.CreateComment.Handle(ctx, command.CreateComment{
+			User:        user,
+			CommentUUID: commentUUID,
+			VideoUUID:   req.GetVideoUuid(),
+			AuthorUUID:  user.UUID,
+			Content:     req.GetCommentText(),
+		}); err != nil {
+			return nil, err
+		}
+		c, err := g.app.Queries.CommentDetail.Handle(ctx, query.CommentDetail{CommentUUID: commentUUID})
+		if err != nil {
+			return nil, err
+		}
+		resp := &commentPb.CommentActionResponse{StatusMsg: "success"}
+		if c != nil {
+			resp.Comment = queryCommentToProtoComment(*c)
+		}
+		return resp, nil
+	case commentPb.CommentActionType_DELETE_COMMENT:
+		if err := g.app.Commands.DeleteComment.Handle(ctx, command.DeleteComment{
+			User:        user,
+			CommentUUID: req.GetCommentUuid(),
+		}); err != nil {
+			return nil, err
+		}
+		return &commentPb.CommentActionResponse{StatusMsg: "success"}, nil
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid comment action type %s", req.GetActionType())
+	}
+}
+
+func (g *GrpcServer) CommentList(ctx context.Context, req *commentPb.CommentListRequest) (*commentPb.CommentListResponse, error) {
+	page, err := g.app.Queries.CommentList.Handle(ctx, query.CommentList{
+		VideoUUID: req.GetVideoUuid(),
+		Cursor:    req.GetCursor(),
+		Limit:     int(req.GetLimit()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	comments := make([]*commentPb.Comment, 0, len(page.Comments))
+	for _, c := range page.Comments {
+		comments = append(comments, queryCommentToProtoComment(c))
+	}
+	return &commentPb.CommentListResponse{
+		StatusMsg:   "success",
+		CommentList: comments,
+		NextCursor:  page.NextCursor,
+	}, nil
+}
+
+func queryCommentToProtoComment(c query.Comment) *commentPb.Comment {
+	return &commentPb.Comment{
+		Uuid:     c.UUID,
+		User:     users.ToProto(c.Author),
+		Content:  c.Content,
+		CreateAt: uint64(c.CreatedAt.Unix()),
+	}
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/client"
+	"newTiktoken/internal/common/config"
+	"newTiktoken/internal/common/decorator"
+	"newTiktoken/internal/common/server"
+	"newTiktoken/internal/common/users"
+	"newTiktoken/internal/video-comment/adapters"
+	"newTiktoken/internal/video-comment/app"
+	"newTiktoken/internal/video-comment/app/command"
+	"newTiktoken/internal/video-comment/app/query"
+)
+
+// NewApplication 返回应用、驱动 gRPC 健康状态的依赖检查以及退出时的清理函数
+func NewApplication(ctx context.Context, cfg config.Config, metricsClient decorator.MetricsClient) (app.Application, []server.HealthCheck, func()) {
+	db, err := cfg.MySQL.Open()
+	if err != nil {
+		panic(err)
+	}
+	commentRepository, err := adapters.NewMySQLCommentRepository(db)
+	if err != nil {
+		panic(err)
+	}
+	commentFinder, err := adapters.NewMySQLCommentFinder(db)
+	if err != nil {
+		panic(err)
+	}
+	videoService, err := adapters.NewMySQLVideoService(db)
+	if err != nil {
+		panic(err)
+	}
+	userClient, closeUserClient, err := client.NewUserClient()
+	if err != nil {
+		panic(err)
+	}
+	userService := users.NewUserGrpc(userClient)
+	logger := logrus.NewEntry(logrus.StandardLogger())
+
+	return app.Application{
+		Commands: app.Commands{
+			CreateComment: command.NewCreateCommentHandler(commentRepository, videoService, logger, metricsClient),
+			DeleteComment: command.NewDeleteCommentHandler(commentRepository, videoService, logger, metricsClient),
+		},
+		Queries: app.Queries{
+			CommentDetail: query.NewCommentDetailHandler(commentFinder, userService, logger, metricsClient),
+			CommentList:   query.NewCommentListHandler(commentFinder, userService, logger, metricsClient),
+		},
+	}, []server.HealthCheck{
+		{Name: "mysql", Check: db.PingContext},
+	}, func() {
+		_ = closeUserClient()
+		_ = db.Close()
+	}
+}
@@ -0,0 +1,268 @@
+package adapters_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+	"newTiktoken/internal/common/pagination"
+	"newTiktoken/internal/video-favorite/adapters"
+	"newTiktoken/internal/video-favorite/app/query"
+	"newTiktoken/internal/video-favorite/domain/favorite"
+)
+
+// favoriteChange 是一次态度变化以及变化后期望保存的状态
+type favoriteChange struct {
+	Name     string
+	Change   func(f *favorite.Favorite) error
+	Expected favorite.Status
+}
+
+// statusTransitions 覆盖点赞和踩互斥的状态流转，每一步都基于上一步保存的状态
+var statusTransitions = []favoriteChange{
+	{Name: "like", Change: (*favorite.Favorite).Like, Expected: favorite.Liked},
+	{Name: "dislike_liked", Change: (*favorite.Favorite).Dislike, Expected: favorite.Disliked},
+	{Name: "like_disliked", Change: (*favorite.Favorite).Like, Expected: favorite.Liked},
+	{Name: "cancel_like", Change: (*favorite.Favorite).CancelLike, Expected: favorite.None},
+	{Name: "dislike", Change: (*favorite.Favorite).Dislike, Expected: favorite.Disliked},
+	{Name: "cancel_dislike", Change: (*favorite.Favorite).CancelDislike, Expected: favorite.None},
+}
+
+func TestMemoryFavoriteRepository(t *testing.T) {
+	t.Parallel()
+	repository := adapters.NewMemoryFavoriteRepository()
+	testFavoriteRepository(t, repository, repository)
+}
+
+// TestMySQLFavoriteRepository 需要提供 MYSQL_DSN，数据库需要先执行 cmd/migrate up
+// 除了与内存实现相同的行为外，还检查每次状态变化都写入了 FavoriteChanged 事件
+func TestMySQLFavoriteRepository(t *testing.T) {
+	t.Parallel()
+	dsn := os.Getenv("MYSQL_DSN")
+	if dsn == "" {
+		t.Skip("MYSQL_DSN is not set")
+	}
+	db, err := sql.Open("mysql", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	repository, err := adapters.NewMySQLFavoriteRepository(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	finder, err := adapters.NewMySQLFavoriteFinder(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testFavoriteRepository(t, repository, finder)
+
+	t.Run("FavoriteChangedEvents", func(t *testing.T) {
+		t.Parallel()
+		userUUID, videoUUID, authorUUID := uuid.NewString(), uuid.NewString(), uuid.NewString()
+		now := time.Now().UTC()
+		_, err := db.Exec(
+			"INSERT INTO videos (video_uuid, author_uuid, title, play_url, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)",
+			videoUUID, authorUUID, "example video", "https://cdn.example.com/videos/example.mp4", now, now,
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, c := range statusTransitions {
+			changeFavorite(t, repository, userUUID, videoUUID, c.Change)
+		}
+		assertFavoriteChangedEvents(t, db, userUUID, videoUUID, authorUUID)
+	})
+}
+
+func testFavoriteRepository(t *testing.T, repository favorite.Repository, readModel query.FavoriteReadModel) {
+	t.Run("GetMissingFavorite", func(t *testing.T) {
+		t.Parallel()
+		f, err := repository.GetFavorite(context.Background(), uuid.NewString(), uuid.NewString())
+		if err != nil {
+			t.Fatalf("expected nil error for missing favorite, got %v", err)
+		}
+		if f != nil {
+			t.Fatalf("expected nil favorite for missing favorite, got %+v", f)
+		}
+	})
+	t.Run("StatusTransitions", func(t *testing.T) {
+		t.Parallel()
+		userUUID, videoUUID := uuid.NewString(), uuid.NewString()
+		for _, c := range statusTransitions {
+			changeFavorite(t, repository, userUUID, videoUUID, c.Change)
+			assertStatus(t, repository, userUUID, videoUUID, c.Expected)
+		}
+	})
+	t.Run("RejectedChangeKeepsStatus", func(t *testing.T) {
+		t.Parallel()
+		userUUID, videoUUID := uuid.NewString(), uuid.NewString()
+		changeFavorite(t, repository, userUUID, videoUUID, (*favorite.Favorite).Like)
+
+		err := repository.UpdateFavorite(context.Background(), userUUID, videoUUID, func(_ context.Context, f *favorite.Favorite) (*favorite.Favorite, error) {
+			return f, f.Like()
+		})
+		if !errors.Is(err, favorite.ErrAlreadyLiked) {
+			t.Fatalf("expected ErrAlreadyLiked, got %v", err)
+		}
+		assertStatus(t, repository, userUUID, videoUUID, favorite.Liked)
+	})
+	t.Run("ConcurrentFirstLikes", func(t *testing.T) {
+		t.Parallel()
+		testConcurrentFirstLikes(t, repository)
+	})
+	t.Run("LikedVideos", func(t *testing.T) {
+		t.Parallel()
+		testLikedVideos(t, repository, readModel)
+	})
+}
+
+// testConcurrentFirstLikes 模拟连续点击：同一用户对同一视频并发的首次点赞只有一个成功，其余得到 ErrAlreadyLiked
+func testConcurrentFirstLikes(t *testing.T, repository favorite.Repository) {
+	const likes = 5
+	userUUID, videoUUID := uuid.NewString(), uuid.NewString()
+
+	results := make(chan error, likes)
+	for range likes {
+		go func() {
+			results <- repository.UpdateFavorite(context.Background(), userUUID, videoUUID, func(_ context.Context, f *favorite.Favorite) (*favorite.Favorite, error) {
+				return f, f.Like()
+			})
+		}()
+	}
+	succeeded := 0
+	for range likes {
+		err := <-results
+		switch {
+		case err == nil:
+			succeeded++
+		case !errors.Is(err, favorite.ErrAlreadyLiked):
+			t.Errorf("expected ErrAlreadyLiked for a concurrent like, got %v", err)
+		}
+	}
+	if succeeded != 1 {
+		t.Errorf("expected exactly one like to succeed, got %d", succeeded)
+	}
+	assertStatus(t, repository, userUUID, videoUUID, favorite.Liked)
+}
+
+// testLikedVideos 检查点赞列表按点赞时间倒序分页，并且只包含当前仍是点赞状态的视频
+func testLikedVideos(t *testing.T, repository favorite.Repository, readModel query.FavoriteReadModel) {
+	ctx := context.Background()
+	userUUID := uuid.NewString()
+	first, second, third, disliked := uuid.NewString(), uuid.NewString(), uuid.NewString(), uuid.NewString()
+	// 点赞时间精确到微秒，间隔 1ms 保证三次点赞的时间不同
+	for _, videoUUID := range []string{first, second, third} {
+		changeFavorite(t, repository, userUUID, videoUUID, (*favorite.Favorite).Like)
+		time.Sleep(time.Millisecond)
+	}
+	changeFavorite(t, repository, userUUID, disliked, (*favorite.Favorite).Like)
+	changeFavorite(t, repository, userUUID, disliked, (*favorite.Favorite).Dislike)
+	changeFavorite(t, repository, uuid.NewString(), first, (*favorite.Favorite).Like)
+
+	liked, err := readModel.FindLikedVideos(ctx, userUUID, pagination.Cursor{}, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertLikedVideos(t, liked, third, second)
+
+	liked, err = readModel.FindLikedVideos(ctx, userUUID, pagination.Cursor{Time: liked[1].LikedAt, UUID: liked[1].VideoUUID}, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertLikedVideos(t, liked, first)
+
+	likedUUIDs, err := readModel.FindLikedVideoUUIDs(ctx, userUUID, []string{first, disliked, uuid.NewString()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := likedUUIDs[first]; !ok || len(likedUUIDs) != 1 {
+		t.Errorf("expected only %s to be liked, got %v", first, likedUUIDs)
+	}
+}
+
+func changeFavorite(t *testing.T, repository favorite.Repository, userUUID string, videoUUID string, change func(f *favorite.Favorite) error) {
+	t.Helper()
+	err := repository.UpdateFavorite(context.Background(), userUUID, videoUUID, func(_ context.Context, f *favorite.Favorite) (*favorite.Favorite, error) {
+		if err := change(f); err != nil {
+			return nil, err
+		}
+		return f, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func assertStatus(t *testing.T, repository favorite.Repository, userUUID string, videoUUID string, expected favorite.Status) {
+	t.Helper()
+	f, err := repository.GetFavorite(context.Background(), userUUID, videoUUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f == nil || f.Status() != expected {
+		t.Fatalf("expected status %d, got %+v", expected.Int(), f)
+	}
+}
+
+func assertLikedVideos(t *testing.T, liked []query.LikedVideo, expected ...string) {
+	t.Helper()
+	if len(liked) != len(expected) {
+		t.Fatalf("expected %d liked videos, got %+v", len(expected), liked)
+	}
+	for i := range expected {
+		if liked[i].VideoUUID != expected[i] {
+			t.Errorf("expected liked video %d to be %s, got %s", i, expected[i], liked[i].VideoUUID)
+		}
+	}
+}
+
+// assertFavoriteChangedEvents 按写入顺序比较事件中的前后状态与 statusTransitions，事件都以视频 UUID 作为分区键并带上视频作者
+func assertFavoriteChangedEvents(t *testing.T, db *sql.DB, userUUID string, videoUUID string, authorUUID string) {
+	t.Helper()
+	rows, err := db.Query(
+		"SELECT partition_key, payload->>'$.video_author_uuid', payload->>'$.previous_status', payload->>'$.status' FROM outbox_events"+
+			" WHERE event_name = ? AND payload->>'$.user_uuid' = ? AND payload->>'$.video_uuid' = ? ORDER BY id",
+		favorite.FavoriteChanged{}.EventName(), userUUID, videoUUID,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	previous := favorite.None
+	i := 0
+	for ; rows.Next(); i++ {
+		var partitionKey, videoAuthorUUID string
+		var previousStatus, status int
+		if err := rows.Scan(&partitionKey, &videoAuthorUUID, &previousStatus, &status); err != nil {
+			t.Fatal(err)
+		}
+		if partitionKey != videoUUID {
+			t.Errorf("expected event %d to be partitioned by video %s, got %q", i, videoUUID, partitionKey)
+		}
+		if videoAuthorUUID != authorUUID {
+			t.Errorf("expected event %d to carry video author %s, got %q", i, authorUUID, videoAuthorUUID)
+		}
+		if i >= len(statusTransitions) {
+			continue
+		}
+		expected := statusTransitions[i].Expected
+		if previousStatus != previous.Int() || status != expected.Int() {
+			t.Errorf("expected event %d to change %d -> %d, got %d -> %d", i, previous.Int(), expected.Int(), previousStatus, status)
+		}
+		previous = expected
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if i != len(statusTransitions) {
+		t.Errorf("expected %d FavoriteChanged events, got %d", len(statusTransitions), i)
+	}
+}
@@ -0,0 +1,115 @@
+package adapters
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"newTiktoken/internal/common/pagination"
+	"newTiktoken/internal/video-favorite/app/query"
+	"newTiktoken/internal/video-favorite/domain/favorite"
+)
+
+type favoriteKey struct {
+	userUUID  string
+	videoUUID string
+}
+
+// MemoryFavoriteRepository 是线程安全的内存点赞仓库，同时实现 query.FavoriteReadModel，用于测试和本地运行
+// 与 MySQL 实现不同，它不写入 outbox 事件
+type MemoryFavoriteRepository struct {
+	lock      *sync.RWMutex
+	favorites map[favoriteKey]favorite.Favorite
+}
+
+func NewMemoryFavoriteRepository() *MemoryFavoriteRepository {
+	return &MemoryFavoriteRepository{
+		lock:      &sync.RWMutex{},
+		favorites: map[favoriteKey]favorite.Favorite{},
+	}
+}
+
+func (m MemoryFavoriteRepository) GetFavorite(_ context.Context, userUUID string, videoUUID string) (*favorite.Favorite, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	f, ok := m.favorites[favoriteKey{userUUID, videoUUID}]
+	if !ok {
+		return nil, nil
+	}
+	return &f, nil
+}
+
+func (m MemoryFavoriteRepository) UpdateFavorite(
+	ctx context.Context,
+	userUUID string,
+	videoUUID string,
+	updateFn func(ctx context.Context, f *favorite.Favorite) (*favorite.Favorite, error),
+) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	key := favoriteKey{userUUID, videoUUID}
+	f, ok := m.favorites[key]
+	current := &f
+	if !ok {
+		var err error
+		if current, err = favorite.NewFavorite(userUUID, videoUUID); err != nil {
+			return err
+		}
+	}
+	updated, err := updateFn(ctx, current)
+	if err != nil {
+		return err
+	}
+	m.favorites[key] = *updated
+	return nil
+}
+
+// FindLikedVideos 按点赞时间倒序返回，点赞时间相同时按视频 UUID 倒序，与 MySQL 实现一致
+func (m MemoryFavoriteRepository) FindLikedVideos(_ context.Context, userUUID string, after pagination.Cursor, limit int) ([]query.LikedVideo, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	var liked []query.LikedVideo
+	for key, f := range m.favorites {
+		if key.userUUID != userUUID || f.Status() != favorite.Liked {
+			continue
+		}
+		if !after.IsZero() && !isAfterCursor(f, after) {
+			continue
+		}
+		liked = append(liked, query.LikedVideo{VideoUUID: f.VideoUUID(), LikedAt: f.UpdatedAt()})
+	}
+	sort.Slice(liked, func(i, j int) bool {
+		if !liked[i].LikedAt.Equal(liked[j].LikedAt) {
+			return liked[i].LikedAt.After(liked[j].LikedAt)
+		}
+		return liked[i].VideoUUID > liked[j].VideoUUID
+	})
+	if len(liked) > limit {
+		liked = liked[:limit]
+	}
+	return liked, nil
+}
+
+func (m MemoryFavoriteRepository) FindLikedVideoUUIDs(_ context.Context, userUUID string, videoUUIDs []string) (map[string]struct{}, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	liked := make(map[string]struct{}, len(videoUUIDs))
+	for _, videoUUID := range videoUUIDs {
+		if f, ok := m.favorites[favoriteKey{userUUID, videoUUID}]; ok && f.Status() == favorite.Liked {
+			liked[videoUUID] = struct{}{}
+		}
+	}
+	return liked, nil
+}
+
+// isAfterCursor 判断记录在倒序排列中是否位于游标之后
+func isAfterCursor(f favorite.Favorite, after pagination.Cursor) bool {
+	if !f.UpdatedAt().Equal(after.Time) {
+		return f.UpdatedAt().Before(after.Time)
+	}
+	return f.VideoUUID() < after.UUID
+}
@@ -0,0 +1,89 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pkg/errors"
+	"newTiktoken/internal/common/tracing"
+	"newTiktoken/internal/video-favorite/domain/favorite"
+)
+
+const reconcileBatchSize = 1000
+
+type MySQLFavoriteCountReconciler struct {
+	db *sql.DB
+}
+
+func NewMySQLFavoriteCountReconciler(db *sql.DB) (*MySQLFavoriteCountReconciler, error) {
+	if db == nil {
+		return nil, errors.New("nil db")
+	}
+	return &MySQLFavoriteCountReconciler{db: db}, nil
+}
+
+// ReconcileFavoriteCounts 按 video_uuid 分批用 video_favorites 重新计算 videos 表中的点赞数，返回被修正的视频数
+func (m MySQLFavoriteCountReconciler) ReconcileFavoriteCounts(ctx context.Context) (_ int64, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "MySQLFavoriteCountReconciler.ReconcileFavoriteCounts")
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	var fixed int64
+	lastVideoUUID := ""
+	for {
+		firstVideoUUID, batchLastVideoUUID, err := m.nextBatch(ctx, lastVideoUUID)
+		if err != nil {
+			return fixed, err
+		}
+		if batchLastVideoUUID == "" {
+			return fixed, nil
+		}
+
+		affected, err := m.reconcileRange(ctx, firstVideoUUID, batchLastVideoUUID)
+		if err != nil {
+			return fixed, err
+		}
+		fixed += affected
+		lastVideoUUID = batchLastVideoUUID
+	}
+}
+
+// nextBatch 返回 afterVideoUUID 之后一批视频的 UUID 范围，没有更多视频时返回空字符串
+func (m MySQLFavoriteCountReconciler) nextBatch(ctx context.Context, afterVideoUUID string) (string, string, error) {
+	const selectQuery = `
+        SELECT MIN(video_uuid), MAX(video_uuid)
+        FROM (
+            SELECT video_uuid FROM videos
+            WHERE video_uuid > ?
+            ORDER BY video_uuid
+            LIMIT ?
+        ) batch`
+	var first, last sql.NullString
+	if err := m.db.QueryRowContext(ctx, selectQuery, afterVideoUUID, reconcileBatchSize).Scan(&first, &last); err != nil {
+		return "", "", errors.Wrap(err, "failed to select video batch for reconciliation")
+	}
+	return first.String, last.String, nil
+}
+
+func (m MySQLFavoriteCountReconciler) reconcileRange(ctx context.Context, firstVideoUUID, lastVideoUUID string) (int64, error) {
+	const updateQuery = `
+        UPDATE videos v
+        LEFT JOIN (
+            SELECT video_uuid, COUNT(*) AS favorite_count
+            FROM video_favorites
+            WHERE status = ? AND video_uuid BETWEEN ? AND ?
+            GROUP BY video_uuid
+        ) liked ON liked.video_uuid = v.video_uuid
+        SET v.favorite_count = COALESCE(liked.favorite_count, 0)
+        WHERE v.video_uuid BETWEEN ? AND ?
+            AND v.favorite_count <> COALESCE(liked.favorite_count, 0)`
+	result, err := m.db.ExecContext(ctx, updateQuery,
+		favorite.Liked.Int(), firstVideoUUID, lastVideoUUID,
+		firstVideoUUID, lastVideoUUID,
+	)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to reconcile favorite counts between %s and %s", firstVideoUUID, lastVideoUUID)
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,97 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/pkg/errors"
+	"newTiktoken/internal/common/pagination"
+	"newTiktoken/internal/common/tracing"
+	"newTiktoken/internal/video-favorite/app/query"
+	"newTiktoken/internal/video-favorite/domain/favorite"
+)
+
+type MySQLFavoriteFinder struct {
+	db *sql.DB
+}
+
+func NewMySQLFavoriteFinder(db *sql.DB) (*MySQLFavoriteFinder, error) {
+	if db == nil {
+		return nil, errors.New("nil db")
+	}
+	return &MySQLFavoriteFinder{db: db}, nil
+}
+
+// FindLikedVideos 使用 idx_video_favorites_user_status_updated_at 索引做 keyset 分页
+func (m MySQLFavoriteFinder) FindLikedVideos(ctx context.Context, userUUID string, after pagination.Cursor, limit int) (_ []query.LikedVideo, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "MySQLFavoriteFinder.FindLikedVideos")
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	selectQuery := `
+        SELECT video_uuid, updated_at
+        FROM video_favorites
+        WHERE user_uuid = ? AND status = ?`
+	args := []any{userUUID, favorite.Liked.Int()}
+	if !after.IsZero() {
+		selectQuery += ` AND (updated_at < ? OR (updated_at = ? AND video_uuid < ?))`
+		args = append(args, after.Time, after.Time, after.UUID)
+	}
+	selectQuery += ` ORDER BY updated_at DESC, video_uuid DESC LIMIT ?`
+	args = append(args, limit)
+
+	rows, err := m.db.QueryContext(ctx, selectQuery, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query liked videos of %s", userUUID)
+	}
+	defer rows.Close()
+
+	var liked []query.LikedVideo
+	for rows.Next() {
+		var l query.LikedVideo
+		if err := rows.Scan(&l.VideoUUID, &l.LikedAt); err != nil {
+			return nil, errors.Wrap(err, "failed to scan liked video")
+		}
+		liked = append(liked, l)
+	}
+	return liked, errors.Wrap(rows.Err(), "failed to iterate liked videos")
+}
+
+func (m MySQLFavoriteFinder) FindLikedVideoUUIDs(ctx context.Context, userUUID string, videoUUIDs []string) (_ map[string]struct{}, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "MySQLFavoriteFinder.FindLikedVideoUUIDs")
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	liked := make(map[string]struct{}, len(videoUUIDs))
+	if len(videoUUIDs) == 0 {
+		return liked, nil
+	}
+	selectQuery := "SELECT video_uuid FROM video_favorites WHERE user_uuid = ? AND status = ? AND video_uuid IN (" +
+		placeholders(len(videoUUIDs)) + ")"
+	args := []any{userUUID, favorite.Liked.Int()}
+	for _, videoUUID := range videoUUIDs {
+		args = append(args, videoUUID)
+	}
+
+	rows, err := m.db.QueryContext(ctx, selectQuery, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query liked videos of %s", userUUID)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var videoUUID string
+		if err := rows.Scan(&videoUUID); err != nil {
+			return nil, errors.Wrap(err, "failed to scan liked video")
+		}
+		liked[videoUUID] = struct{}{}
+	}
+	return liked, errors.Wrap(rows.Err(), "failed to iterate liked videos")
+}
+
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
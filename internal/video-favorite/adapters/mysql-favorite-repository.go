@@ -0,0 +1,146 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/pkg/errors"
+	"newTiktoken/internal/common/events"
+	"newTiktoken/internal/common/tracing"
+	"newTiktoken/internal/video-favorite/domain/favorite"
+)
+
+type MySQLFavoriteRepository struct {
+	db *sql.DB
+}
+
+// NewMySQLFavoriteRepository 创建一个新的 MySQL 点赞仓库实例
+// video_favorites 表由 cmd/migrate 执行 internal/common/migrations 中的迁移创建
+func NewMySQLFavoriteRepository(db *sql.DB) (favorite.Repository, error) {
+	if db == nil {
+		return nil, errors.New("nil db")
+	}
+	return &MySQLFavoriteRepository{db: db}, nil
+}
+
+func (m MySQLFavoriteRepository) GetFavorite(ctx context.Context, userUUID string, videoUUID string) (_ *favorite.Favorite, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "MySQLFavoriteRepository.GetFavorite")
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	f, err := scanFavorite(m.db.QueryRowContext(ctx, selectFavorite, userUUID, videoUUID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return f, err
+}
+
+// UpdateFavorite 加锁读取记录后调用 updateFn，状态发生变化时在同一事务中写入 FavoriteChanged 事件
+// 第一次操作时先插入没有态度的记录再加锁：锁定不存在的记录只会加间隙锁，并发的首次操作（如连续点击）会在插入时死锁或冲突；
+// 先插入后，并发的操作在唯一键上排队，后执行的一方看到已提交的状态，得到 ErrAlreadyLiked 等领域错误
+func (m MySQLFavoriteRepository) UpdateFavorite(
+	ctx context.Context,
+	userUUID string,
+	videoUUID string,
+	updateFn func(ctx context.Context, f *favorite.Favorite) (*favorite.Favorite, error),
+) (err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "MySQLFavoriteRepository.UpdateFavorite")
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	initial, err := favorite.NewFavorite(userUUID, videoUUID)
+	if err != nil {
+		return err
+	}
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		} else if err != nil {
+			_ = tx.Rollback()
+		} else {
+			err = tx.Commit()
+		}
+	}()
+
+	// 记录已存在时不修改，只是等待并持有它的行锁；操作被拒绝时随事务回滚
+	_, err = tx.ExecContext(ctx,
+		"INSERT INTO video_favorites (user_uuid, video_uuid, status, created_at, updated_at) VALUES (?, ?, ?, ?, ?)"+
+			" ON DUPLICATE KEY UPDATE user_uuid = user_uuid",
+		userUUID,
+		videoUUID,
+		initial.Status().Int(),
+		initial.CreatedAt().UTC(),
+		initial.UpdatedAt().UTC(),
+	)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create favorite of %s on %s", userUUID, videoUUID)
+	}
+	current, err := scanFavorite(tx.QueryRowContext(ctx, selectFavorite+" FOR UPDATE", userUUID, videoUUID))
+	if err != nil {
+		return err
+	}
+	previous := current.Status()
+
+	updated, err := updateFn(ctx, current)
+	if err != nil {
+		return err
+	}
+	if updated.Status() == previous {
+		return nil
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"UPDATE video_favorites SET status = ?, updated_at = ? WHERE user_uuid = ? AND video_uuid = ?",
+		updated.Status().Int(),
+		updated.UpdatedAt().UTC(),
+		userUUID,
+		videoUUID,
+	)
+	if err != nil {
+		return errors.Wrapf(err, "failed to save favorite of %s on %s", userUUID, videoUUID)
+	}
+
+	// videos 表属于 video 服务，两个服务共用同一个数据库；视频不存在时事件不带作者
+	var videoAuthorUUID string
+	err = tx.QueryRowContext(ctx, "SELECT author_uuid FROM videos WHERE video_uuid = ?", videoUUID).Scan(&videoAuthorUUID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return errors.Wrapf(err, "failed to get author of %s", videoUUID)
+	}
+
+	return events.StoreInOutbox(ctx, tx, favorite.NewFavoriteChanged(previous, updated, videoAuthorUUID))
+}
+
+const selectFavorite = `
+        SELECT user_uuid, video_uuid, status, created_at, updated_at
+        FROM video_favorites
+        WHERE user_uuid = ? AND video_uuid = ?`
+
+// scanFavorite 在记录不存在时返回 sql.ErrNoRows
+func scanFavorite(row *sql.Row) (*favorite.Favorite, error) {
+	var (
+		userUUID, videoUUID  string
+		status               int
+		createdAt, updatedAt time.Time
+	)
+	if err := row.Scan(&userUUID, &videoUUID, &status, &createdAt, &updatedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "failed to scan favorite")
+	}
+	favoriteStatus, err := favorite.NewStatusFromInt(status)
+	if err != nil {
+		return nil, err
+	}
+	return favorite.UnmarshalFavoriteFromDatabase(userUUID, videoUUID, favoriteStatus, createdAt, updatedAt), nil
+}
@@ -0,0 +1,121 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pkg/errors"
+	"newTiktoken/internal/common/events"
+	"newTiktoken/internal/common/tracing"
+	"newTiktoken/internal/video-favorite/app/query"
+	"newTiktoken/internal/video-favorite/domain/favorite"
+)
+
+// favoriteCountsConsumer 是 processed_events 中记录视频点赞数更新的消费者名
+const favoriteCountsConsumer = "video-favorite-service.favorite-counts"
+
+// MySQLVideoService 直接读写 video 服务的 videos 表
+// 点赞服务与视频服务共用数据库，与评论服务一样不需要经过 gRPC
+type MySQLVideoService struct {
+	db *sql.DB
+}
+
+func NewMySQLVideoService(db *sql.DB) (*MySQLVideoService, error) {
+	if db == nil {
+		return nil, errors.New("nil db")
+	}
+	return &MySQLVideoService{db: db}, nil
+}
+
+func (m MySQLVideoService) CheckVideoExists(ctx context.Context, videoUUID string) (err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "MySQLVideoService.CheckVideoExists")
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	var exists int
+	err = m.db.QueryRowContext(ctx, "SELECT 1 FROM videos WHERE video_uuid = ?", videoUUID).Scan(&exists)
+	if errors.Is(err, sql.ErrNoRows) {
+		return favorite.ErrVideoNotFound
+	}
+	return errors.Wrapf(err, "failed to find video %s", videoUUID)
+}
+
+func (m MySQLVideoService) GetVideos(ctx context.Context, videoUUIDs []string) (_ map[string]query.Video, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "MySQLVideoService.GetVideos")
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	videos := make(map[string]query.Video, len(videoUUIDs))
+	if len(videoUUIDs) == 0 {
+		return videos, nil
+	}
+	selectQuery := `
+        SELECT
+            video_uuid, author_uuid, title, play_url,
+            favorite_count, comment_count, share_count, created_at
+        FROM videos
+        WHERE video_uuid IN (` + placeholders(len(videoUUIDs)) + `)`
+	args := make([]any, 0, len(videoUUIDs))
+	for _, videoUUID := range videoUUIDs {
+		args = append(args, videoUUID)
+	}
+
+	rows, err := m.db.QueryContext(ctx, selectQuery, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query videos")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var v query.Video
+		if err := rows.Scan(
+			&v.UUID,
+			&v.Author.UUID,
+			&v.Title,
+			&v.PlayURL,
+			&v.FavoriteCount,
+			&v.CommentCount,
+			&v.ShareCount,
+			&v.CreatedAt,
+		); err != nil {
+			return nil, errors.Wrap(err, "failed to scan video")
+		}
+		videos[v.UUID] = v
+	}
+	return videos, errors.Wrap(rows.Err(), "failed to iterate videos")
+}
+
+// AddFavoriteCount 在同一事务中记录事件已处理并修改视频的点赞数，重复投递的事件不会再次计数
+// 同一个视频的事件按发生顺序被消费，点赞数不会减到 0 以下；其他原因产生的偏差由 MySQLFavoriteCountReconciler 定期修正
+func (m MySQLVideoService) AddFavoriteCount(ctx context.Context, eventUUID string, videoUUID string, delta int64) (err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "MySQLVideoService.AddFavoriteCount")
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		} else if err != nil {
+			_ = tx.Rollback()
+		} else {
+			err = tx.Commit()
+		}
+	}()
+
+	firstTime, err := events.MarkProcessed(ctx, tx, favoriteCountsConsumer, eventUUID)
+	if err != nil || !firstTime {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE videos SET favorite_count = favorite_count + ? WHERE video_uuid = ?", delta, videoUUID)
+	return errors.Wrapf(err, "failed to update favorite count of %s", videoUUID)
+}
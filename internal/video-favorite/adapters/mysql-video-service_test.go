@@ -0,0 +1,152 @@
+package adapters_test
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/metrics"
+	"newTiktoken/internal/video-favorite/adapters"
+	"newTiktoken/internal/video-favorite/app/command"
+	"newTiktoken/internal/video-favorite/domain/favorite"
+)
+
+// TestFavoriteCountIgnoresDuplicateEvents 每个态度变化事件都投递两次，点赞数仍然等于当前点赞的用户数
+// 需要提供 MYSQL_DSN，数据库需要先执行 cmd/migrate up
+func TestFavoriteCountIgnoresDuplicateEvents(t *testing.T) {
+	t.Parallel()
+	db := openTestDB(t)
+
+	repository, err := adapters.NewMySQLFavoriteRepository(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	videoService, err := adapters.NewMySQLVideoService(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler := command.NewUpdateVideoFavoriteCountHandler(videoService, logrus.NewEntry(logrus.StandardLogger()), metrics.NoOp{})
+
+	ctx := context.Background()
+	videoUUID, authorUUID := insertTestVideo(t, db)
+
+	userA, userB := uuid.NewString(), uuid.NewString()
+	expectedCounts := []uint64{1, 2, 1, 0, 1}
+	var changes []favorite.FavoriteChanged
+	record := func(userUUID string, change func(f *favorite.Favorite) error) {
+		t.Helper()
+		err := repository.UpdateFavorite(ctx, userUUID, videoUUID, func(_ context.Context, f *favorite.Favorite) (*favorite.Favorite, error) {
+			previous := f.Status()
+			if err := change(f); err != nil {
+				return nil, err
+			}
+			changes = append(changes, favorite.NewFavoriteChanged(previous, f, authorUUID))
+			return f, nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	record(userA, (*favorite.Favorite).Like)
+	record(userB, (*favorite.Favorite).Like)
+	record(userA, (*favorite.Favorite).Dislike)
+	record(userB, (*favorite.Favorite).CancelLike)
+	record(userB, (*favorite.Favorite).Like)
+
+	for i, event := range changes {
+		cmd := command.UpdateVideoFavoriteCount{EventUUID: uuid.NewString(), Event: event}
+		for range 2 {
+			if err := handler.Handle(ctx, cmd); err != nil {
+				t.Fatal(err)
+			}
+			assertFavoriteCount(t, db, videoUUID, expectedCounts[i])
+		}
+	}
+}
+
+// TestReconcileFavoriteCounts 把点赞数改成与点赞记录不一致的值后，校准会把它修正回点赞的用户数
+// 需要提供 MYSQL_DSN，数据库需要先执行 cmd/migrate up
+func TestReconcileFavoriteCounts(t *testing.T) {
+	t.Parallel()
+	db := openTestDB(t)
+
+	repository, err := adapters.NewMySQLFavoriteRepository(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reconciler, err := adapters.NewMySQLFavoriteCountReconciler(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	videoUUID, _ := insertTestVideo(t, db)
+	for _, change := range []func(f *favorite.Favorite) error{
+		(*favorite.Favorite).Like,
+		(*favorite.Favorite).Like,
+		(*favorite.Favorite).Dislike,
+	} {
+		err := repository.UpdateFavorite(ctx, uuid.NewString(), videoUUID, func(_ context.Context, f *favorite.Favorite) (*favorite.Favorite, error) {
+			return f, change(f)
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := db.ExecContext(ctx, "UPDATE videos SET favorite_count = 7 WHERE video_uuid = ?", videoUUID); err != nil {
+		t.Fatal(err)
+	}
+
+	fixed, err := reconciler.ReconcileFavoriteCounts(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fixed < 1 {
+		t.Errorf("expected at least 1 fixed video, got %d", fixed)
+	}
+	assertFavoriteCount(t, db, videoUUID, 2)
+}
+
+func openTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+	dsn := os.Getenv("MYSQL_DSN")
+	if dsn == "" {
+		t.Skip("MYSQL_DSN is not set")
+	}
+	db, err := sql.Open("mysql", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+	return db
+}
+
+// insertTestVideo 插入一个点赞数为 0 的视频，返回视频和作者的 uuid
+func insertTestVideo(t *testing.T, db *sql.DB) (string, string) {
+	t.Helper()
+	videoUUID, authorUUID := uuid.NewString(), uuid.NewString()
+	now := time.Now().UTC()
+	_, err := db.Exec(
+		"INSERT INTO videos (video_uuid, author_uuid, title, play_url, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)",
+		videoUUID, authorUUID, "example video", "https://cdn.example.com/videos/example.mp4", now, now,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return videoUUID, authorUUID
+}
+
+func assertFavoriteCount(t *testing.T, db *sql.DB, videoUUID string, expected uint64) {
+	t.Helper()
+	var count uint64
+	if err := db.QueryRow("SELECT favorite_count FROM videos WHERE video_uuid = ?", videoUUID).Scan(&count); err != nil {
+		t.Fatal(err)
+	}
+	if count != expected {
+		t.Errorf("expected favorite count %d, got %d", expected, count)
+	}
+}
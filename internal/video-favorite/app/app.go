@@ -0,0 +1,25 @@
+package app
+
+import (
+	"newTiktoken/internal/video-favorite/app/command"
+	"newTiktoken/internal/video-favorite/app/query"
+)
+
+type Application struct {
+	Commands Commands
+	Queries  Queries
+}
+
+type Commands struct {
+	LikeVideo     command.LikeVideoHandler
+	CancelLike    command.CancelLikeHandler
+	DislikeVideo  command.DislikeVideoHandler
+	CancelDislike command.CancelDislikeHandler
+
+	UpdateVideoFavoriteCount     command.UpdateVideoFavoriteCountHandler
+	ReconcileVideoFavoriteCounts command.ReconcileVideoFavoriteCountsHandler
+}
+
+type Queries struct {
+	FavoriteList query.FavoriteListHandler
+}
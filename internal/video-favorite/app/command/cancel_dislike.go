@@ -0,0 +1,48 @@
+package command
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/auth"
+	"newTiktoken/internal/common/decorator"
+	"newTiktoken/internal/common/logs"
+	"newTiktoken/internal/video-favorite/domain/favorite"
+)
+
+// CancelDislike 取消踩，视频没有被踩时返回 favorite.ErrNotDisliked
+type CancelDislike struct {
+	User auth.User
+
+	UserUUID  string
+	VideoUUID string
+}
+
+type CancelDislikeHandler decorator.CommandHandler[CancelDislike]
+
+type cancelDislikeHandler struct {
+	repo favorite.Repository
+}
+
+func (h cancelDislikeHandler) Handle(ctx context.Context, cmd CancelDislike) (err error) {
+	defer func() {
+		logs.LogCommandExecution("CancelDislike", cmd, err)
+	}()
+	if err := CancelDislikePolicy.Authorize(cmd.User, cmd); err != nil {
+		return err
+	}
+	return changeFavorite(ctx, h.repo, cmd.UserUUID, cmd.VideoUUID, (*favorite.Favorite).CancelDislike)
+}
+
+func NewCancelDislikeHandler(repo favorite.Repository,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient) CancelDislikeHandler {
+	if repo == nil {
+		panic("nil repo")
+	}
+	return decorator.ApplyCommandDecorators[CancelDislike](
+		cancelDislikeHandler{repo: repo},
+		logger,
+		metricsClient,
+	)
+}
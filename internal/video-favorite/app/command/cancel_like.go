@@ -0,0 +1,48 @@
+package command
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/auth"
+	"newTiktoken/internal/common/decorator"
+	"newTiktoken/internal/common/logs"
+	"newTiktoken/internal/video-favorite/domain/favorite"
+)
+
+// CancelLike 取消点赞，视频没有被点赞时返回 favorite.ErrNotLiked
+type CancelLike struct {
+	User auth.User
+
+	UserUUID  string
+	VideoUUID string
+}
+
+type CancelLikeHandler decorator.CommandHandler[CancelLike]
+
+type cancelLikeHandler struct {
+	repo favorite.Repository
+}
+
+func (h cancelLikeHandler) Handle(ctx context.Context, cmd CancelLike) (err error) {
+	defer func() {
+		logs.LogCommandExecution("CancelLike", cmd, err)
+	}()
+	if err := CancelLikePolicy.Authorize(cmd.User, cmd); err != nil {
+		return err
+	}
+	return changeFavorite(ctx, h.repo, cmd.UserUUID, cmd.VideoUUID, (*favorite.Favorite).CancelLike)
+}
+
+func NewCancelLikeHandler(repo favorite.Repository,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient) CancelLikeHandler {
+	if repo == nil {
+		panic("nil repo")
+	}
+	return decorator.ApplyCommandDecorators[CancelLike](
+		cancelLikeHandler{repo: repo},
+		logger,
+		metricsClient,
+	)
+}
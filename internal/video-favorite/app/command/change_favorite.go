@@ -0,0 +1,23 @@
+package command
+
+import (
+	"context"
+
+	"newTiktoken/internal/video-favorite/domain/favorite"
+)
+
+// changeFavorite 在仓库的事务中对用户和视频的记录执行一次状态转换
+func changeFavorite(
+	ctx context.Context,
+	repo favorite.Repository,
+	userUUID string,
+	videoUUID string,
+	change func(f *favorite.Favorite) error,
+) error {
+	return repo.UpdateFavorite(ctx, userUUID, videoUUID, func(ctx context.Context, f *favorite.Favorite) (*favorite.Favorite, error) {
+		if err := change(f); err != nil {
+			return nil, err
+		}
+		return f, nil
+	})
+}
@@ -0,0 +1,56 @@
+package command
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/auth"
+	"newTiktoken/internal/common/decorator"
+	"newTiktoken/internal/common/logs"
+	"newTiktoken/internal/video-favorite/domain/favorite"
+)
+
+// DislikeVideo 踩视频，点赞过的视频会改为踩
+type DislikeVideo struct {
+	User auth.User
+
+	UserUUID  string
+	VideoUUID string
+}
+
+type DislikeVideoHandler decorator.CommandHandler[DislikeVideo]
+
+type dislikeVideoHandler struct {
+	repo         favorite.Repository
+	videoService VideoService
+}
+
+func (h dislikeVideoHandler) Handle(ctx context.Context, cmd DislikeVideo) (err error) {
+	defer func() {
+		logs.LogCommandExecution("DislikeVideo", cmd, err)
+	}()
+	if err := DislikeVideoPolicy.Authorize(cmd.User, cmd); err != nil {
+		return err
+	}
+	if err := h.videoService.CheckVideoExists(ctx, cmd.VideoUUID); err != nil {
+		return err
+	}
+	return changeFavorite(ctx, h.repo, cmd.UserUUID, cmd.VideoUUID, (*favorite.Favorite).Dislike)
+}
+
+func NewDislikeVideoHandler(repo favorite.Repository,
+	videoService VideoService,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient) DislikeVideoHandler {
+	if repo == nil {
+		panic("nil repo")
+	}
+	if videoService == nil {
+		panic("nil videoService")
+	}
+	return decorator.ApplyCommandDecorators[DislikeVideo](
+		dislikeVideoHandler{repo: repo, videoService: videoService},
+		logger,
+		metricsClient,
+	)
+}
@@ -0,0 +1,56 @@
+package command
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/auth"
+	"newTiktoken/internal/common/decorator"
+	"newTiktoken/internal/common/logs"
+	"newTiktoken/internal/video-favorite/domain/favorite"
+)
+
+// LikeVideo 点赞视频，踩过的视频会改为点赞
+type LikeVideo struct {
+	User auth.User
+
+	UserUUID  string
+	VideoUUID string
+}
+
+type LikeVideoHandler decorator.CommandHandler[LikeVideo]
+
+type likeVideoHandler struct {
+	repo         favorite.Repository
+	videoService VideoService
+}
+
+func (h likeVideoHandler) Handle(ctx context.Context, cmd LikeVideo) (err error) {
+	defer func() {
+		logs.LogCommandExecution("LikeVideo", cmd, err)
+	}()
+	if err := LikeVideoPolicy.Authorize(cmd.User, cmd); err != nil {
+		return err
+	}
+	if err := h.videoService.CheckVideoExists(ctx, cmd.VideoUUID); err != nil {
+		return err
+	}
+	return changeFavorite(ctx, h.repo, cmd.UserUUID, cmd.VideoUUID, (*favorite.Favorite).Like)
+}
+
+func NewLikeVideoHandler(repo favorite.Repository,
+	videoService VideoService,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient) LikeVideoHandler {
+	if repo == nil {
+		panic("nil repo")
+	}
+	if videoService == nil {
+		panic("nil videoService")
+	}
+	return decorator.ApplyCommandDecorators[LikeVideo](
+		likeVideoHandler{repo: repo, videoService: videoService},
+		logger,
+		metricsClient,
+	)
+}
@@ -0,0 +1,96 @@
+package command_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/auth"
+	commonerrors "newTiktoken/internal/common/errors"
+	"newTiktoken/internal/common/metrics"
+	"newTiktoken/internal/video-favorite/adapters"
+	"newTiktoken/internal/video-favorite/app/command"
+	"newTiktoken/internal/video-favorite/domain/favorite"
+)
+
+// existingVideos 是只认识固定视频的 command.VideoService
+type existingVideos map[string]struct{}
+
+func (v existingVideos) CheckVideoExists(_ context.Context, videoUUID string) error {
+	if _, ok := v[videoUUID]; !ok {
+		return favorite.ErrVideoNotFound
+	}
+	return nil
+}
+
+var userA = auth.User{UUID: "user-a", Role: "user"}
+
+func TestLikeVideo(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		Name        string
+		Command     command.LikeVideo
+		LikedBefore bool
+		ExpectedErr commonerrors.ErrorType
+	}{
+		{
+			Name:    "like",
+			Command: command.LikeVideo{User: userA, UserUUID: "user-a", VideoUUID: "video-1"},
+		},
+		{
+			Name:        "like_twice",
+			Command:     command.LikeVideo{User: userA, UserUUID: "user-a", VideoUUID: "video-1"},
+			LikedBefore: true,
+			ExpectedErr: commonerrors.ErrorTypeConflict,
+		},
+		{
+			Name:        "other_user",
+			Command:     command.LikeVideo{User: userA, UserUUID: "user-b", VideoUUID: "video-1"},
+			ExpectedErr: commonerrors.ErrorTypeAuthorization,
+		},
+		{
+			Name:        "missing_video",
+			Command:     command.LikeVideo{User: userA, UserUUID: "user-a", VideoUUID: "missing"},
+			ExpectedErr: commonerrors.ErrorTypeNotFound,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			repository := adapters.NewMemoryFavoriteRepository()
+			handler := command.NewLikeVideoHandler(
+				repository,
+				existingVideos{"video-1": {}},
+				logrus.NewEntry(logrus.StandardLogger()),
+				metrics.NoOp{},
+			)
+			if tc.LikedBefore {
+				if err := handler.Handle(ctx, tc.Command); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			err := handler.Handle(ctx, tc.Command)
+			if tc.ExpectedErr != (commonerrors.ErrorType{}) {
+				var slugErr commonerrors.SlugError
+				if !errors.As(err, &slugErr) || slugErr.ErrorType() != tc.ExpectedErr {
+					t.Fatalf("expected %v error, got %v", tc.ExpectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			f, err := repository.GetFavorite(ctx, tc.Command.UserUUID, tc.Command.VideoUUID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if f == nil || f.Status() != favorite.Liked {
+				t.Errorf("expected video to be liked, got %+v", f)
+			}
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package command
+
+import "newTiktoken/internal/common/auth"
+
+// 点赞和踩只能由用户本人操作，管理员也不能代替用户点赞
+
+var LikeVideoPolicy = auth.Owner(func(cmd LikeVideo) string { return cmd.UserUUID })
+
+var CancelLikePolicy = auth.Owner(func(cmd CancelLike) string { return cmd.UserUUID })
+
+var DislikeVideoPolicy = auth.Owner(func(cmd DislikeVideo) string { return cmd.UserUUID })
+
+var CancelDislikePolicy = auth.Owner(func(cmd CancelDislike) string { return cmd.UserUUID })
@@ -0,0 +1,46 @@
+package command
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/decorator"
+)
+
+// ReconcileVideoFavoriteCounts 用点赞记录重新计算所有视频的点赞数，修正增量更新产生的偏差
+type ReconcileVideoFavoriteCounts struct{}
+
+type ReconcileVideoFavoriteCountsHandler decorator.CommandHandler[ReconcileVideoFavoriteCounts]
+
+type VideoFavoriteCountReconciler interface {
+	ReconcileFavoriteCounts(ctx context.Context) (int64, error)
+}
+
+type reconcileVideoFavoriteCountsHandler struct {
+	reconciler VideoFavoriteCountReconciler
+	logger     *logrus.Entry
+}
+
+func (h reconcileVideoFavoriteCountsHandler) Handle(ctx context.Context, _ ReconcileVideoFavoriteCounts) error {
+	fixed, err := h.reconciler.ReconcileFavoriteCounts(ctx)
+	if err != nil {
+		return err
+	}
+	if fixed > 0 {
+		h.logger.WithField("fixed_videos", fixed).Warn("Video favorite counts drifted and were reconciled")
+	}
+	return nil
+}
+
+func NewReconcileVideoFavoriteCountsHandler(reconciler VideoFavoriteCountReconciler,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient) ReconcileVideoFavoriteCountsHandler {
+	if reconciler == nil {
+		panic("nil reconciler")
+	}
+	return decorator.ApplyCommandDecorators[ReconcileVideoFavoriteCounts](
+		reconcileVideoFavoriteCountsHandler{reconciler: reconciler, logger: logger},
+		logger,
+		metricsClient,
+	)
+}
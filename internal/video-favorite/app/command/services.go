@@ -0,0 +1,8 @@
+package command
+
+import "context"
+
+// VideoService 用于确认视频存在，视频不存在时返回 favorite.ErrVideoNotFound
+type VideoService interface {
+	CheckVideoExists(ctx context.Context, videoUUID string) error
+}
@@ -0,0 +1,48 @@
+package command
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/decorator"
+	"newTiktoken/internal/video-favorite/domain/favorite"
+)
+
+// UpdateVideoFavoriteCount 在一次态度变化后更新视频的点赞数
+type UpdateVideoFavoriteCount struct {
+	EventUUID string
+	Event     favorite.FavoriteChanged
+}
+
+type UpdateVideoFavoriteCountHandler decorator.CommandHandler[UpdateVideoFavoriteCount]
+
+// VideoFavoriteCounter 按事件修改视频的点赞数，同一个事件重复投递时只计数一次
+type VideoFavoriteCounter interface {
+	AddFavoriteCount(ctx context.Context, eventUUID string, videoUUID string, delta int64) error
+}
+
+type updateVideoFavoriteCountHandler struct {
+	counter VideoFavoriteCounter
+}
+
+func (h updateVideoFavoriteCountHandler) Handle(ctx context.Context, cmd UpdateVideoFavoriteCount) error {
+	delta := cmd.Event.FavoriteCountDelta()
+	// 踩和取消踩不涉及点赞状态，不影响点赞数
+	if delta == 0 {
+		return nil
+	}
+	return h.counter.AddFavoriteCount(ctx, cmd.EventUUID, cmd.Event.VideoUUID, delta)
+}
+
+func NewUpdateVideoFavoriteCountHandler(counter VideoFavoriteCounter,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient) UpdateVideoFavoriteCountHandler {
+	if counter == nil {
+		panic("nil counter")
+	}
+	return decorator.ApplyCommandDecorators[UpdateVideoFavoriteCount](
+		updateVideoFavoriteCountHandler{counter: counter},
+		logger,
+		metricsClient,
+	)
+}
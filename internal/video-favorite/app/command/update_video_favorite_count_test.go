@@ -0,0 +1,63 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/metrics"
+	"newTiktoken/internal/video-favorite/app/command"
+	"newTiktoken/internal/video-favorite/domain/favorite"
+)
+
+// favoriteCountChanges 按事件 uuid 记录每个事件带来的点赞数变化
+type favoriteCountChanges map[string]int64
+
+func (c favoriteCountChanges) AddFavoriteCount(_ context.Context, eventUUID string, _ string, delta int64) error {
+	c[eventUUID] = delta
+	return nil
+}
+
+func TestUpdateVideoFavoriteCount(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		Name          string
+		Previous      favorite.Status
+		Current       favorite.Status
+		ExpectedDelta int64
+		ExpectCall    bool
+	}{
+		{Name: "like", Previous: favorite.None, Current: favorite.Liked, ExpectedDelta: 1, ExpectCall: true},
+		{Name: "cancel_like", Previous: favorite.Liked, Current: favorite.None, ExpectedDelta: -1, ExpectCall: true},
+		{Name: "like_to_dislike", Previous: favorite.Liked, Current: favorite.Disliked, ExpectedDelta: -1, ExpectCall: true},
+		{Name: "dislike", Previous: favorite.None, Current: favorite.Disliked},
+		{Name: "cancel_dislike", Previous: favorite.Disliked, Current: favorite.None},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			counter := favoriteCountChanges{}
+			handler := command.NewUpdateVideoFavoriteCountHandler(counter, logrus.NewEntry(logrus.StandardLogger()), metrics.NoOp{})
+
+			event := favorite.FavoriteChanged{
+				UserUUID:       "user-a",
+				VideoUUID:      "video-1",
+				PreviousStatus: tc.Previous.Int(),
+				Status:         tc.Current.Int(),
+			}
+			err := handler.Handle(context.Background(), command.UpdateVideoFavoriteCount{EventUUID: "event-1", Event: event})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			delta, called := counter["event-1"]
+			if called != tc.ExpectCall {
+				t.Fatalf("expected counter called %v, got %v", tc.ExpectCall, called)
+			}
+			if delta != tc.ExpectedDelta {
+				t.Errorf("expected delta %d, got %d", tc.ExpectedDelta, delta)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/decorator"
+	"newTiktoken/internal/common/pagination"
+)
+
+// FavoriteList 查询 UserUUID 点赞的视频，按点赞时间倒序分页，IsFavorite 表示 ViewerUUID 是否点赞了视频
+type FavoriteList struct {
+	ViewerUUID string
+	UserUUID   string
+	Cursor     string
+	Limit      int
+}
+
+type FavoriteListHandler decorator.QueryHandler[FavoriteList, FavoritePage]
+
+type favoriteListHandler struct {
+	readModel    FavoriteReadModel
+	videoService VideoService
+	userService  UserService
+}
+
+// Handle 多取一条记录用于判断是否存在下一页，已不存在的视频会被跳过
+func (h favoriteListHandler) Handle(ctx context.Context, query FavoriteList) (FavoritePage, error) {
+	after, err := pagination.DecodeCursor(query.Cursor)
+	if err != nil {
+		return FavoritePage{}, err
+	}
+	limit := pagination.NormalizePageSize(query.Limit)
+
+	liked, err := h.readModel.FindLikedVideos(ctx, query.UserUUID, after, limit+1)
+	if err != nil {
+		return FavoritePage{}, err
+	}
+	var nextCursor string
+	if len(liked) > limit {
+		liked = liked[:limit]
+		last := liked[len(liked)-1]
+		nextCursor = pagination.Cursor{Time: last.LikedAt, UUID: last.VideoUUID}.Encode()
+	}
+	if len(liked) == 0 {
+		return FavoritePage{NextCursor: nextCursor}, nil
+	}
+
+	videoUUIDs := make([]string, 0, len(liked))
+	for _, l := range liked {
+		videoUUIDs = append(videoUUIDs, l.VideoUUID)
+	}
+	found, err := h.videoService.GetVideos(ctx, videoUUIDs)
+	if err != nil {
+		return FavoritePage{}, errors.Wrap(err, "failed to get liked videos")
+	}
+	viewerLiked, err := h.viewerLiked(ctx, query, videoUUIDs)
+	if err != nil {
+		return FavoritePage{}, err
+	}
+
+	videos := make([]Video, 0, len(liked))
+	for _, l := range liked {
+		v, ok := found[l.VideoUUID]
+		if !ok {
+			continue
+		}
+		_, v.IsFavorite = viewerLiked[l.VideoUUID]
+		videos = append(videos, v)
+	}
+	if err := fillAuthors(ctx, h.userService, videos); err != nil {
+		return FavoritePage{}, err
+	}
+	return FavoritePage{Videos: videos, NextCursor: nextCursor}, nil
+}
+
+// viewerLiked 在查看自己的点赞列表时不需要再次查询
+func (h favoriteListHandler) viewerLiked(ctx context.Context, query FavoriteList, videoUUIDs []string) (map[string]struct{}, error) {
+	if query.ViewerUUID == query.UserUUID {
+		liked := make(map[string]struct{}, len(videoUUIDs))
+		for _, videoUUID := range videoUUIDs {
+			liked[videoUUID] = struct{}{}
+		}
+		return liked, nil
+	}
+	if query.ViewerUUID == "" {
+		return nil, nil
+	}
+	return h.readModel.FindLikedVideoUUIDs(ctx, query.ViewerUUID, videoUUIDs)
+}
+
+func NewFavoriteListHandler(
+	readModel FavoriteReadModel,
+	videoService VideoService,
+	userService UserService,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) FavoriteListHandler {
+	if readModel == nil {
+		panic("nil readModel")
+	}
+	if videoService == nil {
+		panic("nil videoService")
+	}
+	if userService == nil {
+		panic("nil userService")
+	}
+	return decorator.ApplyQueryDecorators[FavoriteList, FavoritePage](
+		favoriteListHandler{readModel: readModel, videoService: videoService, userService: userService},
+		logger,
+		metricsClient,
+	)
+}
@@ -0,0 +1,20 @@
+package query
+
+import (
+	"context"
+
+	"newTiktoken/internal/common/pagination"
+	"newTiktoken/internal/common/users"
+)
+
+type FavoriteReadModel interface {
+	// FindLikedVideos 按点赞时间倒序返回用户在 after 之后点赞的至多 limit 个视频
+	FindLikedVideos(ctx context.Context, userUUID string, after pagination.Cursor, limit int) ([]LikedVideo, error)
+	// FindLikedVideoUUIDs 返回 videoUUIDs 中被用户点赞的视频
+	FindLikedVideoUUIDs(ctx context.Context, userUUID string, videoUUIDs []string) (map[string]struct{}, error)
+}
+
+// fillAuthors 批量补全视频的作者资料，用户服务中不存在的作者只保留 UUID
+func fillAuthors(ctx context.Context, userService UserService, videos []Video) error {
+	return users.Fill(ctx, userService, videos, func(v *Video) *users.User { return &v.Author })
+}
@@ -0,0 +1,17 @@
+package query
+
+import (
+	"context"
+
+	"newTiktoken/internal/common/users"
+)
+
+// UserService 用于从用户服务批量获取用户资料，不存在的用户不会出现在返回的 map 中
+type UserService interface {
+	GetUsersInformation(ctx context.Context, userUUIDs []string) (map[string]users.User, error)
+}
+
+// VideoService 用于批量获取视频，不存在的视频不会出现在返回的 map 中，返回的视频只填充 Author.UUID
+type VideoService interface {
+	GetVideos(ctx context.Context, videoUUIDs []string) (map[string]Video, error)
+}
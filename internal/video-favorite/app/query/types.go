@@ -0,0 +1,32 @@
+package query
+
+import (
+	"time"
+
+	"newTiktoken/internal/common/users"
+)
+
+// Video 是返回给客户端的视频，VideoService 只填充 Author.UUID，其余作者资料由 UserService 补全
+type Video struct {
+	UUID          string
+	Author        users.User
+	Title         string
+	PlayURL       string
+	FavoriteCount uint64
+	CommentCount  uint64
+	ShareCount    uint64
+	IsFavorite    bool
+	CreatedAt     time.Time
+}
+
+// LikedVideo 是点赞列表中的一条记录，LikedAt 为点赞时间
+type LikedVideo struct {
+	VideoUUID string
+	LikedAt   time.Time
+}
+
+// FavoritePage 是点赞列表的一页数据，NextCursor 为空表示没有下一页
+type FavoritePage struct {
+	Videos     []Video
+	NextCursor string
+}
@@ -0,0 +1,47 @@
+package favorite
+
+import "time"
+
+// FavoriteChanged 在用户对视频的态度发生变化时产生，状态为 Status.Int() 的值
+// VideoAuthorUUID 供 user 服务更新作者的获赞数，视频不存在时为空
+type FavoriteChanged struct {
+	UserUUID        string    `json:"user_uuid"`
+	VideoUUID       string    `json:"video_uuid"`
+	VideoAuthorUUID string    `json:"video_author_uuid"`
+	PreviousStatus  int       `json:"previous_status"`
+	Status          int       `json:"status"`
+	ChangedAt       time.Time `json:"changed_at"`
+}
+
+func (FavoriteChanged) EventName() string {
+	return "FavoriteChanged"
+}
+
+// PartitionKey 让同一个视频的态度变化按发生顺序被消费
+func (e FavoriteChanged) PartitionKey() string {
+	return e.VideoUUID
+}
+
+func NewFavoriteChanged(previous Status, current *Favorite, videoAuthorUUID string) FavoriteChanged {
+	return FavoriteChanged{
+		UserUUID:        current.UserUUID(),
+		VideoUUID:       current.VideoUUID(),
+		VideoAuthorUUID: videoAuthorUUID,
+		PreviousStatus:  previous.Int(),
+		Status:          current.Status().Int(),
+		ChangedAt:       current.UpdatedAt().UTC(),
+	}
+}
+
+// FavoriteCountDelta 返回这次变化对视频点赞数的影响：变为点赞时为 1，取消点赞或由点赞改为踩时为 -1
+func (e FavoriteChanged) FavoriteCountDelta() int64 {
+	wasLiked := e.PreviousStatus == Liked.Int()
+	isLiked := e.Status == Liked.Int()
+	switch {
+	case !wasLiked && isLiked:
+		return 1
+	case wasLiked && !isLiked:
+		return -1
+	}
+	return 0
+}
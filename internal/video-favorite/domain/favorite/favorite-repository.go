@@ -0,0 +1,15 @@
+package favorite
+
+import "context"
+
+// Repository 是 favorite domain repository 的接口
+// 所有实现都需要通过 adapters 中的 repository contract 测试
+type Repository interface {
+	// GetFavorite 在用户从未操作过视频时返回 nil, nil
+	GetFavorite(ctx context.Context, userUUID string, videoUUID string) (*Favorite, error)
+	// UpdateFavorite 在记录不存在时把 NewFavorite 创建的记录交给 updateFn，updateFn 返回错误时不做任何修改
+	UpdateFavorite(ctx context.Context, userUUID string, videoUUID string, updateFn func(
+		ctx context.Context,
+		favorite *Favorite,
+	) (*Favorite, error)) error
+}
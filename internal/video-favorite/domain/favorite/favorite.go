@@ -0,0 +1,117 @@
+package favorite
+
+import (
+	"time"
+
+	commonerrors "newTiktoken/internal/common/errors"
+)
+
+var (
+	ErrVideoNotFound   = commonerrors.NewNotFoundError("video not found", "video-not-found")
+	ErrAlreadyLiked    = commonerrors.NewConflictError("video is already liked", "already-liked")
+	ErrNotLiked        = commonerrors.NewPreconditionFailedError("video is not liked", "not-liked")
+	ErrAlreadyDisliked = commonerrors.NewConflictError("video is already disliked", "already-disliked")
+	ErrNotDisliked     = commonerrors.NewPreconditionFailedError("video is not disliked", "not-disliked")
+)
+
+// Favorite 记录用户对视频的态度，每个用户对每个视频只有一条记录
+// 点赞和踩互斥：点赞一个踩过的视频会直接变为点赞，反之亦然
+type Favorite struct {
+	userUUID  string
+	videoUUID string
+	status    Status
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+// NewFavorite 创建一条没有态度的记录，用户第一次操作视频时使用
+func NewFavorite(userUUID string, videoUUID string) (*Favorite, error) {
+	if userUUID == "" {
+		return nil, commonerrors.NewIncorrectInputError("空的用户uuid", "empty-user-uuid")
+	}
+	if videoUUID == "" {
+		return nil, commonerrors.NewIncorrectInputError("空的视频uuid", "empty-video-uuid")
+	}
+	now := time.Now()
+	return &Favorite{
+		userUUID:  userUUID,
+		videoUUID: videoUUID,
+		status:    None,
+		createdAt: now,
+		updatedAt: now,
+	}, nil
+}
+
+func UnmarshalFavoriteFromDatabase(
+	userUUID string,
+	videoUUID string,
+	status Status,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Favorite {
+	return &Favorite{
+		userUUID:  userUUID,
+		videoUUID: videoUUID,
+		status:    status,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+}
+
+func (f Favorite) UserUUID() string {
+	return f.userUUID
+}
+
+func (f Favorite) VideoUUID() string {
+	return f.videoUUID
+}
+
+func (f Favorite) Status() Status {
+	return f.status
+}
+
+func (f Favorite) CreatedAt() time.Time {
+	return f.createdAt
+}
+
+// UpdatedAt 是进入当前状态的时间，点赞列表按它排序
+func (f Favorite) UpdatedAt() time.Time {
+	return f.updatedAt
+}
+
+func (f *Favorite) Like() error {
+	if f.status == Liked {
+		return ErrAlreadyLiked
+	}
+	f.changeStatus(Liked)
+	return nil
+}
+
+func (f *Favorite) CancelLike() error {
+	if f.status != Liked {
+		return ErrNotLiked
+	}
+	f.changeStatus(None)
+	return nil
+}
+
+func (f *Favorite) Dislike() error {
+	if f.status == Disliked {
+		return ErrAlreadyDisliked
+	}
+	f.changeStatus(Disliked)
+	return nil
+}
+
+func (f *Favorite) CancelDislike() error {
+	if f.status != Disliked {
+		return ErrNotDisliked
+	}
+	f.changeStatus(None)
+	return nil
+}
+
+func (f *Favorite) changeStatus(status Status) {
+	f.status = status
+	f.updatedAt = time.Now()
+}
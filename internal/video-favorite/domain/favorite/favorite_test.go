@@ -0,0 +1,92 @@
+package favorite_test
+
+import (
+	"errors"
+	"testing"
+
+	"newTiktoken/internal/video-favorite/domain/favorite"
+)
+
+func TestFavoriteTransitions(t *testing.T) {
+	t.Parallel()
+	like := (*favorite.Favorite).Like
+	dislike := (*favorite.Favorite).Dislike
+	cancelLike := (*favorite.Favorite).CancelLike
+	cancelDislike := (*favorite.Favorite).CancelDislike
+
+	testCases := []struct {
+		Name           string
+		From           favorite.Status
+		Action         func(f *favorite.Favorite) error
+		ExpectedStatus favorite.Status
+		ExpectedErr    error
+	}{
+		{Name: "like", From: favorite.None, Action: like, ExpectedStatus: favorite.Liked},
+		{Name: "like_twice", From: favorite.Liked, Action: like, ExpectedErr: favorite.ErrAlreadyLiked},
+		{Name: "like_disliked", From: favorite.Disliked, Action: like, ExpectedStatus: favorite.Liked},
+		{Name: "dislike", From: favorite.None, Action: dislike, ExpectedStatus: favorite.Disliked},
+		{Name: "dislike_twice", From: favorite.Disliked, Action: dislike, ExpectedErr: favorite.ErrAlreadyDisliked},
+		{Name: "dislike_liked", From: favorite.Liked, Action: dislike, ExpectedStatus: favorite.Disliked},
+		{Name: "cancel_like", From: favorite.Liked, Action: cancelLike, ExpectedStatus: favorite.None},
+		{Name: "cancel_like_never_liked", From: favorite.None, Action: cancelLike, ExpectedErr: favorite.ErrNotLiked},
+		{Name: "cancel_like_disliked", From: favorite.Disliked, Action: cancelLike, ExpectedErr: favorite.ErrNotLiked},
+		{Name: "cancel_dislike", From: favorite.Disliked, Action: cancelDislike, ExpectedStatus: favorite.None},
+		{Name: "cancel_dislike_never_disliked", From: favorite.None, Action: cancelDislike, ExpectedErr: favorite.ErrNotDisliked},
+		{Name: "cancel_dislike_liked", From: favorite.Liked, Action: cancelDislike, ExpectedErr: favorite.ErrNotDisliked},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			f, err := favorite.NewFavorite("user-a", "video-1")
+			if err != nil {
+				t.Fatal(err)
+			}
+			f = favorite.UnmarshalFavoriteFromDatabase(f.UserUUID(), f.VideoUUID(), tc.From, f.CreatedAt(), f.UpdatedAt())
+
+			err = tc.Action(f)
+			if tc.ExpectedErr != nil {
+				if !errors.Is(err, tc.ExpectedErr) {
+					t.Fatalf("expected %v, got %v", tc.ExpectedErr, err)
+				}
+				if f.Status() != tc.From {
+					t.Errorf("expected status to stay %d, got %d", tc.From.Int(), f.Status().Int())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if f.Status() != tc.ExpectedStatus {
+				t.Errorf("expected status %d, got %d", tc.ExpectedStatus.Int(), f.Status().Int())
+			}
+		})
+	}
+}
+
+func TestFavoriteChangedFavoriteCountDelta(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		Name     string
+		From     favorite.Status
+		To       favorite.Status
+		Expected int64
+	}{
+		{Name: "like", From: favorite.None, To: favorite.Liked, Expected: 1},
+		{Name: "like_disliked", From: favorite.Disliked, To: favorite.Liked, Expected: 1},
+		{Name: "cancel_like", From: favorite.Liked, To: favorite.None, Expected: -1},
+		{Name: "dislike_liked", From: favorite.Liked, To: favorite.Disliked, Expected: -1},
+		{Name: "dislike", From: favorite.None, To: favorite.Disliked, Expected: 0},
+		{Name: "cancel_dislike", From: favorite.Disliked, To: favorite.None, Expected: 0},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			event := favorite.FavoriteChanged{PreviousStatus: tc.From.Int(), Status: tc.To.Int()}
+			if delta := event.FavoriteCountDelta(); delta != tc.Expected {
+				t.Errorf("expected delta %d, got %d", tc.Expected, delta)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package favorite
+
+import (
+	"fmt"
+
+	commonerrors "newTiktoken/internal/common/errors"
+)
+
+var (
+	None     = Status{0}
+	Liked    = Status{1}
+	Disliked = Status{2}
+)
+
+// Status 是用户对视频的态度，同一时间只能是没有态度、点赞或踩中的一种
+type Status struct {
+	status int
+}
+
+func NewStatusFromInt(status int) (Status, error) {
+	switch status {
+	case 0:
+		return None, nil
+	case 1:
+		return Liked, nil
+	case 2:
+		return Disliked, nil
+	}
+
+	return Status{}, commonerrors.NewIncorrectInputError(
+		fmt.Sprintf("invalid '%d' favorite status", status),
+		"invalid-favorite-status",
+	)
+}
+
+// Int 返回状态在数据库中的存储值
+func (s Status) Int() int {
+	return s.status
+}
@@ -0,0 +1,69 @@
+package ports
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/client/v3/concurrency"
+	distributedLock "newTiktoken/internal/common/distributed-lock"
+	"newTiktoken/internal/video-favorite/app"
+	"newTiktoken/internal/video-favorite/app/command"
+)
+
+const favoriteCountReconcilerLockKey = "/lock/video-favorite/favorite-count-reconciler"
+
+// FavoriteCountReconciler 启动时和之后每隔 interval 触发一次点赞数校准；配置了 etcd 时通过分布式锁保证同一时刻只有一个实例在校准
+type FavoriteCountReconciler struct {
+	app        app.Application
+	etcdClient *clientv3.Client
+	interval   time.Duration
+}
+
+func NewFavoriteCountReconciler(application app.Application, etcdClient *clientv3.Client, interval time.Duration) *FavoriteCountReconciler {
+	return &FavoriteCountReconciler{app: application, etcdClient: etcdClient, interval: interval}
+}
+
+func (r *FavoriteCountReconciler) Run(ctx context.Context) {
+	if err := r.reconcile(ctx); err != nil {
+		logrus.WithError(err).Error("Failed to reconcile video favorite counts")
+	}
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.reconcile(ctx); err != nil {
+				logrus.WithError(err).Error("Failed to reconcile video favorite counts")
+			}
+		}
+	}
+}
+
+func (r *FavoriteCountReconciler) reconcile(ctx context.Context) error {
+	if r.etcdClient == nil {
+		return r.app.Commands.ReconcileVideoFavoriteCounts.Handle(ctx, command.ReconcileVideoFavoriteCounts{})
+	}
+
+	lock, err := distributedLock.NewDistributedLock(r.etcdClient, favoriteCountReconcilerLockKey, int(r.interval.Seconds()))
+	if err != nil {
+		return err
+	}
+	if err := lock.TryLock(ctx); err != nil {
+		_ = lock.Unlock(ctx)
+		if errors.Is(err, concurrency.ErrLocked) {
+			// 其他实例正在校准
+			return nil
+		}
+		return err
+	}
+	defer func() {
+		_ = lock.Unlock(ctx)
+	}()
+	return r.app.Commands.ReconcileVideoFavoriteCounts.Handle(ctx, command.ReconcileVideoFavoriteCounts{})
+}
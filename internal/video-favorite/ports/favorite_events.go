@@ -0,0 +1,24 @@
+package ports
+
+import (
+	"context"
+
+	"newTiktoken/internal/common/events"
+	"newTiktoken/internal/video-favorite/app"
+	"newTiktoken/internal/video-favorite/app/command"
+	"newTiktoken/internal/video-favorite/domain/favorite"
+)
+
+// NewEventHandlers 返回服务消费的事件：FavoriteChanged 用于更新视频的点赞数
+func NewEventHandlers(application app.Application) map[string]events.Handler {
+	return map[string]events.Handler{
+		favorite.FavoriteChanged{}.EventName(): events.JSONHandler(
+			func(ctx context.Context, eventUUID string, event favorite.FavoriteChanged) error {
+				return application.Commands.UpdateVideoFavoriteCount.Handle(ctx, command.UpdateVideoFavoriteCount{
+					EventUUID: eventUUID,
+					Event:     event,
+				})
+			},
+		),
+	}
+}
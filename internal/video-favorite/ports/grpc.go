@@ -0,0 +1,105 @@
+package ports
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"newTiktoken/internal/common/auth"
+	videoPb "newTiktoken/internal/common/genproto/video"
+	favoritePb "newTiktoken/internal/common/genproto/video_favorite"
+	"newTiktoken/internal/common/users"
+	"newTiktoken/internal/video-favorite/app"
+	"newTiktoken/internal/video-favorite/app/command"
+	"newTiktoken/internal/video-favorite/app/query"
+)
+
+type GrpcServer struct {
+	favoritePb.UnimplementedFavoriteServiceServer
+	app app.Application
+}
+
+func NewGrpcServer(application app.Application) *GrpcServer {
+	return &GrpcServer{app: application}
+}
+
+// FavoriteAction 以当前认证用户的身份点赞、踩或取消
+func (g *GrpcServer) FavoriteAction(ctx context.Context, req *favoritePb.FavoriteActionRequest) (*favoritePb.FavoriteActionResponse, error) {
+	user, err := auth.GRPCUserFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	switch req.GetActionType() {
+	case favoritePb.VideoActionType_LIKE:
+		err = g.app.Commands.LikeVideo.Handle(ctx, command.LikeVideo{
+			User:      user,
+			UserUUID:  user.UUID,
+			VideoUUID: req.GetVideoUuid(),
+		})
+	case favoritePb.VideoActionType_DISLIKE:
+		err = g.app.Commands.DislikeVideo.Handle(ctx, command.DislikeVideo{
+			User:      user,
+			UserUUID:  user.UUID,
+			VideoUUID: req.GetVideoUuid(),
+		})
+	case favoritePb.VideoActionType_CANCEL_LIKE:
+		err = g.app.Commands.CancelLike.Handle(ctx, command.CancelLike{
+			User:      user,
+			UserUUID:  user.UUID,
+			VideoUUID: req.GetVideoUuid(),
+		})
+	case favoritePb.VideoActionType_CANCEL_DISLIKE:
+		err = g.app.Commands.CancelDislike.Handle(ctx, command.CancelDislike{
+			User:      user,
+			UserUUID:  user.UUID,
+			VideoUUID: req.GetVideoUuid(),
+		})
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid favorite action type %s", req.GetActionType())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &favoritePb.FavoriteActionResponse{StatusMsg: "success"}, nil
+}
+
+// FavoriteList 返回 user_uuid 点赞的视频，is_favorite 表示当前认证用户是否点赞了视频
+func (g *GrpcServer) FavoriteList(ctx context.Context, req *favoritePb.FavoriteListRequest) (*favoritePb.FavoriteListResponse, error) {
+	user, err := auth.GRPCUserFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page, err := g.app.Queries.FavoriteList.Handle(ctx, query.FavoriteList{
+		ViewerUUID: user.UUID,
+		UserUUID:   req.GetUserUuid(),
+		Cursor:     req.GetCursor(),
+		Limit:      int(req.GetLimit()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &favoritePb.FavoriteListResponse{
+		StatusMsg:  "success",
+		VideoList:  queryVideosToProtoVideos(page.Videos),
+		NextCursor: page.NextCursor,
+	}, nil
+}
+
+func queryVideosToProtoVideos(videos []query.Video) []*videoPb.Video {
+	pbVideos := make([]*videoPb.Video, 0, len(videos))
+	for _, v := range videos {
+		pbVideos = append(pbVideos, &videoPb.Video{
+			Uuid:          v.UUID,
+			Author:        users.ToProto(v.Author),
+			PlayUrl:       v.PlayURL,
+			FavoriteCount: v.FavoriteCount,
+			CommentCount:  v.CommentCount,
+			IsFavorite:    v.IsFavorite,
+			Title:         v.Title,
+			ShareCount:    v.ShareCount,
+			CreateAt:      uint64(v.CreatedAt.Unix()),
+		})
+	}
+	return pbVideos
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/client"
+	"newTiktoken/internal/common/config"
+	"newTiktoken/internal/common/decorator"
+	"newTiktoken/internal/common/server"
+	"newTiktoken/internal/common/users"
+	"newTiktoken/internal/video-favorite/adapters"
+	"newTiktoken/internal/video-favorite/app"
+	"newTiktoken/internal/video-favorite/app/command"
+	"newTiktoken/internal/video-favorite/app/query"
+)
+
+// NewApplication 返回应用、驱动 gRPC 健康状态的依赖检查以及退出时的清理函数
+func NewApplication(ctx context.Context, cfg config.Config, metricsClient decorator.MetricsClient) (app.Application, []server.HealthCheck, func()) {
+	db, err := cfg.MySQL.Open()
+	if err != nil {
+		panic(err)
+	}
+	favoriteRepository, err := adapters.NewMySQLFavoriteRepository(db)
+	if err != nil {
+		panic(err)
+	}
+	favoriteFinder, err := adapters.NewMySQLFavoriteFinder(db)
+	if err != nil {
+		panic(err)
+	}
+	videoService, err := adapters.NewMySQLVideoService(db)
+	if err != nil {
+		panic(err)
+	}
+	favoriteCountReconciler, err := adapters.NewMySQLFavoriteCountReconciler(db)
+	if err != nil {
+		panic(err)
+	}
+	userClient, closeUserClient, err := client.NewUserClient()
+	if err != nil {
+		panic(err)
+	}
+	userService := users.NewUserGrpc(userClient)
+	logger := logrus.NewEntry(logrus.StandardLogger())
+
+	return app.Application{
+		Commands: app.Commands{
+			LikeVideo:     command.NewLikeVideoHandler(favoriteRepository, videoService, logger, metricsClient),
+			CancelLike:    command.NewCancelLikeHandler(favoriteRepository, logger, metricsClient),
+			DislikeVideo:  command.NewDislikeVideoHandler(favoriteRepository, videoService, logger, metricsClient),
+			CancelDislike: command.NewCancelDislikeHandler(favoriteRepository, logger, metricsClient),
+
+			UpdateVideoFavoriteCount:     command.NewUpdateVideoFavoriteCountHandler(videoService, logger, metricsClient),
+			ReconcileVideoFavoriteCounts: command.NewReconcileVideoFavoriteCountsHandler(favoriteCountReconciler, logger, metricsClient),
+		},
+		Queries: app.Queries{
+			FavoriteList: query.NewFavoriteListHandler(favoriteFinder, videoService, userService, logger, metricsClient),
+		},
+	}, []server.HealthCheck{
+		{Name: "mysql", Check: db.PingContext},
+	}, func() {
+		_ = closeUserClient()
+		_ = db.Close()
+	}
+}
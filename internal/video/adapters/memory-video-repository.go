@@ -0,0 +1,95 @@
+package adapters
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"newTiktoken/internal/common/users"
+	"newTiktoken/internal/video/app/query"
+	videoDomain "newTiktoken/internal/video/domain/video"
+)
+
+// MemoryVideoRepository 是线程安全的内存视频仓库，同时实现 query.VideoReadModel，用于测试和本地运行
+// 内存实现不维护点赞数、评论数等统计字段，这些字段始终为 0
+type MemoryVideoRepository struct {
+	lock   *sync.RWMutex
+	videos map[string]videoDomain.Video
+}
+
+func NewMemoryVideoRepository() *MemoryVideoRepository {
+	return &MemoryVideoRepository{
+		lock:   &sync.RWMutex{},
+		videos: map[string]videoDomain.Video{},
+	}
+}
+
+func (m MemoryVideoRepository) GetVideo(_ context.Context, videoUUID string) (*videoDomain.Video, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	v, ok := m.videos[videoUUID]
+	if !ok {
+		return nil, nil
+	}
+	return &v, nil
+}
+
+func (m MemoryVideoRepository) AddVideo(_ context.Context, video *videoDomain.Video) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.videos[video.UUID()]; ok {
+		return errors.Errorf("video %s already exists", video.UUID())
+	}
+	m.videos[video.UUID()] = *video
+	return nil
+}
+
+func (m MemoryVideoRepository) FindFeed(_ context.Context, before time.Time, limit int) ([]query.Video, error) {
+	videos := m.findVideos(func(v videoDomain.Video) bool {
+		return v.CreatedAt().Before(before)
+	})
+	if len(videos) > limit {
+		videos = videos[:limit]
+	}
+	return videos, nil
+}
+
+func (m MemoryVideoRepository) FindPublished(_ context.Context, authorUUID string) ([]query.Video, error) {
+	return m.findVideos(func(v videoDomain.Video) bool {
+		return v.AuthorUUID() == authorUUID
+	}), nil
+}
+
+// findVideos 按投稿时间倒序返回满足 match 的视频，投稿时间相同时按 UUID 倒序，与 MySQL 实现一致
+func (m MemoryVideoRepository) findVideos(match func(v videoDomain.Video) bool) []query.Video {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	var videos []query.Video
+	for _, v := range m.videos {
+		if match(v) {
+			videos = append(videos, domainVideoToQueryVideo(v))
+		}
+	}
+	sort.Slice(videos, func(i, j int) bool {
+		if !videos[i].CreatedAt.Equal(videos[j].CreatedAt) {
+			return videos[i].CreatedAt.After(videos[j].CreatedAt)
+		}
+		return videos[i].UUID > videos[j].UUID
+	})
+	return videos
+}
+
+func domainVideoToQueryVideo(v videoDomain.Video) query.Video {
+	return query.Video{
+		UUID:      v.UUID(),
+		Author:    users.User{UUID: v.AuthorUUID()},
+		Title:     v.Title(),
+		PlayURL:   v.PlayURL(),
+		CreatedAt: v.CreatedAt(),
+	}
+}
@@ -0,0 +1,67 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/pkg/errors"
+	"newTiktoken/internal/common/tracing"
+)
+
+// likedStatus 是 video_favorites 中点赞的 status
+const likedStatus = 1
+
+// MySQLFavoriteService 直接读取 video-favorite 服务的 video_favorites 表
+// 视频服务与点赞服务共用数据库，批量查询点赞状态不需要经过 gRPC
+type MySQLFavoriteService struct {
+	db *sql.DB
+}
+
+func NewMySQLFavoriteService(db *sql.DB) (*MySQLFavoriteService, error) {
+	if db == nil {
+		return nil, errors.New("nil db")
+	}
+	return &MySQLFavoriteService{db: db}, nil
+}
+
+// GetLikedVideos 使用 uk_video_favorites_user_video 索引
+func (m MySQLFavoriteService) GetLikedVideos(
+	ctx context.Context,
+	userUUID string,
+	videoUUIDs []string,
+) (_ map[string]bool, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "MySQLFavoriteService.GetLikedVideos")
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	liked := make(map[string]bool, len(videoUUIDs))
+	if len(videoUUIDs) == 0 {
+		return liked, nil
+	}
+	selectQuery := `
+        SELECT video_uuid
+        FROM video_favorites
+        WHERE user_uuid = ? AND status = ? AND video_uuid IN (` + strings.TrimSuffix(strings.Repeat("?, ", len(videoUUIDs)), ", ") + `)`
+	args := make([]any, 0, len(videoUUIDs)+2)
+	args = append(args, userUUID, likedStatus)
+	for _, videoUUID := range videoUUIDs {
+		args = append(args, videoUUID)
+	}
+
+	rows, err := m.db.QueryContext(ctx, selectQuery, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query liked videos")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var videoUUID string
+		if err := rows.Scan(&videoUUID); err != nil {
+			return nil, errors.Wrap(err, "failed to scan liked video")
+		}
+		liked[videoUUID] = true
+	}
+	return liked, errors.Wrap(rows.Err(), "failed to iterate liked videos")
+}
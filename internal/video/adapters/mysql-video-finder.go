@@ -0,0 +1,80 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/pkg/errors"
+	"newTiktoken/internal/common/tracing"
+	"newTiktoken/internal/video/app/query"
+)
+
+type MySQLVideoFinder struct {
+	db *sql.DB
+}
+
+func NewMySQLVideoFinder(db *sql.DB) (*MySQLVideoFinder, error) {
+	if db == nil {
+		return nil, errors.New("nil db")
+	}
+	return &MySQLVideoFinder{db: db}, nil
+}
+
+const selectVideo = `
+        SELECT
+            video_uuid, author_uuid, title, play_url,
+            favorite_count, comment_count, share_count, created_at
+        FROM videos`
+
+// FindFeed 使用 idx_videos_created_at 索引
+func (m MySQLVideoFinder) FindFeed(ctx context.Context, before time.Time, limit int) (_ []query.Video, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "MySQLVideoFinder.FindFeed")
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	return m.findVideos(ctx, selectVideo+`
+        WHERE created_at < ?
+        ORDER BY created_at DESC, video_uuid DESC
+        LIMIT ?`, before.UTC(), limit)
+}
+
+// FindPublished 使用 idx_videos_author_created_at 索引
+func (m MySQLVideoFinder) FindPublished(ctx context.Context, authorUUID string) (_ []query.Video, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "MySQLVideoFinder.FindPublished")
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	return m.findVideos(ctx, selectVideo+`
+        WHERE author_uuid = ?
+        ORDER BY created_at DESC, video_uuid DESC`, authorUUID)
+}
+
+func (m MySQLVideoFinder) findVideos(ctx context.Context, selectQuery string, args ...any) ([]query.Video, error) {
+	rows, err := m.db.QueryContext(ctx, selectQuery, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query videos")
+	}
+	defer rows.Close()
+
+	var videos []query.Video
+	for rows.Next() {
+		var v query.Video
+		if err := rows.Scan(
+			&v.UUID,
+			&v.Author.UUID,
+			&v.Title,
+			&v.PlayURL,
+			&v.FavoriteCount,
+			&v.CommentCount,
+			&v.ShareCount,
+			&v.CreatedAt,
+		); err != nil {
+			return nil, errors.Wrap(err, "failed to scan video")
+		}
+		videos = append(videos, v)
+	}
+	return videos, errors.Wrap(rows.Err(), "failed to iterate videos")
+}
@@ -0,0 +1,88 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/pkg/errors"
+	"newTiktoken/internal/common/events"
+	"newTiktoken/internal/common/tracing"
+	videoDomain "newTiktoken/internal/video/domain/video"
+)
+
+type MySQLVideoRepository struct {
+	db *sql.DB
+}
+
+// NewMySQLVideoRepository 创建一个新的 MySQL 视频仓库实例
+// videos 表由 cmd/migrate 执行 internal/common/migrations 中的迁移创建
+func NewMySQLVideoRepository(db *sql.DB) (videoDomain.Repository, error) {
+	if db == nil {
+		return nil, errors.New("nil db")
+	}
+	return &MySQLVideoRepository{db: db}, nil
+}
+
+// AddVideo 添加视频，在同一事务中写入 VideoPublished 事件，user 服务消费该事件更新作者的作品数
+func (m MySQLVideoRepository) AddVideo(ctx context.Context, video *videoDomain.Video) (err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "MySQLVideoRepository.AddVideo")
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		} else if err != nil {
+			_ = tx.Rollback()
+		} else {
+			err = tx.Commit()
+		}
+	}()
+
+	insertQuery := "INSERT INTO videos (video_uuid, author_uuid, title, play_url, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)"
+	_, err = tx.ExecContext(ctx, insertQuery,
+		video.UUID(),
+		video.AuthorUUID(),
+		video.Title(),
+		video.PlayURL(),
+		video.CreatedAt().UTC(),
+		time.Now().UTC(),
+	)
+	if err != nil {
+		return errors.Wrapf(err, "failed to insert video %s", video.UUID())
+	}
+
+	return events.StoreInOutbox(ctx, tx, videoDomain.NewVideoPublished(video))
+}
+
+// GetVideo 根据视频UUID查找视频
+func (m MySQLVideoRepository) GetVideo(ctx context.Context, videoUUID string) (_ *videoDomain.Video, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "MySQLVideoRepository.GetVideo")
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	row := m.db.QueryRowContext(ctx,
+		"SELECT video_uuid, author_uuid, title, play_url, created_at FROM videos WHERE video_uuid = ?",
+		videoUUID,
+	)
+	var (
+		uuid, authorUUID, title, playURL string
+		createdAt                        time.Time
+	)
+	if err := row.Scan(&uuid, &authorUUID, &title, &playURL, &createdAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to find video by uuid %s", videoUUID)
+	}
+	return videoDomain.UnmarshalVideoFromDatabase(uuid, authorUUID, title, playURL, createdAt), nil
+}
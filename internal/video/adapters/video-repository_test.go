@@ -0,0 +1,193 @@
+package adapters_test
+
+import (
+	"context"
+	"database/sql"
+	"math/rand/v2"
+	"os"
+	"testing"
+	"time"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+	"newTiktoken/internal/video/adapters"
+	"newTiktoken/internal/video/app/query"
+	videoDomain "newTiktoken/internal/video/domain/video"
+)
+
+// 内存仓库同时是 query 测试使用的读模型，需要与 MySQL 实现的排序和分页语义一致
+func TestMemoryVideoRepository(t *testing.T) {
+	t.Parallel()
+	repository := adapters.NewMemoryVideoRepository()
+	testVideoRepository(t, repository, repository)
+}
+
+func TestMySQLVideoRepository(t *testing.T) {
+	t.Parallel()
+	db := openTestDB(t)
+	repository, err := adapters.NewMySQLVideoRepository(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	finder, err := adapters.NewMySQLVideoFinder(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testVideoRepository(t, repository, finder)
+
+	// 作品数由 user 服务消费 VideoPublished 事件维护，投稿只写入 videos 和 outbox
+	t.Run("AddVideoStoresVideoPublished", func(t *testing.T) {
+		t.Parallel()
+		v := addExampleVideo(t, repository, uuid.NewString(), time.Now())
+
+		var count int
+		err := db.QueryRow(
+			"SELECT COUNT(*) FROM outbox_events WHERE event_name = ? AND payload->>'$.uuid' = ? AND payload->>'$.author_uuid' = ?",
+			videoDomain.VideoPublished{}.EventName(), v.UUID(), v.AuthorUUID(),
+		).Scan(&count)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count != 1 {
+			t.Errorf("expected one VideoPublished event for %s, got %d", v.UUID(), count)
+		}
+	})
+}
+
+func testVideoRepository(t *testing.T, repository videoDomain.Repository, readModel query.VideoReadModel) {
+	t.Run("GetMissingVideo", func(t *testing.T) {
+		t.Parallel()
+		v, err := repository.GetVideo(context.Background(), uuid.NewString())
+		if err != nil {
+			t.Fatalf("expected nil error for missing video, got %v", err)
+		}
+		if v != nil {
+			t.Fatalf("expected nil video for missing video, got %+v", v)
+		}
+	})
+	t.Run("AddVideo", func(t *testing.T) {
+		t.Parallel()
+		expected := addExampleVideo(t, repository, uuid.NewString(), time.Now())
+
+		found, err := repository.GetVideo(context.Background(), expected.UUID())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if found == nil {
+			t.Fatal("expected video to be persisted")
+		}
+		if found.AuthorUUID() != expected.AuthorUUID() || found.Title() != expected.Title() || found.PlayURL() != expected.PlayURL() {
+			t.Errorf("expected %+v, got %+v", expected, found)
+		}
+		if !found.CreatedAt().Equal(expected.CreatedAt()) {
+			t.Errorf("expected created at %s, got %s", expected.CreatedAt(), found.CreatedAt())
+		}
+
+		if err := repository.AddVideo(context.Background(), expected); err == nil {
+			t.Error("expected error when adding existing video")
+		}
+	})
+	t.Run("FindFeed", func(t *testing.T) {
+		t.Parallel()
+		testFindFeed(t, repository, readModel)
+	})
+	t.Run("FindPublished", func(t *testing.T) {
+		t.Parallel()
+		authorUUID := uuid.NewString()
+		now := time.Now()
+		first := addExampleVideo(t, repository, authorUUID, now.Add(-time.Minute))
+		second := addExampleVideo(t, repository, authorUUID, now)
+		addExampleVideo(t, repository, uuid.NewString(), now)
+
+		videos, err := readModel.FindPublished(context.Background(), authorUUID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertVideoUUIDs(t, videos, second.UUID(), first.UUID())
+	})
+}
+
+// testFindFeed 使用 2001 年到 2023 年之间的随机时间，避免与共享数据库中的其他视频和并行的子测试混在一起
+// before 不包含在结果中，投稿时间相同的视频按 UUID 倒序
+func testFindFeed(t *testing.T, repository videoDomain.Repository, readModel query.VideoReadModel) {
+	base := time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC).Add(time.Duration(rand.IntN(200_000)) * time.Hour)
+	oldest := addExampleVideo(t, repository, uuid.NewString(), base)
+	sameTimeA := addExampleVideo(t, repository, uuid.NewString(), base.Add(time.Second))
+	sameTimeB := addExampleVideo(t, repository, uuid.NewString(), base.Add(time.Second))
+	newest := addExampleVideo(t, repository, uuid.NewString(), base.Add(2*time.Second))
+	first, second := sameTimeA, sameTimeB
+	if first.UUID() < second.UUID() {
+		first, second = second, first
+	}
+
+	testCases := []struct {
+		Name     string
+		Before   time.Time
+		Limit    int
+		Expected []string
+	}{
+		{
+			Name:     "before_is_exclusive",
+			Before:   newest.CreatedAt(),
+			Limit:    3,
+			Expected: []string{first.UUID(), second.UUID(), oldest.UUID()},
+		},
+		{
+			Name:     "limit",
+			Before:   newest.CreatedAt().Add(time.Millisecond),
+			Limit:    2,
+			Expected: []string{newest.UUID(), first.UUID()},
+		},
+	}
+	for _, c := range testCases {
+		t.Run(c.Name, func(t *testing.T) {
+			videos, err := readModel.FindFeed(context.Background(), c.Before, c.Limit)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertVideoUUIDs(t, videos, c.Expected...)
+		})
+	}
+}
+
+// openTestDB 只有在提供 MYSQL_DSN 时才返回连接，数据库需要先执行 cmd/migrate up
+func openTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+	dsn := os.Getenv("MYSQL_DSN")
+	if dsn == "" {
+		t.Skip("MYSQL_DSN is not set")
+	}
+	db, err := sql.Open("mysql", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+	return db
+}
+
+func addExampleVideo(t *testing.T, repository videoDomain.Repository, authorUUID string, createdAt time.Time) *videoDomain.Video {
+	t.Helper()
+	v := videoDomain.UnmarshalVideoFromDatabase(
+		uuid.NewString(),
+		authorUUID,
+		"example video",
+		"https://cdn.example.com/videos/example.mp4",
+		createdAt.Truncate(time.Microsecond),
+	)
+	if err := repository.AddVideo(context.Background(), v); err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+func assertVideoUUIDs(t *testing.T, videos []query.Video, expected ...string) {
+	t.Helper()
+	if len(videos) != len(expected) {
+		t.Fatalf("expected %d videos, got %+v", len(expected), videos)
+	}
+	for i := range expected {
+		if videos[i].UUID != expected[i] {
+			t.Errorf("expected video %d to be %s, got %s", i, expected[i], videos[i].UUID)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package app
+
+import (
+	"newTiktoken/internal/video/app/command"
+	"newTiktoken/internal/video/app/query"
+)
+
+type Application struct {
+	Commands Commands
+	Queries  Queries
+}
+
+type Commands struct {
+	PublishVideo command.PublishVideoHandler
+}
+
+type Queries struct {
+	Feed        query.FeedHandler
+	PublishList query.PublishListHandler
+}
@@ -0,0 +1,9 @@
+package command
+
+import "newTiktoken/internal/common/auth"
+
+// PublishVideoPolicy 用户只能以自己的身份投稿，管理员可以代为投稿
+var PublishVideoPolicy = auth.AnyOf(
+	auth.Owner(func(cmd PublishVideo) string { return cmd.AuthorUUID }),
+	auth.Role[PublishVideo](auth.RoleAdmin),
+)
@@ -0,0 +1,54 @@
+package command
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/auth"
+	"newTiktoken/internal/common/decorator"
+	"newTiktoken/internal/common/logs"
+	"newTiktoken/internal/video/domain/video"
+)
+
+// PublishVideo 以 AuthorUUID 的身份投稿视频，VideoUUID 由调用方生成
+type PublishVideo struct {
+	User auth.User
+
+	VideoUUID  string
+	AuthorUUID string
+	Title      string
+	PlayURL    string
+}
+
+type PublishVideoHandler decorator.CommandHandler[PublishVideo]
+
+type publishVideoHandler struct {
+	repo video.Repository
+}
+
+func (h publishVideoHandler) Handle(ctx context.Context, cmd PublishVideo) (err error) {
+	defer func() {
+		logs.LogCommandExecution("PublishVideo", cmd, err)
+	}()
+	if err := PublishVideoPolicy.Authorize(cmd.User, cmd); err != nil {
+		return err
+	}
+	v, err := video.NewVideo(cmd.VideoUUID, cmd.AuthorUUID, cmd.Title, cmd.PlayURL)
+	if err != nil {
+		return err
+	}
+	return h.repo.AddVideo(ctx, v)
+}
+
+func NewPublishVideoHandler(repo video.Repository,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient) PublishVideoHandler {
+	if repo == nil {
+		panic("nil repo")
+	}
+	return decorator.ApplyCommandDecorators[PublishVideo](
+		publishVideoHandler{repo: repo},
+		logger,
+		metricsClient,
+	)
+}
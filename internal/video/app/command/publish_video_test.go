@@ -0,0 +1,89 @@
+package command_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/auth"
+	commonerrors "newTiktoken/internal/common/errors"
+	"newTiktoken/internal/common/metrics"
+	"newTiktoken/internal/video/adapters"
+	"newTiktoken/internal/video/app/command"
+)
+
+var userA = auth.User{UUID: "user-a", Role: "user"}
+
+func TestPublishVideo(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		Name        string
+		Command     command.PublishVideo
+		ExpectedErr commonerrors.ErrorType
+	}{
+		{
+			Name: "own_video",
+			Command: command.PublishVideo{
+				User: userA, VideoUUID: "video-1", AuthorUUID: "user-a",
+				Title: "title", PlayURL: "https://cdn.example.com/v.mp4",
+			},
+		},
+		{
+			Name: "other_author",
+			Command: command.PublishVideo{
+				User: userA, VideoUUID: "video-2", AuthorUUID: "user-b",
+				Title: "title", PlayURL: "https://cdn.example.com/v.mp4",
+			},
+			ExpectedErr: commonerrors.ErrorTypeAuthorization,
+		},
+		{
+			Name: "empty_title",
+			Command: command.PublishVideo{
+				User: userA, VideoUUID: "video-3", AuthorUUID: "user-a",
+				PlayURL: "https://cdn.example.com/v.mp4",
+			},
+			ExpectedErr: commonerrors.ErrorTypeIncorrectInput,
+		},
+		{
+			Name: "relative_play_url",
+			Command: command.PublishVideo{
+				User: userA, VideoUUID: "video-4", AuthorUUID: "user-a",
+				Title: "title", PlayURL: "/videos/v.mp4",
+			},
+			ExpectedErr: commonerrors.ErrorTypeIncorrectInput,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			repository := adapters.NewMemoryVideoRepository()
+			handler := command.NewPublishVideoHandler(repository, logrus.NewEntry(logrus.StandardLogger()), metrics.NoOp{})
+
+			err := handler.Handle(ctx, tc.Command)
+			v, getErr := repository.GetVideo(ctx, tc.Command.VideoUUID)
+			if getErr != nil {
+				t.Fatal(getErr)
+			}
+
+			if tc.ExpectedErr == (commonerrors.ErrorType{}) {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if v == nil || v.AuthorUUID() != tc.Command.AuthorUUID {
+					t.Errorf("expected video to be published, got %+v", v)
+				}
+				return
+			}
+			var slugErr commonerrors.SlugError
+			if !errors.As(err, &slugErr) || slugErr.ErrorType() != tc.ExpectedErr {
+				t.Fatalf("expected %v error, got %v", tc.ExpectedErr, err)
+			}
+			if v != nil {
+				t.Errorf("expected video not to be published, got %+v", v)
+			}
+		})
+	}
+}
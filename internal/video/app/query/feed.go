@@ -0,0 +1,88 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/decorator"
+)
+
+const feedPageSize = 30
+
+// Feed 查询投稿时间早于 LatestTime 的一页视频，LatestTime 为零值时从当前时间开始
+// ViewerUUID 为查询的用户，用于标记该用户点赞过的视频
+type Feed struct {
+	LatestTime time.Time
+	ViewerUUID string
+}
+
+type FeedHandler decorator.QueryHandler[Feed, FeedPage]
+
+type feedHandler struct {
+	readModel       VideoReadModel
+	userService     UserService
+	favoriteService FavoriteService
+}
+
+func (h feedHandler) Handle(ctx context.Context, query Feed) (FeedPage, error) {
+	latestTime := query.LatestTime
+	if latestTime.IsZero() {
+		latestTime = time.Now()
+	}
+
+	videos, err := h.readModel.FindFeed(ctx, latestTime, feedPageSize)
+	if err != nil {
+		return FeedPage{}, err
+	}
+	var nextTime time.Time
+	if len(videos) == feedPageSize {
+		videos, nextTime = trimLastSecond(videos)
+	}
+	if err := fillAuthors(ctx, h.userService, videos); err != nil {
+		return FeedPage{}, err
+	}
+	if err := fillIsFavorite(ctx, h.favoriteService, query.ViewerUUID, videos); err != nil {
+		return FeedPage{}, err
+	}
+	return FeedPage{Videos: videos, NextTime: nextTime}, nil
+}
+
+// trimLastSecond 计算下一页的 latest_time
+// latest_time 精确到秒，下一页只返回早于该秒的视频，因此与最后一个视频同一秒投稿的视频
+// 要么全部留到下一页，要么（整页都在同一秒时）只能跳过该秒剩余的视频
+func trimLastSecond(videos []Video) ([]Video, time.Time) {
+	lastSecond := videos[len(videos)-1].CreatedAt.Truncate(time.Second)
+	if videos[0].CreatedAt.Truncate(time.Second).Equal(lastSecond) {
+		return videos, lastSecond
+	}
+
+	kept := len(videos)
+	for kept > 0 && videos[kept-1].CreatedAt.Truncate(time.Second).Equal(lastSecond) {
+		kept--
+	}
+	return videos[:kept], lastSecond.Add(time.Second)
+}
+
+func NewFeedHandler(
+	readModel VideoReadModel,
+	userService UserService,
+	favoriteService FavoriteService,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) FeedHandler {
+	if readModel == nil {
+		panic("nil readModel")
+	}
+	if userService == nil {
+		panic("nil userService")
+	}
+	if favoriteService == nil {
+		panic("nil favoriteService")
+	}
+	return decorator.ApplyQueryDecorators[Feed, FeedPage](
+		feedHandler{readModel: readModel, userService: userService, favoriteService: favoriteService},
+		logger,
+		metricsClient,
+	)
+}
@@ -0,0 +1,170 @@
+package query_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/metrics"
+	"newTiktoken/internal/common/users"
+	"newTiktoken/internal/video/adapters"
+	"newTiktoken/internal/video/app/query"
+	videoDomain "newTiktoken/internal/video/domain/video"
+)
+
+type userServiceStub map[string]users.User
+
+func (s userServiceStub) GetUsersInformation(_ context.Context, userUUIDs []string) (map[string]users.User, error) {
+	found := map[string]users.User{}
+	for _, userUUID := range userUUIDs {
+		if usr, ok := s[userUUID]; ok {
+			found[userUUID] = usr
+		}
+	}
+	return found, nil
+}
+
+// favoriteServiceStub 的 key 为用户 UUID，value 为该用户点赞的视频
+type favoriteServiceStub map[string][]string
+
+func (s favoriteServiceStub) GetLikedVideos(_ context.Context, userUUID string, videoUUIDs []string) (map[string]bool, error) {
+	liked := map[string]bool{}
+	for _, videoUUID := range s[userUUID] {
+		liked[videoUUID] = true
+	}
+	return liked, nil
+}
+
+func TestFeedPagesWithoutSkippingVideosInTheSameSecond(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	repository := adapters.NewMemoryVideoRepository()
+	base := time.Now().Truncate(time.Second).Add(-time.Hour)
+
+	var createdAt []time.Time
+	for i := 0; i < 29; i++ {
+		createdAt = append(createdAt, base.Add(-time.Duration(i)*time.Second))
+	}
+	// 第一页的最后一个视频与之后的两个视频在同一秒投稿
+	lastSecond := base.Add(-29 * time.Second)
+	for _, offset := range []time.Duration{300, 200, 100} {
+		createdAt = append(createdAt, lastSecond.Add(offset*time.Millisecond))
+	}
+	for i, at := range createdAt {
+		v := videoDomain.UnmarshalVideoFromDatabase(fmt.Sprintf("video-%02d", i), "author-a", "title", "https://cdn.example.com/v.mp4", at)
+		if err := repository.AddVideo(ctx, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	handler := query.NewFeedHandler(
+		repository,
+		userServiceStub{"author-a": {UUID: "author-a", Name: "Author A"}},
+		favoriteServiceStub{},
+		logrus.NewEntry(logrus.StandardLogger()),
+		metrics.NoOp{},
+	)
+
+	first, err := handler.Handle(ctx, query.Feed{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(first.Videos) != 29 {
+		t.Fatalf("expected videos of the last second to be moved to the next page, got %d videos", len(first.Videos))
+	}
+	if !first.NextTime.Equal(lastSecond.Add(time.Second)) {
+		t.Fatalf("expected next time %s, got %s", lastSecond.Add(time.Second), first.NextTime)
+	}
+	if first.Videos[0].Author.Name != "Author A" {
+		t.Errorf("expected author to be filled in, got %+v", first.Videos[0].Author)
+	}
+
+	second, err := handler.Handle(ctx, query.Feed{LatestTime: first.NextTime})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(second.Videos) != 3 {
+		t.Fatalf("expected 3 videos on the second page, got %d", len(second.Videos))
+	}
+	if !second.NextTime.IsZero() {
+		t.Errorf("expected no next page, got next time %s", second.NextTime)
+	}
+
+	seen := map[string]bool{}
+	for _, v := range append(first.Videos, second.Videos...) {
+		if seen[v.UUID] {
+			t.Errorf("video %s returned twice", v.UUID)
+		}
+		seen[v.UUID] = true
+	}
+	if len(seen) != len(createdAt) {
+		t.Errorf("expected all %d videos to be returned, got %d", len(createdAt), len(seen))
+	}
+}
+
+func TestPublishListKeepsUnknownAuthor(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	repository := adapters.NewMemoryVideoRepository()
+	v := videoDomain.UnmarshalVideoFromDatabase("video-1", "deleted-author", "title", "https://cdn.example.com/v.mp4", time.Now())
+	if err := repository.AddVideo(ctx, v); err != nil {
+		t.Fatal(err)
+	}
+
+	handler := query.NewPublishListHandler(
+		repository,
+		userServiceStub{},
+		favoriteServiceStub{},
+		logrus.NewEntry(logrus.StandardLogger()),
+		metrics.NoOp{},
+	)
+	videos, err := handler.Handle(ctx, query.PublishList{UserUUID: "deleted-author"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(videos) != 1 || videos[0].Author.UUID != "deleted-author" {
+		t.Errorf("expected the video with only the author uuid, got %+v", videos)
+	}
+}
+
+func TestFeedMarksVideosLikedByViewer(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	repository := adapters.NewMemoryVideoRepository()
+	now := time.Now()
+	for i, videoUUID := range []string{"video-1", "video-2"} {
+		v := videoDomain.UnmarshalVideoFromDatabase(videoUUID, "author-a", "title", "https://cdn.example.com/v.mp4",
+			now.Add(-time.Duration(i+1)*time.Minute))
+		if err := repository.AddVideo(ctx, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	handler := query.NewFeedHandler(
+		repository,
+		userServiceStub{},
+		favoriteServiceStub{"viewer": {"video-2"}},
+		logrus.NewEntry(logrus.StandardLogger()),
+		metrics.NoOp{},
+	)
+
+	page, err := handler.Handle(ctx, query.Feed{ViewerUUID: "viewer"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.Videos) != 2 || page.Videos[0].IsFavorite || !page.Videos[1].IsFavorite {
+		t.Errorf("expected only video-2 to be liked, got %+v", page.Videos)
+	}
+
+	// 未认证的请求不标记点赞
+	page, err = handler.Handle(ctx, query.Feed{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range page.Videos {
+		if v.IsFavorite {
+			t.Errorf("expected %s not to be liked without a viewer", v.UUID)
+		}
+	}
+}
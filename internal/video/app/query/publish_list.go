@@ -0,0 +1,59 @@
+package query
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/decorator"
+)
+
+// PublishList 查询 UserUUID 投稿的所有视频，按投稿时间倒序，ViewerUUID 为查询的用户
+type PublishList struct {
+	UserUUID   string
+	ViewerUUID string
+}
+
+type PublishListHandler decorator.QueryHandler[PublishList, []Video]
+
+type publishListHandler struct {
+	readModel       VideoReadModel
+	userService     UserService
+	favoriteService FavoriteService
+}
+
+func (h publishListHandler) Handle(ctx context.Context, query PublishList) ([]Video, error) {
+	videos, err := h.readModel.FindPublished(ctx, query.UserUUID)
+	if err != nil {
+		return nil, err
+	}
+	if err := fillAuthors(ctx, h.userService, videos); err != nil {
+		return nil, err
+	}
+	if err := fillIsFavorite(ctx, h.favoriteService, query.ViewerUUID, videos); err != nil {
+		return nil, err
+	}
+	return videos, nil
+}
+
+func NewPublishListHandler(
+	readModel VideoReadModel,
+	userService UserService,
+	favoriteService FavoriteService,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) PublishListHandler {
+	if readModel == nil {
+		panic("nil readModel")
+	}
+	if userService == nil {
+		panic("nil userService")
+	}
+	if favoriteService == nil {
+		panic("nil favoriteService")
+	}
+	return decorator.ApplyQueryDecorators[PublishList, []Video](
+		publishListHandler{readModel: readModel, userService: userService, favoriteService: favoriteService},
+		logger,
+		metricsClient,
+	)
+}
@@ -0,0 +1,17 @@
+package query
+
+import (
+	"context"
+
+	"newTiktoken/internal/common/users"
+)
+
+// UserService 用于从用户服务批量获取用户资料，不存在的用户不会出现在返回的 map 中
+type UserService interface {
+	GetUsersInformation(ctx context.Context, userUUIDs []string) (map[string]users.User, error)
+}
+
+// FavoriteService 返回 videoUUIDs 中被 userUUID 点赞的视频
+type FavoriteService interface {
+	GetLikedVideos(ctx context.Context, userUUID string, videoUUIDs []string) (map[string]bool, error)
+}
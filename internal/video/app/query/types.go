@@ -0,0 +1,27 @@
+package query
+
+import (
+	"time"
+
+	"newTiktoken/internal/common/users"
+)
+
+// Video 是返回给客户端的视频，读模型只填充 Author.UUID，其余作者资料由 UserService 补全，
+// IsFavorite 表示查询的用户是否点赞了该视频
+type Video struct {
+	UUID          string
+	Author        users.User
+	Title         string
+	PlayURL       string
+	FavoriteCount uint64
+	CommentCount  uint64
+	ShareCount    uint64
+	IsFavorite    bool
+	CreatedAt     time.Time
+}
+
+// FeedPage 是一页视频流，NextTime 为零值表示没有更早的视频
+type FeedPage struct {
+	Videos   []Video
+	NextTime time.Time
+}
@@ -0,0 +1,42 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"newTiktoken/internal/common/users"
+)
+
+// VideoReadModel 按投稿时间倒序返回视频，返回的视频只填充 Author.UUID
+type VideoReadModel interface {
+	// FindFeed 返回投稿时间早于 before 的至多 limit 个视频
+	FindFeed(ctx context.Context, before time.Time, limit int) ([]Video, error)
+	FindPublished(ctx context.Context, authorUUID string) ([]Video, error)
+}
+
+// fillAuthors 批量补全视频的作者资料，用户服务中不存在的作者只保留 UUID
+func fillAuthors(ctx context.Context, userService UserService, videos []Video) error {
+	return users.Fill(ctx, userService, videos, func(v *Video) *users.User { return &v.Author })
+}
+
+// fillIsFavorite 标记 viewerUUID 点赞过的视频，viewerUUID 为空时都不标记
+func fillIsFavorite(ctx context.Context, favoriteService FavoriteService, viewerUUID string, videos []Video) error {
+	if viewerUUID == "" || len(videos) == 0 {
+		return nil
+	}
+
+	videoUUIDs := make([]string, 0, len(videos))
+	for _, v := range videos {
+		videoUUIDs = append(videoUUIDs, v.UUID)
+	}
+	liked, err := favoriteService.GetLikedVideos(ctx, viewerUUID, videoUUIDs)
+	if err != nil {
+		return errors.Wrap(err, "failed to get liked videos")
+	}
+
+	for i := range videos {
+		videos[i].IsFavorite = liked[videos[i].UUID]
+	}
+	return nil
+}
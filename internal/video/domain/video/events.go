@@ -0,0 +1,26 @@
+package video
+
+import "time"
+
+// VideoPublished 在新视频投稿后产生
+type VideoPublished struct {
+	UUID       string    `json:"uuid"`
+	AuthorUUID string    `json:"author_uuid"`
+	Title      string    `json:"title"`
+	PlayURL    string    `json:"play_url"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+func (VideoPublished) EventName() string {
+	return "VideoPublished"
+}
+
+func NewVideoPublished(video *Video) VideoPublished {
+	return VideoPublished{
+		UUID:       video.UUID(),
+		AuthorUUID: video.AuthorUUID(),
+		Title:      video.Title(),
+		PlayURL:    video.PlayURL(),
+		CreatedAt:  video.CreatedAt().UTC(),
+	}
+}
@@ -0,0 +1,12 @@
+package video
+
+import "context"
+
+// Repository 是 video domain repository 的接口
+// 所有实现都需要通过 adapters 中的 repository contract 测试
+type Repository interface {
+	// GetVideo 在视频不存在时返回 nil, nil
+	GetVideo(ctx context.Context, videoUUID string) (*Video, error)
+	// AddVideo 保存新投稿的视频，视频已存在时返回错误
+	AddVideo(ctx context.Context, video *Video) error
+}
@@ -0,0 +1,96 @@
+package video
+
+import (
+	"net/url"
+	"time"
+	"unicode/utf8"
+
+	commonerrors "newTiktoken/internal/common/errors"
+)
+
+const maxTitleLength = 128
+
+var (
+	ErrEmptyTitle     = commonerrors.NewIncorrectInputError("empty video title", "empty-video-title")
+	ErrTitleTooLong   = commonerrors.NewIncorrectInputError("video title is too long", "video-title-too-long")
+	ErrInvalidPlayURL = commonerrors.NewIncorrectInputError("play url must be an absolute http(s) url", "invalid-play-url")
+)
+
+// Video 是投稿的视频，点赞数和评论数由其他服务维护，不属于该聚合
+type Video struct {
+	uuid       string
+	authorUUID string
+	title      string
+	playURL    string
+	createdAt  time.Time
+}
+
+// NewVideo 创建一个新投稿的视频
+func NewVideo(uuid string, authorUUID string, title string, playURL string) (*Video, error) {
+	if uuid == "" {
+		return nil, commonerrors.NewIncorrectInputError("空的视频uuid", "empty-video-uuid")
+	}
+	if authorUUID == "" {
+		return nil, commonerrors.NewIncorrectInputError("空的作者uuid", "empty-author-uuid")
+	}
+	if title == "" {
+		return nil, ErrEmptyTitle
+	}
+	if utf8.RuneCountInString(title) > maxTitleLength {
+		return nil, ErrTitleTooLong
+	}
+	if !validPlayURL(playURL) {
+		return nil, ErrInvalidPlayURL
+	}
+	return &Video{
+		uuid:       uuid,
+		authorUUID: authorUUID,
+		title:      title,
+		playURL:    playURL,
+		createdAt:  time.Now(),
+	}, nil
+}
+
+func UnmarshalVideoFromDatabase(
+	uuid string,
+	authorUUID string,
+	title string,
+	playURL string,
+	createdAt time.Time,
+) *Video {
+	return &Video{
+		uuid:       uuid,
+		authorUUID: authorUUID,
+		title:      title,
+		playURL:    playURL,
+		createdAt:  createdAt,
+	}
+}
+
+func (v Video) UUID() string {
+	return v.uuid
+}
+
+func (v Video) AuthorUUID() string {
+	return v.authorUUID
+}
+
+func (v Video) Title() string {
+	return v.title
+}
+
+func (v Video) PlayURL() string {
+	return v.playURL
+}
+
+func (v Video) CreatedAt() time.Time {
+	return v.createdAt
+}
+
+func validPlayURL(playURL string) bool {
+	parsed, err := url.Parse(playURL)
+	if err != nil {
+		return false
+	}
+	return (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
+}
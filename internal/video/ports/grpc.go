@@ -0,0 +1,104 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"newTiktoken/internal/common/auth"
+	videoPb "newTiktoken/internal/common/genproto/video"
+	"newTiktoken/internal/common/users"
+	"newTiktoken/internal/video/app"
+	"newTiktoken/internal/video/app/command"
+	"newTiktoken/internal/video/app/query"
+)
+
+type GrpcServer struct {
+	videoPb.UnimplementedVideoServiceServer
+	app app.Application
+}
+
+func NewGrpcServer(application app.Application) *GrpcServer {
+	return &GrpcServer{app: application}
+}
+
+// Feed 返回投稿时间早于 latest_time 的视频，latest_time 为 0 时从当前时间开始
+func (g *GrpcServer) Feed(ctx context.Context, req *videoPb.FeedRequest) (*videoPb.FeedResponse, error) {
+	var latestTime time.Time
+	if req.GetLatestTime() > 0 {
+		latestTime = time.Unix(req.GetLatestTime(), 0)
+	}
+	page, err := g.app.Queries.Feed.Handle(ctx, query.Feed{LatestTime: latestTime, ViewerUUID: viewerUUID(ctx)})
+	if err != nil {
+		return nil, err
+	}
+	var nextTime int64
+	if !page.NextTime.IsZero() {
+		nextTime = page.NextTime.Unix()
+	}
+	return &videoPb.FeedResponse{
+		StatusMsg: "success",
+		VideoList: queryVideosToProtoVideos(page.Videos),
+		NextTime:  nextTime,
+	}, nil
+}
+
+// PublishAction 以当前认证用户作为作者投稿视频
+func (g *GrpcServer) PublishAction(ctx context.Context, req *videoPb.PublishActionRequest) (*videoPb.PublishActionResponse, error) {
+	user, err := auth.GRPCUserFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	videoUUID := uuid.NewString()
+	if err := g.app.Commands.PublishVideo.Handle(ctx, command.PublishVideo{
+		User:       user,
+		VideoUUID:  videoUUID,
+		AuthorUUID: user.UUID,
+		Title:      req.GetTitle(),
+		PlayURL:    req.GetPlayUrl(),
+	}); err != nil {
+		return nil, err
+	}
+	return &videoPb.PublishActionResponse{StatusMsg: "success", VideoUuid: videoUUID}, nil
+}
+
+func (g *GrpcServer) PublishList(ctx context.Context, req *videoPb.PublishListRequest) (*videoPb.PublishListResponse, error) {
+	videos, err := g.app.Queries.PublishList.Handle(ctx, query.PublishList{
+		UserUUID:   req.GetUserUuid(),
+		ViewerUUID: viewerUUID(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &videoPb.PublishListResponse{
+		StatusMsg: "success",
+		VideoList: queryVideosToProtoVideos(videos),
+	}, nil
+}
+
+// viewerUUID 返回认证用户的 UUID，未认证时返回空字符串，此时视频都不标记为已点赞
+func viewerUUID(ctx context.Context) string {
+	user, err := auth.UserFromCtx(ctx)
+	if err != nil {
+		return ""
+	}
+	return user.UUID
+}
+
+func queryVideosToProtoVideos(videos []query.Video) []*videoPb.Video {
+	pbVideos := make([]*videoPb.Video, 0, len(videos))
+	for _, v := range videos {
+		pbVideos = append(pbVideos, &videoPb.Video{
+			Uuid:          v.UUID,
+			Author:        users.ToProto(v.Author),
+			PlayUrl:       v.PlayURL,
+			FavoriteCount: v.FavoriteCount,
+			CommentCount:  v.CommentCount,
+			IsFavorite:    v.IsFavorite,
+			Title:         v.Title,
+			ShareCount:    v.ShareCount,
+			CreateAt:      uint64(v.CreatedAt.Unix()),
+		})
+	}
+	return pbVideos
+}
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/sirupsen/logrus"
+	"newTiktoken/internal/common/client"
+	"newTiktoken/internal/common/config"
+	"newTiktoken/internal/common/decorator"
+	"newTiktoken/internal/common/server"
+	"newTiktoken/internal/common/users"
+	"newTiktoken/internal/video/adapters"
+	"newTiktoken/internal/video/app"
+	"newTiktoken/internal/video/app/command"
+	"newTiktoken/internal/video/app/query"
+)
+
+// NewApplication 返回应用、驱动 gRPC 健康状态的依赖检查以及退出时的清理函数
+func NewApplication(ctx context.Context, cfg config.Config, metricsClient decorator.MetricsClient) (app.Application, []server.HealthCheck, func()) {
+	db, err := cfg.MySQL.Open()
+	if err != nil {
+		panic(err)
+	}
+	videoRepository, err := adapters.NewMySQLVideoRepository(db)
+	if err != nil {
+		panic(err)
+	}
+	videoFinder, err := adapters.NewMySQLVideoFinder(db)
+	if err != nil {
+		panic(err)
+	}
+	userClient, closeUserClient, err := client.NewUserClient()
+	if err != nil {
+		panic(err)
+	}
+	userService := users.NewUserGrpc(userClient)
+	favoriteService, err := adapters.NewMySQLFavoriteService(db)
+	if err != nil {
+		panic(err)
+	}
+	logger := logrus.NewEntry(logrus.StandardLogger())
+
+	return app.Application{
+		Commands: app.Commands{
+			PublishVideo: command.NewPublishVideoHandler(videoRepository, logger, metricsClient),
+		},
+		Queries: app.Queries{
+			Feed:        query.NewFeedHandler(videoFinder, userService, favoriteService, logger, metricsClient),
+			PublishList: query.NewPublishListHandler(videoFinder, userService, favoriteService, logger, metricsClient),
+		},
+	}, []server.HealthCheck{
+		{Name: "mysql", Check: db.PingContext},
+	}, func() {
+		_ = closeUserClient()
+		_ = db.Close()
+	}
+}